@@ -329,6 +329,13 @@ func HandleVCRConfiguration(ctx context.Context, testName string, rndTripper htt
 		diags.AddError("error creating record as new mode", err.Error())
 		return pollInterval, rndTripper, diags
 	}
+	// Keep credentials out of recorded cassettes, so that they can be shared
+	// and replayed without access to the project they were recorded in.
+	rec.AddFilter(func(i *cassette.Interaction) error {
+		i.Request.Headers = redactVcrHeaders(i.Request.Headers)
+		i.Response.Headers = redactVcrHeaders(i.Response.Headers)
+		return nil
+	})
 	// Defines how VCR will match requests to responses.
 	rec.SetMatcher(func(r *http.Request, i cassette.Request) bool {
 		// Default matcher compares method and URL only
@@ -375,6 +382,20 @@ func HandleVCRConfiguration(ctx context.Context, testName string, rndTripper htt
 	return pollInterval, rec, diags
 }
 
+// redactVcrHeaders returns a copy of the headers without credentials. The
+// recorded headers are shared with the live request and response, so they're
+// not modified in place.
+func redactVcrHeaders(h http.Header) http.Header {
+	redacted := http.Header{}
+	for k, v := range h {
+		if transport_tpg.IsSensitiveHttpHeader(k) {
+			continue
+		}
+		redacted[k] = v
+	}
+	return redacted
+}
+
 // MuxedProviders configures the providers, thus, if we want the providers to be configured
 // to use VCR, the configure functions need to be altered. The only way to do this is to create
 // test versions of the provider that will call the same configure function, only append the VCR
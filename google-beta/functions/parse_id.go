@@ -0,0 +1,91 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+package functions
+
+import (
+	"context"
+	"regexp"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ function.Function = ParseIdFunction{}
+
+// parseIdAttributeTypes are the attributes of the object returned by parse_id.
+var parseIdAttributeTypes = map[string]attr.Type{
+	"project":  types.StringType,
+	"location": types.StringType,
+	"name":     types.StringType,
+}
+
+func NewParseIdFunction() function.Function {
+	return &ParseIdFunction{
+		name: "parse_id",
+	}
+}
+
+type ParseIdFunction struct {
+	name string // Makes function name available in Run logic for logging purposes
+}
+
+func (f ParseIdFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = f.name
+}
+
+func (f ParseIdFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:     "Returns the project, location and name within a provided resource's id, resource URI, self link, or full resource name.",
+		Description: "Takes a single string argument, which should be a resource's id, resource URI, self link, or full resource name. This function will either return an object with the attributes \"project\", \"location\" and \"name\" or raise an error due to no name being present in the string. The location is taken from \"locations/{{location}}/\", \"regions/{{region}}/\" or \"zones/{{zone}}/\", whichever comes first, and attributes that aren't present in the string are null, e.g. when the function is passed the id \"projects/my-project/regions/us-central1/subnetworks/my-subnetwork\" as an argument it will return {project = \"my-project\", location = \"us-central1\", name = \"my-subnetwork\"}.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "id",
+				Description: "A string of a resource's id, resource URI, self link, or full resource name. For example, \"projects/my-project/zones/us-central1-c/instances/my-instance\", \"https://www.googleapis.com/compute/v1/projects/my-project/zones/us-central1-c/instances/my-instance\" and \"//gkehub.googleapis.com/projects/my-project/locations/us-central1/memberships/my-membership\" are valid values",
+			},
+		},
+		Return: function.ObjectReturn{
+			AttributeTypes: parseIdAttributeTypes,
+		},
+	}
+}
+
+func (f ParseIdFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	// Load arguments from function call
+	var arg0 string
+	resp.Error = function.ConcatFuncErrors(req.Arguments.GetArgument(ctx, 0, &arg0))
+	if resp.Error != nil {
+		return
+	}
+
+	// Prepare how we'll identify each element from input string
+	nameRegex := regexp.MustCompile("/(?P<ResourceName>[^/]+)$")
+	projectRegex := regexp.MustCompile("projects/(?P<ProjectId>[^/]+)/")
+	locationRegex := regexp.MustCompile("(?:locations|regions|zones)/(?P<LocationName>[^/]+)/")
+	pattern := "resourceType/{name}$" // Human-readable pseudo-regex pattern used in errors and warnings
+
+	// Validate input; only the name is required
+	resp.Error = function.ConcatFuncErrors(ValidateElementFromIdArguments(ctx, arg0, nameRegex, pattern, f.name))
+	if resp.Error != nil {
+		return
+	}
+
+	result := map[string]attr.Value{
+		"project":  types.StringNull(),
+		"location": types.StringNull(),
+		"name":     types.StringValue(GetElementFromId(arg0, nameRegex, "$ResourceName")),
+	}
+	if projectRegex.MatchString(arg0) {
+		result["project"] = types.StringValue(GetElementFromId(arg0, projectRegex, "$ProjectId"))
+	}
+	if locationRegex.MatchString(arg0) {
+		result["location"] = types.StringValue(GetElementFromId(arg0, locationRegex, "$LocationName"))
+	}
+
+	obj, diags := types.ObjectValue(parseIdAttributeTypes, result)
+	if diags.HasError() {
+		resp.Error = function.FuncErrorFromDiags(ctx, diags)
+		return
+	}
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, obj))
+}
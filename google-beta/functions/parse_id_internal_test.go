@@ -0,0 +1,106 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+package functions
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestFunctionRun_parse_id(t *testing.T) {
+	t.Parallel()
+
+	// Happy path inputs
+	locationId := "projects/my-project/locations/us-central1/services/my-service"
+	regionalSelfLink := "https://www.googleapis.com/compute/v1/projects/my-project/regions/us-central1/subnetworks/my-subnetwork"
+	zonalOpStyleResourceName := "//compute.googleapis.com/projects/my-project/zones/us-central1-c/instances/my-instance"
+	globalId := "projects/my-project/global/networks/my-network"
+
+	// Unhappy path inputs
+	invalidInput := "projects/my-project/global/networks/"
+
+	parsedId := func(project, location attr.Value, name string) function.ResultData {
+		return function.NewResultData(types.ObjectValueMust(parseIdAttributeTypes, map[string]attr.Value{
+			"project":  project,
+			"location": location,
+			"name":     types.StringValue(name),
+		}))
+	}
+
+	testCases := map[string]struct {
+		request  function.RunRequest
+		expected function.RunResponse
+	}{
+		"it returns the project, location and name of a resource id with a location": {
+			request: function.RunRequest{
+				Arguments: function.NewArgumentsData([]attr.Value{types.StringValue(locationId)}),
+			},
+			expected: function.RunResponse{
+				Result: parsedId(types.StringValue("my-project"), types.StringValue("us-central1"), "my-service"),
+			},
+		},
+		"it returns the region as the location of a regional self_link": {
+			request: function.RunRequest{
+				Arguments: function.NewArgumentsData([]attr.Value{types.StringValue(regionalSelfLink)}),
+			},
+			expected: function.RunResponse{
+				Result: parsedId(types.StringValue("my-project"), types.StringValue("us-central1"), "my-subnetwork"),
+			},
+		},
+		"it returns the zone as the location of a zonal OP style resource name": {
+			request: function.RunRequest{
+				Arguments: function.NewArgumentsData([]attr.Value{types.StringValue(zonalOpStyleResourceName)}),
+			},
+			expected: function.RunResponse{
+				Result: parsedId(types.StringValue("my-project"), types.StringValue("us-central1-c"), "my-instance"),
+			},
+		},
+		"it returns a null location for a global resource id": {
+			request: function.RunRequest{
+				Arguments: function.NewArgumentsData([]attr.Value{types.StringValue(globalId)}),
+			},
+			expected: function.RunResponse{
+				Result: parsedId(types.StringValue("my-project"), types.StringNull(), "my-network"),
+			},
+		},
+		"it returns an error when given input with no name": {
+			request: function.RunRequest{
+				Arguments: function.NewArgumentsData([]attr.Value{types.StringValue(invalidInput)}),
+			},
+			expected: function.RunResponse{
+				Result: function.NewResultData(types.ObjectUnknown(parseIdAttributeTypes)),
+				Error:  function.NewArgumentFuncError(0, fmt.Sprintf("The input string \"%s\" doesn't contain the expected pattern \"resourceType/{name}$\".", invalidInput)),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		tn, tc := name, testCase
+
+		t.Run(tn, func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			got := function.RunResponse{
+				Result: function.NewResultData(types.ObjectUnknown(parseIdAttributeTypes)),
+			}
+
+			// Act
+			NewParseIdFunction().Run(context.Background(), tc.request, &got)
+
+			// Assert
+			if diff := cmp.Diff(got.Result, tc.expected.Result); diff != "" {
+				t.Errorf("unexpected diff between expected and received result: %s", diff)
+			}
+			if diff := cmp.Diff(got.Error, tc.expected.Error); diff != "" {
+				t.Errorf("unexpected diff between expected and received errors: %s", diff)
+			}
+		})
+	}
+}
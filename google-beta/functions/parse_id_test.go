@@ -0,0 +1,79 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+package functions_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-google-beta/google-beta/acctest"
+	"github.com/hashicorp/terraform-provider-google-beta/google-beta/envvar"
+)
+
+func TestAccProviderFunction_parse_id(t *testing.T) {
+	t.Parallel()
+	// Skipping due to requiring TF 1.8.0 in VCR systems : https://github.com/hashicorp/terraform-provider-google/issues/17451
+	acctest.SkipIfVcr(t)
+
+	projectId := envvar.GetTestProjectFromEnv()
+	location := "us-central1"
+	resourceName := fmt.Sprintf("tf-test-parse-id-func-%s", acctest.RandString(t, 10))
+
+	context := map[string]interface{}{
+		"function_name":     "parse_id",
+		"resource_name":     resourceName,
+		"resource_location": location,
+	}
+
+	acctest.VcrTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories(t),
+		Steps: []resource.TestStep{
+			{
+				// Can get the project, location and name from a resource's id in one step
+				// Uses google_compute_address resource's id attribute with format projects/{{project}}/regions/{{region}}/addresses/{{name}}
+				Config: testProviderFunction_parse_resource_id(context),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestMatchOutput("project", regexp.MustCompile(fmt.Sprintf("^%s$", projectId))),
+					resource.TestMatchOutput("location", regexp.MustCompile(fmt.Sprintf("^%s$", location))),
+					resource.TestMatchOutput("name", regexp.MustCompile(fmt.Sprintf("^%s$", resourceName))),
+				),
+			},
+		},
+	})
+}
+
+func testProviderFunction_parse_resource_id(context map[string]interface{}) string {
+	return acctest.Nprintf(`
+# terraform block required for provider function to be found
+terraform {
+  required_providers {
+    google = {
+      source = "hashicorp/google"
+    }
+  }
+}
+
+resource "google_compute_address" "default" {
+  name   = "%{resource_name}"
+  region = "%{resource_location}"
+}
+
+locals {
+  parsed_id = provider::google::%{function_name}(google_compute_address.default.id)
+}
+
+output "project" {
+  value = local.parsed_id.project
+}
+
+output "location" {
+  value = local.parsed_id.location
+}
+
+output "name" {
+  value = local.parsed_id.name
+}
+`, context)
+}
@@ -5,6 +5,7 @@ package functions
 import (
 	"context"
 	"fmt"
+	"strings"
 
 	"github.com/hashicorp/terraform-plugin-framework/function"
 )
@@ -24,7 +25,7 @@ func (f RegionFromZoneFunction) Metadata(ctx context.Context, req function.Metad
 func (f RegionFromZoneFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
 	resp.Definition = function.Definition{
 		Summary:     "Returns the region within a provided resource's zone",
-		Description: "Takes a single string argument, which should be a resource's zone.",
+		Description: "Takes a single string argument, which should be a resource's zone. A zone self link or id such as \"projects/my-project/zones/us-central1-a\" is also accepted.",
 		Parameters: []function.Parameter{
 			function.StringParameter{
 				Name:        "zone",
@@ -49,11 +50,14 @@ func (f RegionFromZoneFunction) Run(ctx context.Context, req function.RunRequest
 		return
 	}
 
-	if arg0[len(arg0)-2] != '-' {
+	// Zone self links and ids end with the zone name
+	zone := arg0[strings.LastIndex(arg0, "/")+1:]
+
+	if len(zone) < 3 || zone[len(zone)-2] != '-' {
 		err := function.NewArgumentFuncError(0, fmt.Sprintf("The input string \"%s\" is not a valid zone name.", arg0))
 		resp.Error = function.ConcatFuncErrors(err)
 		return
 	}
 
-	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, arg0[:len(arg0)-2]))
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, zone[:len(zone)-2]))
 }
@@ -30,6 +30,22 @@ func TestFunctionRun_region_from_zone(t *testing.T) {
 				Result: function.NewResultData(types.StringValue(region)),
 			},
 		},
+		"it returns the expected output value when given a zone self link": {
+			request: function.RunRequest{
+				Arguments: function.NewArgumentsData([]attr.Value{types.StringValue("https://www.googleapis.com/compute/v1/projects/my-project/zones/us-central1-b")}),
+			},
+			expected: function.RunResponse{
+				Result: function.NewResultData(types.StringValue(region)),
+			},
+		},
+		"it returns the expected output value when given a zone id": {
+			request: function.RunRequest{
+				Arguments: function.NewArgumentsData([]attr.Value{types.StringValue("projects/my-project/zones/us-central1-b")}),
+			},
+			expected: function.RunResponse{
+				Result: function.NewResultData(types.StringValue(region)),
+			},
+		},
 		"it returns an error when given input is empty": {
 			request: function.RunRequest{
 				Arguments: function.NewArgumentsData([]attr.Value{types.StringValue("")}),
@@ -48,6 +64,24 @@ func TestFunctionRun_region_from_zone(t *testing.T) {
 				Error:  function.NewArgumentFuncError(0, "The input string \"foobar\" is not a valid zone name."),
 			},
 		},
+		"it returns an error when given input is too short to be a zone": {
+			request: function.RunRequest{
+				Arguments: function.NewArgumentsData([]attr.Value{types.StringValue("a")}),
+			},
+			expected: function.RunResponse{
+				Result: function.NewResultData(types.StringNull()),
+				Error:  function.NewArgumentFuncError(0, "The input string \"a\" is not a valid zone name."),
+			},
+		},
+		"it returns an error when given a self link that doesn't end with a zone": {
+			request: function.RunRequest{
+				Arguments: function.NewArgumentsData([]attr.Value{types.StringValue("projects/my-project/zones/")}),
+			},
+			expected: function.RunResponse{
+				Result: function.NewResultData(types.StringNull()),
+				Error:  function.NewArgumentFuncError(0, "The input string \"projects/my-project/zones/\" is not a valid zone name."),
+			},
+		},
 	}
 
 	for name, testCase := range testCases {
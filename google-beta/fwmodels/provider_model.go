@@ -22,10 +22,21 @@ type ProviderModel struct {
 	UserProjectOverride                       types.Bool   `tfsdk:"user_project_override"`
 	RequestTimeout                            types.String `tfsdk:"request_timeout"`
 	RequestReason                             types.String `tfsdk:"request_reason"`
+	OperationPollInterval                     types.String `tfsdk:"operation_poll_interval"`
+	ApiRateLimits                             types.Map    `tfsdk:"api_rate_limits"`
+	UserAgentExtension                        types.String `tfsdk:"user_agent_extension"`
 	UniverseDomain                            types.String `tfsdk:"universe_domain"`
 	DefaultLabels                             types.Map    `tfsdk:"default_labels"`
+	DefaultKmsKeys                            types.Map    `tfsdk:"default_kms_key"`
+	DefaultTags                               types.List   `tfsdk:"default_tags"`
+	DefaultMetadata                           types.Map    `tfsdk:"default_metadata"`
 	AddTerraformAttributionLabel              types.Bool   `tfsdk:"add_terraform_attribution_label"`
 	TerraformAttributionLabelAdditionStrategy types.String `tfsdk:"terraform_attribution_label_addition_strategy"`
+	IgnoreAnnotationPrefixes                  types.List   `tfsdk:"ignore_annotation_prefixes"`
+	IgnoreServerSideChanges                   types.List   `tfsdk:"ignore_server_side_changes"`
+	ConditionalRefresh                        types.Bool   `tfsdk:"conditional_refresh"`
+	ApplyManifestPath                         types.String `tfsdk:"apply_manifest_path"`
+	ApplyManifestPubsubTopic                  types.String `tfsdk:"apply_manifest_pubsub_topic"`
 
 	// Generated Products
 	AccessApprovalCustomEndpoint           types.String `tfsdk:"access_approval_custom_endpoint"`
@@ -38,6 +49,7 @@ type ProviderModel struct {
 	ApphubCustomEndpoint                   types.String `tfsdk:"apphub_custom_endpoint"`
 	ArtifactRegistryCustomEndpoint         types.String `tfsdk:"artifact_registry_custom_endpoint"`
 	BackupDRCustomEndpoint                 types.String `tfsdk:"backup_dr_custom_endpoint"`
+	BareMetalSolutionCustomEndpoint        types.String `tfsdk:"bare_metal_solution_custom_endpoint"`
 	BeyondcorpCustomEndpoint               types.String `tfsdk:"beyondcorp_custom_endpoint"`
 	BiglakeCustomEndpoint                  types.String `tfsdk:"biglake_custom_endpoint"`
 	BigQueryCustomEndpoint                 types.String `tfsdk:"big_query_custom_endpoint"`
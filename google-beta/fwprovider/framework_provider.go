@@ -4,6 +4,7 @@ package fwprovider
 
 import (
 	"context"
+	"regexp"
 
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
@@ -132,6 +133,19 @@ func (p *FrameworkProvider) Schema(_ context.Context, _ provider.SchemaRequest,
 			"request_reason": schema.StringAttribute{
 				Optional: true,
 			},
+			"operation_poll_interval": schema.StringAttribute{
+				Optional: true,
+			},
+			"api_rate_limits": schema.MapAttribute{
+				Optional:    true,
+				ElementType: types.Float64Type,
+			},
+			"user_agent_extension": schema.StringAttribute{
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(regexp.MustCompile(`^[^\r\n]*$`), "must not contain carriage returns or newlines"),
+				},
+			},
 			"universe_domain": schema.StringAttribute{
 				Optional: true,
 			},
@@ -139,12 +153,41 @@ func (p *FrameworkProvider) Schema(_ context.Context, _ provider.SchemaRequest,
 				Optional:    true,
 				ElementType: types.StringType,
 			},
+			"default_kms_key": schema.MapAttribute{
+				Optional:    true,
+				ElementType: types.StringType,
+			},
+			"default_tags": schema.ListAttribute{
+				Optional:    true,
+				ElementType: types.StringType,
+			},
+			"default_metadata": schema.MapAttribute{
+				Optional:    true,
+				ElementType: types.StringType,
+			},
 			"add_terraform_attribution_label": schema.BoolAttribute{
 				Optional: true,
 			},
 			"terraform_attribution_label_addition_strategy": schema.StringAttribute{
 				Optional: true,
 			},
+			"ignore_annotation_prefixes": schema.ListAttribute{
+				Optional:    true,
+				ElementType: types.StringType,
+			},
+			"ignore_server_side_changes": schema.ListAttribute{
+				Optional:    true,
+				ElementType: types.StringType,
+			},
+			"conditional_refresh": schema.BoolAttribute{
+				Optional: true,
+			},
+			"apply_manifest_path": schema.StringAttribute{
+				Optional: true,
+			},
+			"apply_manifest_pubsub_topic": schema.StringAttribute{
+				Optional: true,
+			},
 			// Generated Products
 			"access_approval_custom_endpoint": &schema.StringAttribute{
 				Optional: true,
@@ -206,6 +249,12 @@ func (p *FrameworkProvider) Schema(_ context.Context, _ provider.SchemaRequest,
 					transport_tpg.CustomEndpointValidator(),
 				},
 			},
+			"bare_metal_solution_custom_endpoint": &schema.StringAttribute{
+				Optional: true,
+				Validators: []validator.String{
+					transport_tpg.CustomEndpointValidator(),
+				},
+			},
 			"beyondcorp_custom_endpoint": &schema.StringAttribute{
 				Optional: true,
 				Validators: []validator.String{
@@ -1059,6 +1108,7 @@ func (p *FrameworkProvider) Functions(_ context.Context) []func() function.Funct
 	return []func() function.Function{
 		functions.NewLocationFromIdFunction,
 		functions.NewNameFromIdFunction,
+		functions.NewParseIdFunction,
 		functions.NewProjectFromIdFunction,
 		functions.NewRegionFromIdFunction,
 		functions.NewRegionFromZoneFunction,
@@ -9,6 +9,7 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
+	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 
@@ -85,3 +86,51 @@ func TestFrameworkProvider_CredentialsValidator(t *testing.T) {
 		})
 	}
 }
+
+func TestFrameworkProvider_UserAgentExtensionValidators(t *testing.T) {
+	cases := map[string]struct {
+		ConfigValue        types.String
+		ExpectedErrorCount int
+	}{
+		"a product token is valid": {
+			ConfigValue: types.StringValue("my-module/1.0.0"),
+		},
+		"leaving user_agent_extension unconfigured is valid": {
+			ConfigValue: types.StringNull(),
+		},
+		"a newline is not valid": {
+			ConfigValue:        types.StringValue("my-module/1.0.0\nX-Injected: true"),
+			ExpectedErrorCount: 1,
+		},
+		"a carriage return is not valid": {
+			ConfigValue:        types.StringValue("my-module/1.0.0\r"),
+			ExpectedErrorCount: 1,
+		},
+	}
+
+	schemaResp := provider.SchemaResponse{}
+	New("test").Schema(context.Background(), provider.SchemaRequest{}, &schemaResp)
+	attr, ok := schemaResp.Schema.Attributes["user_agent_extension"].(schema.StringAttribute)
+	if !ok {
+		t.Fatalf("expected user_agent_extension to be a string attribute")
+	}
+
+	for tn, tc := range cases {
+		t.Run(tn, func(t *testing.T) {
+			req := validator.StringRequest{
+				ConfigValue: tc.ConfigValue,
+			}
+			resp := validator.StringResponse{
+				Diagnostics: diag.Diagnostics{},
+			}
+
+			for _, v := range attr.Validators {
+				v.ValidateString(context.Background(), req, &resp)
+			}
+
+			if resp.Diagnostics.ErrorsCount() != tc.ExpectedErrorCount {
+				t.Errorf("Expected %d errors, got %d: %v", tc.ExpectedErrorCount, resp.Diagnostics.ErrorsCount(), resp.Diagnostics)
+			}
+		})
+	}
+}
@@ -23,7 +23,6 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/logging"
 
 	"github.com/hashicorp/terraform-provider-google-beta/google-beta/fwmodels"
 	transport_tpg "github.com/hashicorp/terraform-provider-google-beta/google-beta/transport"
@@ -61,6 +60,7 @@ type FrameworkProviderConfig struct {
 	ApphubBasePath                   string
 	ArtifactRegistryBasePath         string
 	BackupDRBasePath                 string
+	BareMetalSolutionBasePath        string
 	BeyondcorpBasePath               string
 	BiglakeBasePath                  string
 	BigQueryBasePath                 string
@@ -196,7 +196,11 @@ func (p *FrameworkProviderConfig) LoadAndValidateFramework(ctx context.Context,
 	// Handle User Agent string
 	p.UserAgent = CompileUserAgentString(ctx, "terraform-provider-google-beta", tfVersion, providerversion)
 	// opt in extension for adding to the User-Agent header
-	if ext := os.Getenv("GOOGLE_TERRAFORM_USERAGENT_EXTENSION"); ext != "" {
+	ext := data.UserAgentExtension.ValueString()
+	if ext == "" {
+		ext = os.Getenv("GOOGLE_TERRAFORM_USERAGENT_EXTENSION")
+	}
+	if ext != "" {
 		ua := p.UserAgent
 		p.UserAgent = fmt.Sprintf("%s %s", ua, ext)
 	}
@@ -228,6 +232,7 @@ func (p *FrameworkProviderConfig) LoadAndValidateFramework(ctx context.Context,
 	p.ApphubBasePath = data.ApphubCustomEndpoint.ValueString()
 	p.ArtifactRegistryBasePath = data.ArtifactRegistryCustomEndpoint.ValueString()
 	p.BackupDRBasePath = data.BackupDRCustomEndpoint.ValueString()
+	p.BareMetalSolutionBasePath = data.BareMetalSolutionCustomEndpoint.ValueString()
 	p.BeyondcorpBasePath = data.BeyondcorpCustomEndpoint.ValueString()
 	p.BiglakeBasePath = data.BiglakeCustomEndpoint.ValueString()
 	p.BigQueryBasePath = data.BigQueryCustomEndpoint.ValueString()
@@ -354,7 +359,19 @@ func (p *FrameworkProviderConfig) LoadAndValidateFramework(ctx context.Context,
 	p.Scopes = data.Scopes
 	p.Zone = data.Zone
 	p.UserProjectOverride = data.UserProjectOverride
-	p.PollInterval = 10 * time.Second
+	p.PollInterval = transport_tpg.DefaultPollInterval
+	if !data.OperationPollInterval.IsNull() && data.OperationPollInterval.ValueString() != "" {
+		pollInterval, err := time.ParseDuration(data.OperationPollInterval.ValueString())
+		if err != nil {
+			diags.AddError("error parsing operation poll interval", err.Error())
+			return
+		}
+		if pollInterval < transport_tpg.MinPollInterval {
+			diags.AddError("invalid operation poll interval", fmt.Sprintf("operation_poll_interval must be at least %v, got %v", transport_tpg.MinPollInterval, pollInterval))
+			return
+		}
+		p.PollInterval = pollInterval
+	}
 	p.Project = data.Project
 	p.UniverseDomain = data.UniverseDomain
 	p.RequestBatcherServiceUsage = transport_tpg.NewRequestBatcher("Service Usage", ctx, batchingConfig)
@@ -553,6 +570,14 @@ func (p *FrameworkProviderConfig) HandleDefaults(ctx context.Context, data *fwmo
 			data.BackupDRCustomEndpoint = types.StringValue(customEndpoint.(string))
 		}
 	}
+	if data.BareMetalSolutionCustomEndpoint.IsNull() {
+		customEndpoint := transport_tpg.MultiEnvDefault([]string{
+			"GOOGLE_BARE_METAL_SOLUTION_CUSTOM_ENDPOINT",
+		}, transport_tpg.DefaultBasePaths[transport_tpg.BareMetalSolutionBasePathKey])
+		if customEndpoint != nil {
+			data.BareMetalSolutionCustomEndpoint = types.StringValue(customEndpoint.(string))
+		}
+	}
 	if data.BeyondcorpCustomEndpoint.IsNull() {
 		customEndpoint := transport_tpg.MultiEnvDefault([]string{
 			"GOOGLE_BEYONDCORP_CUSTOM_ENDPOINT",
@@ -1028,7 +1053,7 @@ func (p *FrameworkProviderConfig) HandleDefaults(ctx context.Context, data *fwmo
 	if data.FirestoreCustomEndpoint.IsNull() {
 		customEndpoint := transport_tpg.MultiEnvDefault([]string{
 			"GOOGLE_FIRESTORE_CUSTOM_ENDPOINT",
-		}, transport_tpg.DefaultBasePaths[transport_tpg.FirestoreBasePathKey])
+		}, transport_tpg.EmulatorBasePath("FIRESTORE_EMULATOR_HOST", "/v1/", transport_tpg.DefaultBasePaths[transport_tpg.FirestoreBasePathKey]))
 		if customEndpoint != nil {
 			data.FirestoreCustomEndpoint = types.StringValue(customEndpoint.(string))
 		}
@@ -1276,7 +1301,7 @@ func (p *FrameworkProviderConfig) HandleDefaults(ctx context.Context, data *fwmo
 	if data.PubsubCustomEndpoint.IsNull() {
 		customEndpoint := transport_tpg.MultiEnvDefault([]string{
 			"GOOGLE_PUBSUB_CUSTOM_ENDPOINT",
-		}, transport_tpg.DefaultBasePaths[transport_tpg.PubsubBasePathKey])
+		}, transport_tpg.EmulatorBasePath("PUBSUB_EMULATOR_HOST", "/v1/", transport_tpg.DefaultBasePaths[transport_tpg.PubsubBasePathKey]))
 		if customEndpoint != nil {
 			data.PubsubCustomEndpoint = types.StringValue(customEndpoint.(string))
 		}
@@ -1404,7 +1429,7 @@ func (p *FrameworkProviderConfig) HandleDefaults(ctx context.Context, data *fwmo
 	if data.StorageCustomEndpoint.IsNull() {
 		customEndpoint := transport_tpg.MultiEnvDefault([]string{
 			"GOOGLE_STORAGE_CUSTOM_ENDPOINT",
-		}, transport_tpg.DefaultBasePaths[transport_tpg.StorageBasePathKey])
+		}, transport_tpg.EmulatorBasePath("STORAGE_EMULATOR_HOST", "/storage/v1/", transport_tpg.DefaultBasePaths[transport_tpg.StorageBasePathKey]))
 		if customEndpoint != nil {
 			data.StorageCustomEndpoint = types.StringValue(customEndpoint.(string))
 		}
@@ -1713,17 +1738,32 @@ func (p *FrameworkProviderConfig) SetupClient(ctx context.Context, data fwmodels
 	}
 
 	// 2. Logging Transport - ensure we log HTTP requests to GCP APIs.
-	loggingTransport := logging.NewTransport("Google", client.Transport)
+	loggingTransport := transport_tpg.NewLoggingTransport("Google", client.Transport)
+
+	// 3. Rate Limit Transport - limits the requests made to each API, retries included.
+	rateLimits := make(map[string]float64)
+	if !data.ApiRateLimits.IsNull() {
+		diags.Append(data.ApiRateLimits.ElementsAs(ctx, &rateLimits, false)...)
+		if diags.HasError() {
+			return
+		}
+	}
+	rateLimitTransport := transport_tpg.NewRateLimitTransport(loggingTransport, rateLimits)
 
-	// 3. Retry Transport - retries common temporary errors
+	// 4. Retry Transport - retries common temporary errors
 	// Keep order for wrapping logging so we log each retried request as well.
 	// This value should be used if needed to create shallow copies with additional retry predicates.
 	// See ClientWithAdditionalRetries
-	retryTransport := transport_tpg.NewTransportWithDefaultRetries(loggingTransport)
+	retryTransport := transport_tpg.NewTransportWithDefaultRetries(rateLimitTransport)
+
+	// 5. Apply Manifest Transport - records mutating requests once, after retries.
+	// Records are published with the unwrapped client so that they aren't recorded themselves.
+	manifest := transport_tpg.NewApplyManifest(data.ApplyManifestPath.ValueString(), data.ApplyManifestPubsubTopic.ValueString(), data.PubsubCustomEndpoint.ValueString(), &http.Client{Transport: client.Transport})
+	manifestTransport := transport_tpg.NewApplyManifestTransport(retryTransport, manifest)
 
-	// 4. Header Transport - outer wrapper to inject additional headers we want to apply
+	// 6. Header Transport - outer wrapper to inject additional headers we want to apply
 	// before making requests
-	headerTransport := transport_tpg.NewTransportWithHeaders(retryTransport)
+	headerTransport := transport_tpg.NewTransportWithHeaders(manifestTransport)
 	if !data.RequestReason.IsNull() {
 		headerTransport.Set("X-Goog-Request-Reason", data.RequestReason.ValueString())
 	}
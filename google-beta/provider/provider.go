@@ -12,6 +12,8 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-google-beta/google-beta/tpgresource"
 	transport_tpg "github.com/hashicorp/terraform-provider-google-beta/google-beta/transport"
 	"github.com/hashicorp/terraform-provider-google-beta/google-beta/verify"
 	"github.com/hashicorp/terraform-provider-google-beta/version"
@@ -128,12 +130,48 @@ func Provider() *schema.Provider {
 				Optional: true,
 			},
 
+			"operation_poll_interval": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: verify.ValidateDurationAtLeast(transport_tpg.MinPollInterval),
+			},
+
+			"api_rate_limits": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeFloat},
+			},
+
+			"user_agent_extension": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringDoesNotContainAny("\r\n"),
+			},
+
 			"default_labels": {
 				Type:     schema.TypeMap,
 				Optional: true,
 				Elem:     &schema.Schema{Type: schema.TypeString},
 			},
 
+			"default_kms_key": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+
+			"default_tags": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+
+			"default_metadata": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+
 			"add_terraform_attribution_label": {
 				Type:     schema.TypeBool,
 				Optional: true,
@@ -144,6 +182,37 @@ func Provider() *schema.Provider {
 				Optional: true,
 			},
 
+			"ignore_annotation_prefixes": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+
+			"ignore_server_side_changes": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: verify.ValidateRegexp(`^google_[a-z0-9_]+\.[a-z0-9_]+$`),
+				},
+			},
+
+			"conditional_refresh": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+
+			"apply_manifest_path": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"apply_manifest_pubsub_topic": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: verify.ValidateRegexp(`^projects/[^/]+/topics/[^/]+$`),
+			},
+
 			// Generated Products
 			"access_approval_custom_endpoint": {
 				Type:         schema.TypeString,
@@ -195,6 +264,11 @@ func Provider() *schema.Provider {
 				Optional:     true,
 				ValidateFunc: transport_tpg.ValidateCustomEndpoint,
 			},
+			"bare_metal_solution_custom_endpoint": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: transport_tpg.ValidateCustomEndpoint,
+			},
 			"beyondcorp_custom_endpoint": {
 				Type:         schema.TypeString,
 				Optional:     true,
@@ -842,12 +916,16 @@ func ResourceMap() map[string]*schema.Resource {
 }
 
 func ResourceMapWithErrors() (map[string]*schema.Resource, error) {
-	return mergeResourceMaps(
+	resourceMap, err := mergeResourceMaps(
 		generatedResources,
 		handwrittenResources,
 		handwrittenIAMResources,
 		dclResources,
 	)
+	for name, r := range resourceMap {
+		resourceMap[name] = tpgresource.WithIgnoreServerSideChanges(name, r)
+	}
+	return resourceMap, err
 }
 
 func ProviderConfigure(ctx context.Context, d *schema.ResourceData, p *schema.Provider) (interface{}, diag.Diagnostics) {
@@ -866,7 +944,11 @@ func ProviderConfigure(ctx context.Context, d *schema.ResourceData, p *schema.Pr
 	}
 
 	// opt in extension for adding to the User-Agent header
-	if ext := os.Getenv("GOOGLE_TERRAFORM_USERAGENT_EXTENSION"); ext != "" {
+	ext := d.Get("user_agent_extension").(string)
+	if ext == "" {
+		ext = os.Getenv("GOOGLE_TERRAFORM_USERAGENT_EXTENSION")
+	}
+	if ext != "" {
 		ua := config.UserAgent
 		config.UserAgent = fmt.Sprintf("%s %s", ua, ext)
 	}
@@ -883,6 +965,19 @@ func ProviderConfigure(ctx context.Context, d *schema.ResourceData, p *schema.Pr
 		config.RequestReason = v.(string)
 	}
 
+	if v, ok := d.GetOk("operation_poll_interval"); ok {
+		var err error
+		config.PollInterval, err = time.ParseDuration(v.(string))
+		if err != nil {
+			return nil, diag.FromErr(err)
+		}
+	}
+
+	config.ApiRateLimits = make(map[string]float64)
+	for k, v := range d.Get("api_rate_limits").(map[string]interface{}) {
+		config.ApiRateLimits[k] = v.(float64)
+	}
+
 	// Check for primary credentials in config. Note that if neither is set, ADCs
 	// will be used if available.
 	if v, ok := d.GetOk("access_token"); ok {
@@ -979,6 +1074,45 @@ func ProviderConfigure(ctx context.Context, d *schema.ResourceData, p *schema.Pr
 		config.DefaultLabels[k] = v.(string)
 	}
 
+	for _, v := range d.Get("default_tags").([]interface{}) {
+		if v == nil {
+			continue
+		}
+		config.DefaultTags = append(config.DefaultTags, v.(string))
+	}
+
+	config.DefaultMetadata = make(map[string]string)
+	for k, v := range d.Get("default_metadata").(map[string]interface{}) {
+		config.DefaultMetadata[k] = v.(string)
+	}
+
+	config.DefaultKmsKeys = make(map[string]string)
+	defaultKmsKeys := d.Get("default_kms_key").(map[string]interface{})
+
+	for k, v := range defaultKmsKeys {
+		config.DefaultKmsKeys[strings.ToLower(k)] = v.(string)
+	}
+
+	for _, v := range d.Get("ignore_annotation_prefixes").([]interface{}) {
+		if v != nil {
+			config.IgnoreAnnotationPrefixes = append(config.IgnoreAnnotationPrefixes, v.(string))
+		}
+	}
+
+	config.IgnoreServerSideChanges = make(map[string][]string)
+	for _, v := range d.Get("ignore_server_side_changes").([]interface{}) {
+		if v == nil {
+			continue
+		}
+		resourceType, field, _ := strings.Cut(v.(string), ".")
+		config.IgnoreServerSideChanges[resourceType] = append(config.IgnoreServerSideChanges[resourceType], field)
+	}
+
+	config.ConditionalRefresh = d.Get("conditional_refresh").(bool)
+
+	config.ApplyManifestPath = d.Get("apply_manifest_path").(string)
+	config.ApplyManifestPubsubTopic = d.Get("apply_manifest_pubsub_topic").(string)
+
 	// Attribution label is opt-in; if unset, the default for AddTerraformAttributionLabel is false.
 	config.AddTerraformAttributionLabel = d.Get("add_terraform_attribution_label").(bool)
 	if config.AddTerraformAttributionLabel {
@@ -1010,6 +1144,7 @@ func ProviderConfigure(ctx context.Context, d *schema.ResourceData, p *schema.Pr
 	config.ApphubBasePath = d.Get("apphub_custom_endpoint").(string)
 	config.ArtifactRegistryBasePath = d.Get("artifact_registry_custom_endpoint").(string)
 	config.BackupDRBasePath = d.Get("backup_dr_custom_endpoint").(string)
+	config.BareMetalSolutionBasePath = d.Get("bare_metal_solution_custom_endpoint").(string)
 	config.BeyondcorpBasePath = d.Get("beyondcorp_custom_endpoint").(string)
 	config.BiglakeBasePath = d.Get("biglake_custom_endpoint").(string)
 	config.BigQueryBasePath = d.Get("big_query_custom_endpoint").(string)
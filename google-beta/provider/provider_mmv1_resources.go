@@ -15,6 +15,7 @@ import (
 	"github.com/hashicorp/terraform-provider-google-beta/google-beta/services/apphub"
 	"github.com/hashicorp/terraform-provider-google-beta/google-beta/services/artifactregistry"
 	"github.com/hashicorp/terraform-provider-google-beta/google-beta/services/backupdr"
+	"github.com/hashicorp/terraform-provider-google-beta/google-beta/services/baremetalsolution"
 	"github.com/hashicorp/terraform-provider-google-beta/google-beta/services/beyondcorp"
 	"github.com/hashicorp/terraform-provider-google-beta/google-beta/services/biglake"
 	"github.com/hashicorp/terraform-provider-google-beta/google-beta/services/bigquery"
@@ -263,6 +264,7 @@ var handwrittenDatasources = map[string]*schema.Resource{
 	"google_monitoring_mesh_istio_service":                monitoring.DataSourceMonitoringServiceMeshIstio(),
 	"google_monitoring_app_engine_service":                monitoring.DataSourceMonitoringServiceAppEngine(),
 	"google_monitoring_uptime_check_ips":                  monitoring.DataSourceGoogleMonitoringUptimeCheckIps(),
+	"google_monitoring_metrics_scope":                     monitoring.DataSourceMonitoringMetricsScope(),
 	"google_netblock_ip_ranges":                           resourcemanager.DataSourceGoogleNetblockIpRanges(),
 	"google_organization":                                 resourcemanager.DataSourceGoogleOrganization(),
 	"google_privateca_certificate_authority":              privateca.DataSourcePrivatecaCertificateAuthority(),
@@ -1178,6 +1180,9 @@ var handwrittenResources = map[string]*schema.Resource{
 	"google_apigee_flowhook":                        apigee.ResourceApigeeFlowhook(),
 	"google_apigee_keystores_aliases_pkcs12":        apigee.ResourceApigeeKeystoresAliasesPkcs12(),
 	"google_apigee_keystores_aliases_key_cert_file": apigee.ResourceApigeeKeystoresAliasesKeyCertFile(),
+	"google_bare_metal_solution_instance":           baremetalsolution.ResourceBareMetalSolutionInstance(),
+	"google_bare_metal_solution_network":            baremetalsolution.ResourceBareMetalSolutionNetwork(),
+	"google_bare_metal_solution_nfs_share":          baremetalsolution.ResourceBareMetalSolutionNfsShare(),
 	"google_bigquery_table":                         bigquery.ResourceBigQueryTable(),
 	"google_bigtable_gc_policy":                     bigtable.ResourceBigtableGCPolicy(),
 	"google_bigtable_instance":                      bigtable.ResourceBigtableInstance(),
@@ -1188,6 +1193,7 @@ var handwrittenResources = map[string]*schema.Resource{
 	"google_compute_attached_disk":                  compute.ResourceComputeAttachedDisk(),
 	"google_compute_instance":                       compute.ResourceComputeInstance(),
 	"google_compute_disk_async_replication":         compute.ResourceComputeDiskAsyncReplication(),
+	"google_compute_future_reservation":             compute.ResourceComputeFutureReservation(),
 	"google_compute_router_peer":                    compute.ResourceComputeRouterBgpPeer(),
 	"google_compute_instance_from_machine_image":    compute.ResourceComputeInstanceFromMachineImage(),
 	"google_compute_instance_from_template":         compute.ResourceComputeInstanceFromTemplate(),
@@ -1204,6 +1210,7 @@ var handwrittenResources = map[string]*schema.Resource{
 	"google_compute_security_policy":                compute.ResourceComputeSecurityPolicy(),
 	"google_compute_shared_vpc_host_project":        compute.ResourceComputeSharedVpcHostProject(),
 	"google_compute_shared_vpc_service_project":     compute.ResourceComputeSharedVpcServiceProject(),
+	"google_compute_subnetwork_secondary_range":     compute.ResourceComputeSubnetworkSecondaryRange(),
 	"google_compute_target_pool":                    compute.ResourceComputeTargetPool(),
 	"google_container_cluster":                      container.ResourceContainerCluster(),
 	"google_container_node_pool":                    container.ResourceContainerNodePool(),
@@ -1212,10 +1219,13 @@ var handwrittenResources = map[string]*schema.Resource{
 	"google_dataflow_flex_template_job":             dataflow.ResourceDataflowFlexTemplateJob(),
 	"google_dataproc_cluster":                       dataproc.ResourceDataprocCluster(),
 	"google_dataproc_job":                           dataproc.ResourceDataprocJob(),
+	"google_dataproc_batch":                         dataproc.ResourceDataprocBatch(),
+	"google_dataproc_session_template":              dataproc.ResourceDataprocSessionTemplate(),
 	"google_dns_record_set":                         dns.ResourceDnsRecordSet(),
 	"google_endpoints_service":                      servicemanagement.ResourceEndpointsService(),
 	"google_folder":                                 resourcemanager.ResourceGoogleFolder(),
 	"google_folder_organization_policy":             resourcemanager.ResourceGoogleFolderOrganizationPolicy(),
+	"google_iap_settings":                           iap.ResourceIapSettings(),
 	"google_logging_billing_account_sink":           logging.ResourceLoggingBillingAccountSink(),
 	"google_logging_billing_account_exclusion":      logging.ResourceLoggingExclusion(logging.BillingAccountLoggingExclusionSchema, logging.NewBillingAccountLoggingExclusionUpdater, logging.BillingAccountLoggingExclusionIdParseFunc),
 	"google_logging_billing_account_bucket_config":  logging.ResourceLoggingBillingAccountBucketConfig(),
@@ -1254,8 +1264,10 @@ var handwrittenResources = map[string]*schema.Resource{
 	"google_storage_object_acl":                     storage.ResourceStorageObjectAcl(),
 	"google_storage_default_object_acl":             storage.ResourceStorageDefaultObjectAcl(),
 	"google_storage_notification":                   storage.ResourceStorageNotification(),
+	"google_storage_notification_webhook":           storage.ResourceStorageNotificationWebhook(),
 	"google_storage_transfer_job":                   storagetransfer.ResourceStorageTransferJob(),
 	"google_tags_location_tag_binding":              tags.ResourceTagsLocationTagBinding(),
+	"google_tags_tag_hold":                          tags.ResourceTagsTagHold(),
 	// ####### END handwritten resources ###########
 }
 
@@ -67,9 +67,12 @@ A maximum of 10 enrolled services will be enforced, to be expanded as the set of
 				Description: `ID of the project of the access approval settings.`,
 			},
 			"active_key_version": {
-				Type:     schema.TypeString,
-				Optional: true,
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: verify.ValidateRegexp(`^(projects/[^/]+/locations/[^/]+/keyRings/[^/]+/cryptoKeys/[^/]+/cryptoKeyVersions/[^/]+)?$`),
 				Description: `The asymmetric crypto key version to use for signing approval requests.
+The key version must be in the format
+projects/{project}/locations/{location}/keyRings/{key_ring}/cryptoKeys/{crypto_key}/cryptoKeyVersions/{version}.
 Empty active_key_version indicates that a Google-managed key should be used for signing.
 This property will be ignored if set by an ancestor of the resource, and new non-empty values may not be set.`,
 			},
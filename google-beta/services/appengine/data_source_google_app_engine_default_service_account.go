@@ -4,6 +4,7 @@ package appengine
 
 import (
 	"fmt"
+	"strings"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-google-beta/google-beta/tpgresource"
@@ -55,7 +56,7 @@ func dataSourceGoogleAppEngineDefaultServiceAccountRead(d *schema.ResourceData,
 		return err
 	}
 
-	serviceAccountEmail := fmt.Sprintf("%s@appspot.gserviceaccount.com", project)
+	serviceAccountEmail := appEngineDefaultServiceAccountEmail(project)
 
 	serviceAccountName, err := tpgresource.ServiceAccountFQN(serviceAccountEmail, d, config)
 	if err != nil {
@@ -89,3 +90,13 @@ func dataSourceGoogleAppEngineDefaultServiceAccountRead(d *schema.ResourceData,
 
 	return nil
 }
+
+// appEngineDefaultServiceAccountEmail returns the email of the App Engine default
+// service account for a project. Domain-scoped project IDs such as
+// "example.com:my-project" use the "my-project.example.com" form in the email.
+func appEngineDefaultServiceAccountEmail(project string) string {
+	if domain, name, ok := strings.Cut(project, ":"); ok {
+		project = fmt.Sprintf("%s.%s", name, domain)
+	}
+	return fmt.Sprintf("%s@appspot.gserviceaccount.com", project)
+}
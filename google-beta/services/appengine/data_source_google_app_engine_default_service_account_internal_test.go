@@ -0,0 +1,29 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+package appengine
+
+import (
+	"testing"
+)
+
+func TestAppEngineDefaultServiceAccountEmail(t *testing.T) {
+	cases := map[string]struct {
+		Project  string
+		Expected string
+	}{
+		"project": {
+			Project:  "my-project",
+			Expected: "my-project@appspot.gserviceaccount.com",
+		},
+		"domain-scoped project": {
+			Project:  "example.com:my-project",
+			Expected: "my-project.example.com@appspot.gserviceaccount.com",
+		},
+	}
+
+	for tn, tc := range cases {
+		if got := appEngineDefaultServiceAccountEmail(tc.Project); got != tc.Expected {
+			t.Errorf("bad: %s, expected %q, got %q", tn, tc.Expected, got)
+		}
+	}
+}
@@ -0,0 +1,83 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+package baremetalsolution
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/terraform-provider-google-beta/google-beta/tpgresource"
+	transport_tpg "github.com/hashicorp/terraform-provider-google-beta/google-beta/transport"
+)
+
+// bareMetalSolutionInstancePowerAction returns the instance action that moves
+// an instance in state current to the desired power state, or "" if nothing
+// needs to be done.
+func bareMetalSolutionInstancePowerAction(current, desired string) string {
+	switch {
+	case desired == "" || desired == current:
+		return ""
+	case desired == "RUNNING" && current != "STARTING":
+		return "start"
+	case desired == "SHUTDOWN" && current != "STOPPING":
+		return "stop"
+	}
+	return ""
+}
+
+// bareMetalSolutionInstanceMergeLabels returns the labels read from an existing
+// instance, overridden by the configured ones.
+func bareMetalSolutionInstanceMergeLabels(existing interface{}, configured map[string]string) map[string]string {
+	merged := make(map[string]string)
+	if m, ok := existing.(map[string]interface{}); ok {
+		for k, v := range m {
+			if s, ok := v.(string); ok {
+				merged[k] = s
+			}
+		}
+	}
+	for k, v := range configured {
+		merged[k] = v
+	}
+	return merged
+}
+
+// bareMetalSolutionInstanceSetPowerState starts or stops the instance at url
+// so that it ends up in the desired power state.
+func bareMetalSolutionInstanceSetPowerState(config *transport_tpg.Config, d tpgresource.TerraformResourceData, url, project, billingProject, userAgent, desired string, timeout time.Duration) error {
+	res, err := transport_tpg.SendRequest(transport_tpg.SendRequestOptions{
+		Config:    config,
+		Method:    "GET",
+		Project:   billingProject,
+		RawURL:    url,
+		UserAgent: userAgent,
+	})
+	if err != nil {
+		return fmt.Errorf("Error reading Instance power state: %s", err)
+	}
+
+	current, _ := res["state"].(string)
+	action := bareMetalSolutionInstancePowerAction(current, desired)
+	if action == "" {
+		return nil
+	}
+
+	log.Printf("[DEBUG] Changing power state of Instance %q from %s to %s", d.Id(), current, desired)
+	op, err := transport_tpg.SendRequest(transport_tpg.SendRequestOptions{
+		Config:    config,
+		Method:    "POST",
+		Project:   billingProject,
+		RawURL:    fmt.Sprintf("%s:%s", url, action),
+		UserAgent: userAgent,
+		Body:      map[string]interface{}{},
+		Timeout:   timeout,
+	})
+	if err != nil {
+		return fmt.Errorf("Error calling %s on Instance %q: %s", action, d.Id(), err)
+	}
+
+	return BareMetalSolutionOperationWaitTime(
+		config, op, project, fmt.Sprintf("Changing Instance power state to %s", desired), userAgent,
+		timeout)
+}
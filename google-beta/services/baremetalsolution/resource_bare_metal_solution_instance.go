@@ -0,0 +1,747 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+package baremetalsolution
+
+import (
+	"fmt"
+	"log"
+	"reflect"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/hashicorp/terraform-provider-google-beta/google-beta/tpgresource"
+	transport_tpg "github.com/hashicorp/terraform-provider-google-beta/google-beta/transport"
+	"github.com/hashicorp/terraform-provider-google-beta/google-beta/verify"
+)
+
+func ResourceBareMetalSolutionInstance() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceBareMetalSolutionInstanceCreate,
+		Read:   resourceBareMetalSolutionInstanceRead,
+		Update: resourceBareMetalSolutionInstanceUpdate,
+		Delete: resourceBareMetalSolutionInstanceDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: resourceBareMetalSolutionInstanceImport,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(20 * time.Minute),
+			Update: schema.DefaultTimeout(20 * time.Minute),
+			Delete: schema.DefaultTimeout(20 * time.Minute),
+		},
+
+		CustomizeDiff: customdiff.All(
+			tpgresource.SetLabelsDiff,
+			tpgresource.DefaultProviderProject,
+		),
+
+		Schema: map[string]*schema.Schema{
+			"location": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: `The region of the instance.`,
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: `The name of the instance, as assigned by Bare Metal Solution.`,
+			},
+			"hyperthreading_enabled": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Optional:    true,
+				Description: `True if you enable hyperthreading for the server, otherwise false.`,
+			},
+			"labels": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				Description: `Labels as key value pairs.
+
+**Note**: This field is non-authoritative, and will only manage the labels present in your configuration.
+Please refer to the field 'effective_labels' for all of the labels present on the resource.`,
+				Elem: &schema.Schema{Type: schema.TypeString},
+			},
+			"power_state": {
+				Type:         schema.TypeString,
+				Computed:     true,
+				Optional:     true,
+				ValidateFunc: verify.ValidateEnum([]string{"RUNNING", "SHUTDOWN", ""}),
+				Description: `The desired power state of the instance. The instance is started or stopped
+to reach this state. Possible values: ["RUNNING", "SHUTDOWN"]`,
+			},
+			"create_time": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: `Create a time stamp.`,
+			},
+			"effective_labels": {
+				Type:        schema.TypeMap,
+				Computed:    true,
+				Description: `All of labels (key/value pairs) present on the resource in GCP, including the labels configured through Terraform, other clients and services.`,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"instance_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: `An identifier for the instance, generated by the backend.`,
+			},
+			"interactive_serial_console_enabled": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: `True if the interactive serial console feature is enabled for the instance.`,
+			},
+			"luns": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: `List of LUNs associated with this server.`,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"boot_lun": {
+							Type:        schema.TypeBool,
+							Computed:    true,
+							Description: `Display if this LUN is a boot LUN.`,
+						},
+						"name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: `The name of the LUN.`,
+						},
+						"size_gb": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: `The size of this LUN, in gigabytes.`,
+						},
+						"state": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: `The state of this storage volume.`,
+						},
+						"storage_volume": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: `Display the storage volume for this LUN.`,
+						},
+						"wwid": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: `The WWID for this LUN.`,
+						},
+					},
+				},
+			},
+			"machine_type": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: `The server type.`,
+			},
+			"networks": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: `List of networks associated with this server.`,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"cidr": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: `The cidr of the network.`,
+						},
+						"ip_address": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: `IP address configured.`,
+						},
+						"name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: `The name of the network.`,
+						},
+						"state": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: `The network state.`,
+						},
+						"type": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: `The type of this network.`,
+						},
+						"vlan_id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: `The vlan id of the network.`,
+						},
+					},
+				},
+			},
+			"os_image": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: `The OS image currently installed on the server.`,
+			},
+			"pod": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: `The pod the instance belongs to.`,
+			},
+			"state": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: `The state of the server.`,
+			},
+			"terraform_labels": {
+				Type:     schema.TypeMap,
+				Computed: true,
+				Description: `The combination of labels configured directly on the resource
+ and default labels configured on the provider.`,
+				Elem: &schema.Schema{Type: schema.TypeString},
+			},
+			"update_time": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: `Update a time stamp.`,
+			},
+			"project": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+		},
+		UseJSONNumber: true,
+	}
+}
+
+func resourceBareMetalSolutionInstanceCreate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*transport_tpg.Config)
+	userAgent, err := tpgresource.GenerateUserAgentString(d, config.UserAgent)
+	if err != nil {
+		return err
+	}
+
+	obj := make(map[string]interface{})
+	hyperthreadingEnabledProp, err := expandBareMetalSolutionInstanceHyperthreadingEnabled(d.Get("hyperthreading_enabled"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("hyperthreading_enabled"); ok || !reflect.DeepEqual(v, hyperthreadingEnabledProp) {
+		obj["hyperthreadingEnabled"] = hyperthreadingEnabledProp
+	}
+	labelsProp, err := expandBareMetalSolutionInstanceEffectiveLabels(d.Get("effective_labels"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("effective_labels"); !tpgresource.IsEmptyValue(reflect.ValueOf(labelsProp)) && (ok || !reflect.DeepEqual(v, labelsProp)) {
+		obj["labels"] = labelsProp
+	}
+
+	url, err := tpgresource.ReplaceVars(d, config, "{{BareMetalSolutionBasePath}}projects/{{project}}/locations/{{location}}/instances/{{name}}")
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Creating new Instance: %#v", obj)
+	billingProject := ""
+
+	project, err := tpgresource.GetProject(d, config)
+	if err != nil {
+		return fmt.Errorf("Error fetching project for Instance: %s", err)
+	}
+	billingProject = project
+
+	// err == nil indicates that the billing_project value was found
+	if bp, err := tpgresource.GetBillingProject(d, config); err == nil {
+		billingProject = bp
+	}
+
+	// Instances are provisioned out of band, so creating the resource adopts the
+	// existing instance and applies the configured fields.
+	existing, err := transport_tpg.SendRequest(transport_tpg.SendRequestOptions{
+		Config:    config,
+		Method:    "GET",
+		Project:   billingProject,
+		RawURL:    url,
+		UserAgent: userAgent,
+	})
+	if err != nil {
+		if transport_tpg.IsGoogleApiErrorWithCode(err, 404) {
+			return fmt.Errorf("Instance %q does not exist. Bare Metal Solution servers are provisioned by Google and can only be adopted once they exist: %s", d.Get("name"), err)
+		}
+		return fmt.Errorf("Error reading Instance to adopt it: %s", err)
+	}
+
+	// labels is non-authoritative, so keep the labels already on the server.
+	if labels, ok := obj["labels"].(map[string]string); ok {
+		obj["labels"] = bareMetalSolutionInstanceMergeLabels(existing["labels"], labels)
+	}
+
+	updateMask := []string{}
+	if _, ok := d.GetOkExists("hyperthreading_enabled"); ok {
+		updateMask = append(updateMask, "hyperthreading_enabled")
+	} else {
+		delete(obj, "hyperthreadingEnabled")
+	}
+	if _, ok := obj["labels"]; ok {
+		updateMask = append(updateMask, "labels")
+	}
+
+	if len(updateMask) > 0 {
+		patchUrl, err := transport_tpg.AddQueryParams(url, map[string]string{"updateMask": strings.Join(updateMask, ",")})
+		if err != nil {
+			return err
+		}
+
+		res, err := transport_tpg.SendRequest(transport_tpg.SendRequestOptions{
+			Config:    config,
+			Method:    "PATCH",
+			Project:   billingProject,
+			RawURL:    patchUrl,
+			UserAgent: userAgent,
+			Body:      obj,
+			Timeout:   d.Timeout(schema.TimeoutCreate),
+		})
+		if err != nil {
+			return fmt.Errorf("Error creating Instance: %s", err)
+		}
+
+		err = BareMetalSolutionOperationWaitTime(
+			config, res, project, "Creating Instance", userAgent,
+			d.Timeout(schema.TimeoutCreate))
+
+		if err != nil {
+			return fmt.Errorf("Error waiting to create Instance: %s", err)
+		}
+	}
+
+	// Store the ID now
+	id, err := tpgresource.ReplaceVars(d, config, "projects/{{project}}/locations/{{location}}/instances/{{name}}")
+	if err != nil {
+		return fmt.Errorf("Error constructing id: %s", err)
+	}
+	d.SetId(id)
+
+	if v, ok := d.GetOk("power_state"); ok {
+		if err := bareMetalSolutionInstanceSetPowerState(config, d, url, project, billingProject, userAgent, v.(string), d.Timeout(schema.TimeoutCreate)); err != nil {
+			d.SetId("")
+			return err
+		}
+	}
+
+	log.Printf("[DEBUG] Finished creating Instance %q", d.Id())
+
+	return resourceBareMetalSolutionInstanceRead(d, meta)
+}
+
+func resourceBareMetalSolutionInstanceRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*transport_tpg.Config)
+	userAgent, err := tpgresource.GenerateUserAgentString(d, config.UserAgent)
+	if err != nil {
+		return err
+	}
+
+	url, err := tpgresource.ReplaceVars(d, config, "{{BareMetalSolutionBasePath}}projects/{{project}}/locations/{{location}}/instances/{{name}}")
+	if err != nil {
+		return err
+	}
+
+	billingProject := ""
+
+	project, err := tpgresource.GetProject(d, config)
+	if err != nil {
+		return fmt.Errorf("Error fetching project for Instance: %s", err)
+	}
+	billingProject = project
+
+	// err == nil indicates that the billing_project value was found
+	if bp, err := tpgresource.GetBillingProject(d, config); err == nil {
+		billingProject = bp
+	}
+
+	res, err := transport_tpg.SendRequest(transport_tpg.SendRequestOptions{
+		Config:    config,
+		Method:    "GET",
+		Project:   billingProject,
+		RawURL:    url,
+		UserAgent: userAgent,
+	})
+	if err != nil {
+		return transport_tpg.HandleNotFoundError(err, d, fmt.Sprintf("BareMetalSolutionInstance %q", d.Id()))
+	}
+
+	if err := d.Set("project", project); err != nil {
+		return fmt.Errorf("Error reading Instance: %s", err)
+	}
+
+	if err := d.Set("instance_id", flattenBareMetalSolutionInstanceInstanceId(res["id"], d, config)); err != nil {
+		return fmt.Errorf("Error reading Instance: %s", err)
+	}
+	if err := d.Set("create_time", flattenBareMetalSolutionInstanceCreateTime(res["createTime"], d, config)); err != nil {
+		return fmt.Errorf("Error reading Instance: %s", err)
+	}
+	if err := d.Set("update_time", flattenBareMetalSolutionInstanceUpdateTime(res["updateTime"], d, config)); err != nil {
+		return fmt.Errorf("Error reading Instance: %s", err)
+	}
+	if err := d.Set("machine_type", flattenBareMetalSolutionInstanceMachineType(res["machineType"], d, config)); err != nil {
+		return fmt.Errorf("Error reading Instance: %s", err)
+	}
+	if err := d.Set("state", flattenBareMetalSolutionInstanceState(res["state"], d, config)); err != nil {
+		return fmt.Errorf("Error reading Instance: %s", err)
+	}
+	if err := d.Set("power_state", flattenBareMetalSolutionInstancePowerState(res["state"], d, config)); err != nil {
+		return fmt.Errorf("Error reading Instance: %s", err)
+	}
+	if err := d.Set("hyperthreading_enabled", flattenBareMetalSolutionInstanceHyperthreadingEnabled(res["hyperthreadingEnabled"], d, config)); err != nil {
+		return fmt.Errorf("Error reading Instance: %s", err)
+	}
+	if err := d.Set("labels", flattenBareMetalSolutionInstanceLabels(res["labels"], d, config)); err != nil {
+		return fmt.Errorf("Error reading Instance: %s", err)
+	}
+	if err := d.Set("luns", flattenBareMetalSolutionInstanceLuns(res["luns"], d, config)); err != nil {
+		return fmt.Errorf("Error reading Instance: %s", err)
+	}
+	if err := d.Set("networks", flattenBareMetalSolutionInstanceNetworks(res["networks"], d, config)); err != nil {
+		return fmt.Errorf("Error reading Instance: %s", err)
+	}
+	if err := d.Set("interactive_serial_console_enabled", flattenBareMetalSolutionInstanceInteractiveSerialConsoleEnabled(res["interactiveSerialConsoleEnabled"], d, config)); err != nil {
+		return fmt.Errorf("Error reading Instance: %s", err)
+	}
+	if err := d.Set("os_image", flattenBareMetalSolutionInstanceOsImage(res["osImage"], d, config)); err != nil {
+		return fmt.Errorf("Error reading Instance: %s", err)
+	}
+	if err := d.Set("pod", flattenBareMetalSolutionInstancePod(res["pod"], d, config)); err != nil {
+		return fmt.Errorf("Error reading Instance: %s", err)
+	}
+	if err := d.Set("terraform_labels", flattenBareMetalSolutionInstanceTerraformLabels(res["labels"], d, config)); err != nil {
+		return fmt.Errorf("Error reading Instance: %s", err)
+	}
+	if err := d.Set("effective_labels", flattenBareMetalSolutionInstanceEffectiveLabels(res["labels"], d, config)); err != nil {
+		return fmt.Errorf("Error reading Instance: %s", err)
+	}
+
+	return nil
+}
+
+func resourceBareMetalSolutionInstanceUpdate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*transport_tpg.Config)
+	userAgent, err := tpgresource.GenerateUserAgentString(d, config.UserAgent)
+	if err != nil {
+		return err
+	}
+
+	billingProject := ""
+
+	project, err := tpgresource.GetProject(d, config)
+	if err != nil {
+		return fmt.Errorf("Error fetching project for Instance: %s", err)
+	}
+	billingProject = project
+
+	obj := make(map[string]interface{})
+	hyperthreadingEnabledProp, err := expandBareMetalSolutionInstanceHyperthreadingEnabled(d.Get("hyperthreading_enabled"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("hyperthreading_enabled"); ok || !reflect.DeepEqual(v, hyperthreadingEnabledProp) {
+		obj["hyperthreadingEnabled"] = hyperthreadingEnabledProp
+	}
+	labelsProp, err := expandBareMetalSolutionInstanceEffectiveLabels(d.Get("effective_labels"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("effective_labels"); !tpgresource.IsEmptyValue(reflect.ValueOf(v)) && (ok || !reflect.DeepEqual(v, labelsProp)) {
+		obj["labels"] = labelsProp
+	}
+
+	url, err := tpgresource.ReplaceVars(d, config, "{{BareMetalSolutionBasePath}}projects/{{project}}/locations/{{location}}/instances/{{name}}")
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Updating Instance %q: %#v", d.Id(), obj)
+	updateMask := []string{}
+
+	if d.HasChange("hyperthreading_enabled") {
+		updateMask = append(updateMask, "hyperthreading_enabled")
+	}
+
+	if d.HasChange("effective_labels") {
+		updateMask = append(updateMask, "labels")
+	}
+	// updateMask is a URL parameter but not present in the schema, so ReplaceVars
+	// won't set it
+	patchUrl, err := transport_tpg.AddQueryParams(url, map[string]string{"updateMask": strings.Join(updateMask, ",")})
+	if err != nil {
+		return err
+	}
+
+	// err == nil indicates that the billing_project value was found
+	if bp, err := tpgresource.GetBillingProject(d, config); err == nil {
+		billingProject = bp
+	}
+
+	// if updateMask is empty we are not updating anything so skip the post
+	if len(updateMask) > 0 {
+		res, err := transport_tpg.SendRequest(transport_tpg.SendRequestOptions{
+			Config:    config,
+			Method:    "PATCH",
+			Project:   billingProject,
+			RawURL:    patchUrl,
+			UserAgent: userAgent,
+			Body:      obj,
+			Timeout:   d.Timeout(schema.TimeoutUpdate),
+		})
+
+		if err != nil {
+			return fmt.Errorf("Error updating Instance %q: %s", d.Id(), err)
+		} else {
+			log.Printf("[DEBUG] Finished updating Instance %q: %#v", d.Id(), res)
+		}
+
+		err = BareMetalSolutionOperationWaitTime(
+			config, res, project, "Updating Instance", userAgent,
+			d.Timeout(schema.TimeoutUpdate))
+
+		if err != nil {
+			return err
+		}
+	}
+
+	if d.HasChange("power_state") {
+		if err := bareMetalSolutionInstanceSetPowerState(config, d, url, project, billingProject, userAgent, d.Get("power_state").(string), d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return err
+		}
+	}
+
+	return resourceBareMetalSolutionInstanceRead(d, meta)
+}
+
+func resourceBareMetalSolutionInstanceDelete(d *schema.ResourceData, meta interface{}) error {
+	log.Printf("[WARNING] BareMetalSolution Instance resources"+
+		" cannot be deleted from Google Cloud. The resource %s will be removed from Terraform"+
+		" state, but will still be present on Google Cloud.", d.Id())
+	d.SetId("")
+
+	return nil
+}
+
+func resourceBareMetalSolutionInstanceImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	config := meta.(*transport_tpg.Config)
+	if err := tpgresource.ParseImportId([]string{
+		"^projects/(?P<project>[^/]+)/locations/(?P<location>[^/]+)/instances/(?P<name>[^/]+)$",
+		"^(?P<project>[^/]+)/(?P<location>[^/]+)/(?P<name>[^/]+)$",
+		"^(?P<location>[^/]+)/(?P<name>[^/]+)$",
+	}, d, config); err != nil {
+		return nil, err
+	}
+
+	// Replace import id for the resource id
+	id, err := tpgresource.ReplaceVars(d, config, "projects/{{project}}/locations/{{location}}/instances/{{name}}")
+	if err != nil {
+		return nil, fmt.Errorf("Error constructing id: %s", err)
+	}
+	d.SetId(id)
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func flattenBareMetalSolutionInstanceInstanceId(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
+	return v
+}
+
+func flattenBareMetalSolutionInstanceCreateTime(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
+	return v
+}
+
+func flattenBareMetalSolutionInstanceUpdateTime(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
+	return v
+}
+
+func flattenBareMetalSolutionInstanceMachineType(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
+	return v
+}
+
+func flattenBareMetalSolutionInstanceState(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
+	return v
+}
+
+// power_state only tracks the stable RUNNING and SHUTDOWN states; while the
+// instance is transitioning the previous value is kept.
+func flattenBareMetalSolutionInstancePowerState(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
+	if s, ok := v.(string); ok && (s == "RUNNING" || s == "SHUTDOWN") {
+		return s
+	}
+	return d.Get("power_state")
+}
+
+func flattenBareMetalSolutionInstanceHyperthreadingEnabled(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
+	return v
+}
+
+func flattenBareMetalSolutionInstanceLabels(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
+	if v == nil {
+		return v
+	}
+
+	transformed := make(map[string]interface{})
+	if l, ok := d.GetOkExists("labels"); ok {
+		for k := range l.(map[string]interface{}) {
+			transformed[k] = v.(map[string]interface{})[k]
+		}
+	}
+
+	return transformed
+}
+
+func flattenBareMetalSolutionInstanceLuns(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
+	if v == nil {
+		return v
+	}
+	l := v.([]interface{})
+	transformed := make([]interface{}, 0, len(l))
+	for _, raw := range l {
+		original := raw.(map[string]interface{})
+		if len(original) < 1 {
+			// Do not include empty json objects coming back from the api
+			continue
+		}
+		transformed = append(transformed, map[string]interface{}{
+			"name":           flattenBareMetalSolutionInstanceLunsName(original["name"], d, config),
+			"size_gb":        flattenBareMetalSolutionInstanceLunsSizeGb(original["sizeGb"], d, config),
+			"state":          flattenBareMetalSolutionInstanceLunsState(original["state"], d, config),
+			"storage_volume": flattenBareMetalSolutionInstanceLunsStorageVolume(original["storageVolume"], d, config),
+			"wwid":           flattenBareMetalSolutionInstanceLunsWwid(original["wwid"], d, config),
+			"boot_lun":       flattenBareMetalSolutionInstanceLunsBootLun(original["bootLun"], d, config),
+		})
+	}
+	return transformed
+}
+func flattenBareMetalSolutionInstanceLunsName(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
+	return v
+}
+
+func flattenBareMetalSolutionInstanceLunsSizeGb(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
+	// Handles the string fixed64 format
+	if strVal, ok := v.(string); ok {
+		if intVal, err := tpgresource.StringToFixed64(strVal); err == nil {
+			return intVal
+		}
+	}
+
+	// number values are represented as float64
+	if floatVal, ok := v.(float64); ok {
+		intVal := int(floatVal)
+		return intVal
+	}
+
+	return v // let terraform core handle it otherwise
+}
+
+func flattenBareMetalSolutionInstanceLunsState(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
+	return v
+}
+
+func flattenBareMetalSolutionInstanceLunsStorageVolume(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
+	return v
+}
+
+func flattenBareMetalSolutionInstanceLunsWwid(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
+	return v
+}
+
+func flattenBareMetalSolutionInstanceLunsBootLun(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
+	return v
+}
+
+func flattenBareMetalSolutionInstanceNetworks(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
+	if v == nil {
+		return v
+	}
+	l := v.([]interface{})
+	transformed := make([]interface{}, 0, len(l))
+	for _, raw := range l {
+		original := raw.(map[string]interface{})
+		if len(original) < 1 {
+			// Do not include empty json objects coming back from the api
+			continue
+		}
+		transformed = append(transformed, map[string]interface{}{
+			"name":       flattenBareMetalSolutionInstanceNetworksName(original["name"], d, config),
+			"type":       flattenBareMetalSolutionInstanceNetworksType(original["type"], d, config),
+			"ip_address": flattenBareMetalSolutionInstanceNetworksIpAddress(original["ipAddress"], d, config),
+			"cidr":       flattenBareMetalSolutionInstanceNetworksCidr(original["cidr"], d, config),
+			"vlan_id":    flattenBareMetalSolutionInstanceNetworksVlanId(original["vlanId"], d, config),
+			"state":      flattenBareMetalSolutionInstanceNetworksState(original["state"], d, config),
+		})
+	}
+	return transformed
+}
+func flattenBareMetalSolutionInstanceNetworksName(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
+	return v
+}
+
+func flattenBareMetalSolutionInstanceNetworksType(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
+	return v
+}
+
+func flattenBareMetalSolutionInstanceNetworksIpAddress(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
+	return v
+}
+
+func flattenBareMetalSolutionInstanceNetworksCidr(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
+	return v
+}
+
+func flattenBareMetalSolutionInstanceNetworksVlanId(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
+	return v
+}
+
+func flattenBareMetalSolutionInstanceNetworksState(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
+	return v
+}
+
+func flattenBareMetalSolutionInstanceInteractiveSerialConsoleEnabled(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
+	return v
+}
+
+func flattenBareMetalSolutionInstanceOsImage(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
+	return v
+}
+
+func flattenBareMetalSolutionInstancePod(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
+	return v
+}
+
+func flattenBareMetalSolutionInstanceTerraformLabels(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
+	if v == nil {
+		return v
+	}
+
+	transformed := make(map[string]interface{})
+	if l, ok := d.GetOkExists("terraform_labels"); ok {
+		for k := range l.(map[string]interface{}) {
+			transformed[k] = v.(map[string]interface{})[k]
+		}
+	}
+
+	return transformed
+}
+
+func flattenBareMetalSolutionInstanceEffectiveLabels(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
+	return v
+}
+
+func expandBareMetalSolutionInstanceHyperthreadingEnabled(v interface{}, d tpgresource.TerraformResourceData, config *transport_tpg.Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandBareMetalSolutionInstanceEffectiveLabels(v interface{}, d tpgresource.TerraformResourceData, config *transport_tpg.Config) (map[string]string, error) {
+	if v == nil {
+		return map[string]string{}, nil
+	}
+	m := make(map[string]string)
+	for k, val := range v.(map[string]interface{}) {
+		m[k] = val.(string)
+	}
+	return m, nil
+}
@@ -0,0 +1,58 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+package baremetalsolution
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestBareMetalSolutionInstancePowerAction(t *testing.T) {
+	t.Parallel()
+
+	cases := map[string]struct {
+		Current  string
+		Desired  string
+		Expected string
+	}{
+		"unset":              {Current: "RUNNING", Desired: "", Expected: ""},
+		"already running":    {Current: "RUNNING", Desired: "RUNNING", Expected: ""},
+		"start":              {Current: "SHUTDOWN", Desired: "RUNNING", Expected: "start"},
+		"already starting":   {Current: "STARTING", Desired: "RUNNING", Expected: ""},
+		"stop":               {Current: "RUNNING", Desired: "SHUTDOWN", Expected: "stop"},
+		"already stopping":   {Current: "STOPPING", Desired: "SHUTDOWN", Expected: ""},
+		"stop while booting": {Current: "STARTING", Desired: "SHUTDOWN", Expected: "stop"},
+	}
+
+	for tn, tc := range cases {
+		if got := bareMetalSolutionInstancePowerAction(tc.Current, tc.Desired); got != tc.Expected {
+			t.Errorf("%s: expected action %q, got %q", tn, tc.Expected, got)
+		}
+	}
+}
+
+func TestBareMetalSolutionInstanceMergeLabels(t *testing.T) {
+	t.Parallel()
+
+	existing := map[string]interface{}{
+		"env":  "prod",
+		"team": "infra",
+	}
+	configured := map[string]string{
+		"env":   "staging",
+		"owner": "me",
+	}
+
+	expected := map[string]string{
+		"env":   "staging",
+		"team":  "infra",
+		"owner": "me",
+	}
+	if got := bareMetalSolutionInstanceMergeLabels(existing, configured); !reflect.DeepEqual(got, expected) {
+		t.Errorf("expected %v, got %v", expected, got)
+	}
+
+	if got := bareMetalSolutionInstanceMergeLabels(nil, configured); !reflect.DeepEqual(got, configured) {
+		t.Errorf("expected %v for an instance without labels, got %v", configured, got)
+	}
+}
@@ -0,0 +1,119 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+package baremetalsolution_test
+
+import (
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	"github.com/hashicorp/terraform-provider-google-beta/google-beta/acctest"
+	"github.com/hashicorp/terraform-provider-google-beta/google-beta/envvar"
+)
+
+// Bare Metal Solution servers and networks are provisioned by Google, so these
+// tests adopt existing ones, given as projects/{project}/locations/{location}/{collection}/{name}.
+func TestAccBareMetalSolutionInstance_update(t *testing.T) {
+	envvar.SkipIfEnvNotSet(t, "GOOGLE_BMS_INSTANCE")
+	t.Parallel()
+
+	instance := strings.Split(os.Getenv("GOOGLE_BMS_INSTANCE"), "/")
+	context := map[string]interface{}{
+		"location": instance[3],
+		"name":     instance[5],
+	}
+
+	acctest.VcrTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.AccTestPreCheck(t) },
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories(t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBareMetalSolutionInstance_basic(context, "RUNNING", "one"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("google_bare_metal_solution_instance.server", "state", "RUNNING"),
+					resource.TestCheckResourceAttrSet("google_bare_metal_solution_instance.server", "luns.0.name"),
+				),
+			},
+			{
+				ResourceName:            "google_bare_metal_solution_instance.server",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"labels", "terraform_labels"},
+			},
+			{
+				Config: testAccBareMetalSolutionInstance_basic(context, "SHUTDOWN", "two"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("google_bare_metal_solution_instance.server", "state", "SHUTDOWN"),
+				),
+			},
+			{
+				Config: testAccBareMetalSolutionInstance_basic(context, "RUNNING", "two"),
+			},
+		},
+	})
+}
+
+func testAccBareMetalSolutionInstance_basic(context map[string]interface{}, powerState, label string) string {
+	context["power_state"] = powerState
+	context["label"] = label
+	return acctest.Nprintf(`
+resource "google_bare_metal_solution_instance" "server" {
+  name        = "%{name}"
+  location    = "%{location}"
+  power_state = "%{power_state}"
+
+  labels = {
+    tf-test = "%{label}"
+  }
+}
+`, context)
+}
+
+func TestAccBareMetalSolutionNetwork_update(t *testing.T) {
+	envvar.SkipIfEnvNotSet(t, "GOOGLE_BMS_NETWORK")
+	t.Parallel()
+
+	network := strings.Split(os.Getenv("GOOGLE_BMS_NETWORK"), "/")
+	context := map[string]interface{}{
+		"location": network[3],
+		"name":     network[5],
+	}
+
+	acctest.VcrTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.AccTestPreCheck(t) },
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories(t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBareMetalSolutionNetwork_basic(context, "one"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("google_bare_metal_solution_network.network", "cidr"),
+				),
+			},
+			{
+				ResourceName:            "google_bare_metal_solution_network.network",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"labels", "terraform_labels"},
+			},
+			{
+				Config: testAccBareMetalSolutionNetwork_basic(context, "two"),
+			},
+		},
+	})
+}
+
+func testAccBareMetalSolutionNetwork_basic(context map[string]interface{}, label string) string {
+	context["label"] = label
+	return acctest.Nprintf(`
+resource "google_bare_metal_solution_network" "network" {
+  name     = "%{name}"
+  location = "%{location}"
+
+  labels = {
+    tf-test = "%{label}"
+  }
+}
+`, context)
+}
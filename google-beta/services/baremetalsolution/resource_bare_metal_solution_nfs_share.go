@@ -0,0 +1,753 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+package baremetalsolution
+
+import (
+	"fmt"
+	"log"
+	"reflect"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/hashicorp/terraform-provider-google-beta/google-beta/tpgresource"
+	transport_tpg "github.com/hashicorp/terraform-provider-google-beta/google-beta/transport"
+	"github.com/hashicorp/terraform-provider-google-beta/google-beta/verify"
+)
+
+func ResourceBareMetalSolutionNfsShare() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceBareMetalSolutionNfsShareCreate,
+		Read:   resourceBareMetalSolutionNfsShareRead,
+		Update: resourceBareMetalSolutionNfsShareUpdate,
+		Delete: resourceBareMetalSolutionNfsShareDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: resourceBareMetalSolutionNfsShareImport,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(20 * time.Minute),
+			Update: schema.DefaultTimeout(20 * time.Minute),
+			Delete: schema.DefaultTimeout(20 * time.Minute),
+		},
+
+		CustomizeDiff: customdiff.All(
+			tpgresource.SetLabelsDiff,
+			tpgresource.DefaultProviderProject,
+		),
+
+		Schema: map[string]*schema.Schema{
+			"location": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: `The region of the NFS share.`,
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: `The name of the NFS share.`,
+			},
+			"allowed_clients": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: `List of allowed access points.`,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"allowed_clients_cidr": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: `The subnet of IP addresses permitted to access the share.`,
+						},
+						"network": {
+							Type:             schema.TypeString,
+							Required:         true,
+							DiffSuppressFunc: tpgresource.CompareSelfLinkOrResourceName,
+							Description: `The network the access point sits on, in the format
+projects/{project}/locations/{location}/networks/{network}.`,
+						},
+						"allow_dev": {
+							Type:        schema.TypeBool,
+							Optional:    true,
+							Description: `Allow dev flag, which controls whether to allow creation of devices.`,
+						},
+						"allow_suid": {
+							Type:        schema.TypeBool,
+							Optional:    true,
+							Description: `Allow the setuid flag.`,
+						},
+						"mount_permissions": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: verify.ValidateEnum([]string{"READ", "READ_WRITE", ""}),
+							Description:  `Mount permissions. Possible values: ["READ", "READ_WRITE"]`,
+						},
+						"no_root_squash": {
+							Type:        schema.TypeBool,
+							Optional:    true,
+							Description: `Disable root squashing, which is a feature of NFS.`,
+						},
+						"nfs_path": {
+							Type:     schema.TypeString,
+							Computed: true,
+							Description: `The path to access NFS, in format shareIP:/InstanceID, for example
+"10.0.0.0:/g123456789-nfs001".`,
+						},
+						"share_ip": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: `The IP address of the share on this network.`,
+						},
+					},
+				},
+			},
+			"labels": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				Description: `Labels as key value pairs.
+
+**Note**: This field is non-authoritative, and will only manage the labels present in your configuration.
+Please refer to the field 'effective_labels' for all of the labels present on the resource.`,
+				Elem: &schema.Schema{Type: schema.TypeString},
+			},
+			"pod": {
+				Type:     schema.TypeString,
+				Computed: true,
+				Optional: true,
+				ForceNew: true,
+				Description: `The pod of the NFS share. The share can only be connected to the networks
+and instances allocated in the same pod.`,
+			},
+			"requested_size_gib": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				ForceNew:    true,
+				Description: `The requested size, in GiB.`,
+			},
+			"storage_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidateEnum([]string{"SSD", "HDD", ""}),
+				Description:  `The storage type of the underlying volume. Possible values: ["SSD", "HDD"]`,
+			},
+			"effective_labels": {
+				Type:        schema.TypeMap,
+				Computed:    true,
+				Description: `All of labels (key/value pairs) present on the resource in GCP, including the labels configured through Terraform, other clients and services.`,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"nfs_share_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: `An identifier for the NFS share, generated by the backend.`,
+			},
+			"state": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: `The state of the NFS share.`,
+			},
+			"terraform_labels": {
+				Type:     schema.TypeMap,
+				Computed: true,
+				Description: `The combination of labels configured directly on the resource
+ and default labels configured on the provider.`,
+				Elem: &schema.Schema{Type: schema.TypeString},
+			},
+			"volume": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: `The underlying volume of the share, created automatically during provisioning.`,
+			},
+			"project": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+		},
+		UseJSONNumber: true,
+	}
+}
+
+func resourceBareMetalSolutionNfsShareCreate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*transport_tpg.Config)
+	userAgent, err := tpgresource.GenerateUserAgentString(d, config.UserAgent)
+	if err != nil {
+		return err
+	}
+
+	obj := make(map[string]interface{})
+	nameProp, err := expandBareMetalSolutionNfsShareName(d.Get("name"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("name"); !tpgresource.IsEmptyValue(reflect.ValueOf(nameProp)) && (ok || !reflect.DeepEqual(v, nameProp)) {
+		obj["name"] = nameProp
+	}
+	allowedClientsProp, err := expandBareMetalSolutionNfsShareAllowedClients(d.Get("allowed_clients"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("allowed_clients"); !tpgresource.IsEmptyValue(reflect.ValueOf(allowedClientsProp)) && (ok || !reflect.DeepEqual(v, allowedClientsProp)) {
+		obj["allowedClients"] = allowedClientsProp
+	}
+	requestedSizeGibProp, err := expandBareMetalSolutionNfsShareRequestedSizeGib(d.Get("requested_size_gib"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("requested_size_gib"); !tpgresource.IsEmptyValue(reflect.ValueOf(requestedSizeGibProp)) && (ok || !reflect.DeepEqual(v, requestedSizeGibProp)) {
+		obj["requestedSizeGib"] = requestedSizeGibProp
+	}
+	storageTypeProp, err := expandBareMetalSolutionNfsShareStorageType(d.Get("storage_type"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("storage_type"); !tpgresource.IsEmptyValue(reflect.ValueOf(storageTypeProp)) && (ok || !reflect.DeepEqual(v, storageTypeProp)) {
+		obj["storageType"] = storageTypeProp
+	}
+	podProp, err := expandBareMetalSolutionNfsSharePod(d.Get("pod"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("pod"); !tpgresource.IsEmptyValue(reflect.ValueOf(podProp)) && (ok || !reflect.DeepEqual(v, podProp)) {
+		obj["pod"] = podProp
+	}
+	labelsProp, err := expandBareMetalSolutionNfsShareEffectiveLabels(d.Get("effective_labels"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("effective_labels"); !tpgresource.IsEmptyValue(reflect.ValueOf(labelsProp)) && (ok || !reflect.DeepEqual(v, labelsProp)) {
+		obj["labels"] = labelsProp
+	}
+
+	url, err := tpgresource.ReplaceVars(d, config, "{{BareMetalSolutionBasePath}}projects/{{project}}/locations/{{location}}/nfsShares")
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Creating new NfsShare: %#v", obj)
+	billingProject := ""
+
+	project, err := tpgresource.GetProject(d, config)
+	if err != nil {
+		return fmt.Errorf("Error fetching project for NfsShare: %s", err)
+	}
+	billingProject = project
+
+	// err == nil indicates that the billing_project value was found
+	if bp, err := tpgresource.GetBillingProject(d, config); err == nil {
+		billingProject = bp
+	}
+
+	res, err := transport_tpg.SendRequest(transport_tpg.SendRequestOptions{
+		Config:    config,
+		Method:    "POST",
+		Project:   billingProject,
+		RawURL:    url,
+		UserAgent: userAgent,
+		Body:      obj,
+		Timeout:   d.Timeout(schema.TimeoutCreate),
+	})
+	if err != nil {
+		return fmt.Errorf("Error creating NfsShare: %s", err)
+	}
+
+	// Store the ID now
+	id, err := tpgresource.ReplaceVars(d, config, "projects/{{project}}/locations/{{location}}/nfsShares/{{name}}")
+	if err != nil {
+		return fmt.Errorf("Error constructing id: %s", err)
+	}
+	d.SetId(id)
+
+	err = BareMetalSolutionOperationWaitTime(
+		config, res, project, "Creating NfsShare", userAgent,
+		d.Timeout(schema.TimeoutCreate))
+
+	if err != nil {
+		// The resource didn't actually create
+		d.SetId("")
+		return fmt.Errorf("Error waiting to create NfsShare: %s", err)
+	}
+
+	log.Printf("[DEBUG] Finished creating NfsShare %q: %#v", d.Id(), res)
+
+	return resourceBareMetalSolutionNfsShareRead(d, meta)
+}
+
+func resourceBareMetalSolutionNfsShareRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*transport_tpg.Config)
+	userAgent, err := tpgresource.GenerateUserAgentString(d, config.UserAgent)
+	if err != nil {
+		return err
+	}
+
+	url, err := tpgresource.ReplaceVars(d, config, "{{BareMetalSolutionBasePath}}projects/{{project}}/locations/{{location}}/nfsShares/{{name}}")
+	if err != nil {
+		return err
+	}
+
+	billingProject := ""
+
+	project, err := tpgresource.GetProject(d, config)
+	if err != nil {
+		return fmt.Errorf("Error fetching project for NfsShare: %s", err)
+	}
+	billingProject = project
+
+	// err == nil indicates that the billing_project value was found
+	if bp, err := tpgresource.GetBillingProject(d, config); err == nil {
+		billingProject = bp
+	}
+
+	res, err := transport_tpg.SendRequest(transport_tpg.SendRequestOptions{
+		Config:    config,
+		Method:    "GET",
+		Project:   billingProject,
+		RawURL:    url,
+		UserAgent: userAgent,
+	})
+	if err != nil {
+		return transport_tpg.HandleNotFoundError(err, d, fmt.Sprintf("BareMetalSolutionNfsShare %q", d.Id()))
+	}
+
+	if err := d.Set("project", project); err != nil {
+		return fmt.Errorf("Error reading NfsShare: %s", err)
+	}
+
+	if err := d.Set("name", flattenBareMetalSolutionNfsShareName(res["name"], d, config)); err != nil {
+		return fmt.Errorf("Error reading NfsShare: %s", err)
+	}
+	if err := d.Set("nfs_share_id", flattenBareMetalSolutionNfsShareNfsShareId(res["id"], d, config)); err != nil {
+		return fmt.Errorf("Error reading NfsShare: %s", err)
+	}
+	if err := d.Set("state", flattenBareMetalSolutionNfsShareState(res["state"], d, config)); err != nil {
+		return fmt.Errorf("Error reading NfsShare: %s", err)
+	}
+	if err := d.Set("volume", flattenBareMetalSolutionNfsShareVolume(res["volume"], d, config)); err != nil {
+		return fmt.Errorf("Error reading NfsShare: %s", err)
+	}
+	if err := d.Set("allowed_clients", flattenBareMetalSolutionNfsShareAllowedClients(res["allowedClients"], d, config)); err != nil {
+		return fmt.Errorf("Error reading NfsShare: %s", err)
+	}
+	if err := d.Set("labels", flattenBareMetalSolutionNfsShareLabels(res["labels"], d, config)); err != nil {
+		return fmt.Errorf("Error reading NfsShare: %s", err)
+	}
+	if err := d.Set("requested_size_gib", flattenBareMetalSolutionNfsShareRequestedSizeGib(res["requestedSizeGib"], d, config)); err != nil {
+		return fmt.Errorf("Error reading NfsShare: %s", err)
+	}
+	if err := d.Set("storage_type", flattenBareMetalSolutionNfsShareStorageType(res["storageType"], d, config)); err != nil {
+		return fmt.Errorf("Error reading NfsShare: %s", err)
+	}
+	if err := d.Set("pod", flattenBareMetalSolutionNfsSharePod(res["pod"], d, config)); err != nil {
+		return fmt.Errorf("Error reading NfsShare: %s", err)
+	}
+	if err := d.Set("terraform_labels", flattenBareMetalSolutionNfsShareTerraformLabels(res["labels"], d, config)); err != nil {
+		return fmt.Errorf("Error reading NfsShare: %s", err)
+	}
+	if err := d.Set("effective_labels", flattenBareMetalSolutionNfsShareEffectiveLabels(res["labels"], d, config)); err != nil {
+		return fmt.Errorf("Error reading NfsShare: %s", err)
+	}
+
+	return nil
+}
+
+func resourceBareMetalSolutionNfsShareUpdate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*transport_tpg.Config)
+	userAgent, err := tpgresource.GenerateUserAgentString(d, config.UserAgent)
+	if err != nil {
+		return err
+	}
+
+	billingProject := ""
+
+	project, err := tpgresource.GetProject(d, config)
+	if err != nil {
+		return fmt.Errorf("Error fetching project for NfsShare: %s", err)
+	}
+	billingProject = project
+
+	obj := make(map[string]interface{})
+	allowedClientsProp, err := expandBareMetalSolutionNfsShareAllowedClients(d.Get("allowed_clients"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("allowed_clients"); !tpgresource.IsEmptyValue(reflect.ValueOf(v)) && (ok || !reflect.DeepEqual(v, allowedClientsProp)) {
+		obj["allowedClients"] = allowedClientsProp
+	}
+	labelsProp, err := expandBareMetalSolutionNfsShareEffectiveLabels(d.Get("effective_labels"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("effective_labels"); !tpgresource.IsEmptyValue(reflect.ValueOf(v)) && (ok || !reflect.DeepEqual(v, labelsProp)) {
+		obj["labels"] = labelsProp
+	}
+
+	url, err := tpgresource.ReplaceVars(d, config, "{{BareMetalSolutionBasePath}}projects/{{project}}/locations/{{location}}/nfsShares/{{name}}")
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Updating NfsShare %q: %#v", d.Id(), obj)
+	updateMask := []string{}
+
+	if d.HasChange("allowed_clients") {
+		updateMask = append(updateMask, "allowedClients")
+	}
+
+	if d.HasChange("effective_labels") {
+		updateMask = append(updateMask, "labels")
+	}
+	// updateMask is a URL parameter but not present in the schema, so ReplaceVars
+	// won't set it
+	url, err = transport_tpg.AddQueryParams(url, map[string]string{"updateMask": strings.Join(updateMask, ",")})
+	if err != nil {
+		return err
+	}
+
+	// err == nil indicates that the billing_project value was found
+	if bp, err := tpgresource.GetBillingProject(d, config); err == nil {
+		billingProject = bp
+	}
+
+	// if updateMask is empty we are not updating anything so skip the post
+	if len(updateMask) > 0 {
+		res, err := transport_tpg.SendRequest(transport_tpg.SendRequestOptions{
+			Config:    config,
+			Method:    "PATCH",
+			Project:   billingProject,
+			RawURL:    url,
+			UserAgent: userAgent,
+			Body:      obj,
+			Timeout:   d.Timeout(schema.TimeoutUpdate),
+		})
+
+		if err != nil {
+			return fmt.Errorf("Error updating NfsShare %q: %s", d.Id(), err)
+		} else {
+			log.Printf("[DEBUG] Finished updating NfsShare %q: %#v", d.Id(), res)
+		}
+
+		err = BareMetalSolutionOperationWaitTime(
+			config, res, project, "Updating NfsShare", userAgent,
+			d.Timeout(schema.TimeoutUpdate))
+
+		if err != nil {
+			return err
+		}
+	}
+
+	return resourceBareMetalSolutionNfsShareRead(d, meta)
+}
+
+func resourceBareMetalSolutionNfsShareDelete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*transport_tpg.Config)
+	userAgent, err := tpgresource.GenerateUserAgentString(d, config.UserAgent)
+	if err != nil {
+		return err
+	}
+
+	billingProject := ""
+
+	project, err := tpgresource.GetProject(d, config)
+	if err != nil {
+		return fmt.Errorf("Error fetching project for NfsShare: %s", err)
+	}
+	billingProject = project
+
+	url, err := tpgresource.ReplaceVars(d, config, "{{BareMetalSolutionBasePath}}projects/{{project}}/locations/{{location}}/nfsShares/{{name}}")
+	if err != nil {
+		return err
+	}
+
+	var obj map[string]interface{}
+
+	// err == nil indicates that the billing_project value was found
+	if bp, err := tpgresource.GetBillingProject(d, config); err == nil {
+		billingProject = bp
+	}
+
+	log.Printf("[DEBUG] Deleting NfsShare %q", d.Id())
+	res, err := transport_tpg.SendRequest(transport_tpg.SendRequestOptions{
+		Config:    config,
+		Method:    "DELETE",
+		Project:   billingProject,
+		RawURL:    url,
+		UserAgent: userAgent,
+		Body:      obj,
+		Timeout:   d.Timeout(schema.TimeoutDelete),
+	})
+	if err != nil {
+		return transport_tpg.HandleNotFoundError(err, d, "NfsShare")
+	}
+
+	err = BareMetalSolutionOperationWaitTime(
+		config, res, project, "Deleting NfsShare", userAgent,
+		d.Timeout(schema.TimeoutDelete))
+
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Finished deleting NfsShare %q: %#v", d.Id(), res)
+	return nil
+}
+
+func resourceBareMetalSolutionNfsShareImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	config := meta.(*transport_tpg.Config)
+	if err := tpgresource.ParseImportId([]string{
+		"^projects/(?P<project>[^/]+)/locations/(?P<location>[^/]+)/nfsShares/(?P<name>[^/]+)$",
+		"^(?P<project>[^/]+)/(?P<location>[^/]+)/(?P<name>[^/]+)$",
+		"^(?P<location>[^/]+)/(?P<name>[^/]+)$",
+	}, d, config); err != nil {
+		return nil, err
+	}
+
+	// Replace import id for the resource id
+	id, err := tpgresource.ReplaceVars(d, config, "projects/{{project}}/locations/{{location}}/nfsShares/{{name}}")
+	if err != nil {
+		return nil, fmt.Errorf("Error constructing id: %s", err)
+	}
+	d.SetId(id)
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func flattenBareMetalSolutionNfsShareName(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
+	if v == nil {
+		return v
+	}
+	return tpgresource.NameFromSelfLinkStateFunc(v)
+}
+
+func flattenBareMetalSolutionNfsShareNfsShareId(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
+	return v
+}
+
+func flattenBareMetalSolutionNfsShareState(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
+	return v
+}
+
+func flattenBareMetalSolutionNfsShareVolume(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
+	return v
+}
+
+func flattenBareMetalSolutionNfsShareAllowedClients(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
+	if v == nil {
+		return v
+	}
+	l := v.([]interface{})
+	transformed := make([]interface{}, 0, len(l))
+	for _, raw := range l {
+		original := raw.(map[string]interface{})
+		if len(original) < 1 {
+			// Do not include empty json objects coming back from the api
+			continue
+		}
+		transformed = append(transformed, map[string]interface{}{
+			"network":              flattenBareMetalSolutionNfsShareAllowedClientsNetwork(original["network"], d, config),
+			"allowed_clients_cidr": flattenBareMetalSolutionNfsShareAllowedClientsAllowedClientsCidr(original["allowedClientsCidr"], d, config),
+			"mount_permissions":    flattenBareMetalSolutionNfsShareAllowedClientsMountPermissions(original["mountPermissions"], d, config),
+			"allow_dev":            flattenBareMetalSolutionNfsShareAllowedClientsAllowDev(original["allowDev"], d, config),
+			"allow_suid":           flattenBareMetalSolutionNfsShareAllowedClientsAllowSuid(original["allowSuid"], d, config),
+			"no_root_squash":       flattenBareMetalSolutionNfsShareAllowedClientsNoRootSquash(original["noRootSquash"], d, config),
+			"share_ip":             flattenBareMetalSolutionNfsShareAllowedClientsShareIp(original["shareIp"], d, config),
+			"nfs_path":             flattenBareMetalSolutionNfsShareAllowedClientsNfsPath(original["nfsPath"], d, config),
+		})
+	}
+	return transformed
+}
+func flattenBareMetalSolutionNfsShareAllowedClientsNetwork(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
+	return v
+}
+
+func flattenBareMetalSolutionNfsShareAllowedClientsAllowedClientsCidr(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
+	return v
+}
+
+func flattenBareMetalSolutionNfsShareAllowedClientsMountPermissions(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
+	return v
+}
+
+func flattenBareMetalSolutionNfsShareAllowedClientsAllowDev(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
+	return v
+}
+
+func flattenBareMetalSolutionNfsShareAllowedClientsAllowSuid(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
+	return v
+}
+
+func flattenBareMetalSolutionNfsShareAllowedClientsNoRootSquash(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
+	return v
+}
+
+func flattenBareMetalSolutionNfsShareAllowedClientsShareIp(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
+	return v
+}
+
+func flattenBareMetalSolutionNfsShareAllowedClientsNfsPath(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
+	return v
+}
+
+func flattenBareMetalSolutionNfsShareLabels(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
+	if v == nil {
+		return v
+	}
+
+	transformed := make(map[string]interface{})
+	if l, ok := d.GetOkExists("labels"); ok {
+		for k := range l.(map[string]interface{}) {
+			transformed[k] = v.(map[string]interface{})[k]
+		}
+	}
+
+	return transformed
+}
+
+func flattenBareMetalSolutionNfsShareRequestedSizeGib(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
+	// Handles the string fixed64 format
+	if strVal, ok := v.(string); ok {
+		if intVal, err := tpgresource.StringToFixed64(strVal); err == nil {
+			return intVal
+		}
+	}
+
+	// number values are represented as float64
+	if floatVal, ok := v.(float64); ok {
+		intVal := int(floatVal)
+		return intVal
+	}
+
+	return v // let terraform core handle it otherwise
+}
+
+func flattenBareMetalSolutionNfsShareStorageType(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
+	return v
+}
+
+func flattenBareMetalSolutionNfsSharePod(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
+	return v
+}
+
+func flattenBareMetalSolutionNfsShareTerraformLabels(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
+	if v == nil {
+		return v
+	}
+
+	transformed := make(map[string]interface{})
+	if l, ok := d.GetOkExists("terraform_labels"); ok {
+		for k := range l.(map[string]interface{}) {
+			transformed[k] = v.(map[string]interface{})[k]
+		}
+	}
+
+	return transformed
+}
+
+func flattenBareMetalSolutionNfsShareEffectiveLabels(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
+	return v
+}
+
+func expandBareMetalSolutionNfsShareName(v interface{}, d tpgresource.TerraformResourceData, config *transport_tpg.Config) (interface{}, error) {
+	return tpgresource.ReplaceVars(d, config, "projects/{{project}}/locations/{{location}}/nfsShares/{{name}}")
+}
+
+func expandBareMetalSolutionNfsShareAllowedClients(v interface{}, d tpgresource.TerraformResourceData, config *transport_tpg.Config) (interface{}, error) {
+	l := v.([]interface{})
+	req := make([]interface{}, 0, len(l))
+	for _, raw := range l {
+		if raw == nil {
+			continue
+		}
+		original := raw.(map[string]interface{})
+		transformed := make(map[string]interface{})
+
+		transformedNetwork, err := expandBareMetalSolutionNfsShareAllowedClientsNetwork(original["network"], d, config)
+		if err != nil {
+			return nil, err
+		} else if val := reflect.ValueOf(transformedNetwork); val.IsValid() && !tpgresource.IsEmptyValue(val) {
+			transformed["network"] = transformedNetwork
+		}
+
+		transformedAllowedClientsCidr, err := expandBareMetalSolutionNfsShareAllowedClientsAllowedClientsCidr(original["allowed_clients_cidr"], d, config)
+		if err != nil {
+			return nil, err
+		} else if val := reflect.ValueOf(transformedAllowedClientsCidr); val.IsValid() && !tpgresource.IsEmptyValue(val) {
+			transformed["allowedClientsCidr"] = transformedAllowedClientsCidr
+		}
+
+		transformedMountPermissions, err := expandBareMetalSolutionNfsShareAllowedClientsMountPermissions(original["mount_permissions"], d, config)
+		if err != nil {
+			return nil, err
+		} else if val := reflect.ValueOf(transformedMountPermissions); val.IsValid() && !tpgresource.IsEmptyValue(val) {
+			transformed["mountPermissions"] = transformedMountPermissions
+		}
+
+		transformedAllowDev, err := expandBareMetalSolutionNfsShareAllowedClientsAllowDev(original["allow_dev"], d, config)
+		if err != nil {
+			return nil, err
+		} else if val := reflect.ValueOf(transformedAllowDev); val.IsValid() && !tpgresource.IsEmptyValue(val) {
+			transformed["allowDev"] = transformedAllowDev
+		}
+
+		transformedAllowSuid, err := expandBareMetalSolutionNfsShareAllowedClientsAllowSuid(original["allow_suid"], d, config)
+		if err != nil {
+			return nil, err
+		} else if val := reflect.ValueOf(transformedAllowSuid); val.IsValid() && !tpgresource.IsEmptyValue(val) {
+			transformed["allowSuid"] = transformedAllowSuid
+		}
+
+		transformedNoRootSquash, err := expandBareMetalSolutionNfsShareAllowedClientsNoRootSquash(original["no_root_squash"], d, config)
+		if err != nil {
+			return nil, err
+		} else if val := reflect.ValueOf(transformedNoRootSquash); val.IsValid() && !tpgresource.IsEmptyValue(val) {
+			transformed["noRootSquash"] = transformedNoRootSquash
+		}
+
+		req = append(req, transformed)
+	}
+	return req, nil
+}
+
+func expandBareMetalSolutionNfsShareAllowedClientsNetwork(v interface{}, d tpgresource.TerraformResourceData, config *transport_tpg.Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandBareMetalSolutionNfsShareAllowedClientsAllowedClientsCidr(v interface{}, d tpgresource.TerraformResourceData, config *transport_tpg.Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandBareMetalSolutionNfsShareAllowedClientsMountPermissions(v interface{}, d tpgresource.TerraformResourceData, config *transport_tpg.Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandBareMetalSolutionNfsShareAllowedClientsAllowDev(v interface{}, d tpgresource.TerraformResourceData, config *transport_tpg.Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandBareMetalSolutionNfsShareAllowedClientsAllowSuid(v interface{}, d tpgresource.TerraformResourceData, config *transport_tpg.Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandBareMetalSolutionNfsShareAllowedClientsNoRootSquash(v interface{}, d tpgresource.TerraformResourceData, config *transport_tpg.Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandBareMetalSolutionNfsShareRequestedSizeGib(v interface{}, d tpgresource.TerraformResourceData, config *transport_tpg.Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandBareMetalSolutionNfsShareStorageType(v interface{}, d tpgresource.TerraformResourceData, config *transport_tpg.Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandBareMetalSolutionNfsSharePod(v interface{}, d tpgresource.TerraformResourceData, config *transport_tpg.Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandBareMetalSolutionNfsShareEffectiveLabels(v interface{}, d tpgresource.TerraformResourceData, config *transport_tpg.Config) (map[string]string, error) {
+	if v == nil {
+		return map[string]string{}, nil
+	}
+	m := make(map[string]string)
+	for k, val := range v.(map[string]interface{}) {
+		m[k] = val.(string)
+	}
+	return m, nil
+}
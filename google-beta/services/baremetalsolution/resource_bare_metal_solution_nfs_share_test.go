@@ -0,0 +1,119 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+package baremetalsolution_test
+
+import (
+	"fmt"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	"github.com/hashicorp/terraform-provider-google-beta/google-beta/acctest"
+	"github.com/hashicorp/terraform-provider-google-beta/google-beta/envvar"
+	"github.com/hashicorp/terraform-provider-google-beta/google-beta/tpgresource"
+	transport_tpg "github.com/hashicorp/terraform-provider-google-beta/google-beta/transport"
+)
+
+// Bare Metal Solution networks are provisioned by Google, so the NFS share test
+// needs an existing network, given as projects/{project}/locations/{location}/networks/{name}.
+func TestAccBareMetalSolutionNfsShare_update(t *testing.T) {
+	envvar.SkipIfEnvNotSet(t, "GOOGLE_BMS_NETWORK")
+	t.Parallel()
+
+	network := os.Getenv("GOOGLE_BMS_NETWORK")
+	context := map[string]interface{}{
+		"network":       network,
+		"location":      strings.Split(network, "/")[3],
+		"random_suffix": acctest.RandString(t, 10),
+	}
+
+	acctest.VcrTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.AccTestPreCheck(t) },
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories(t),
+		CheckDestroy:             testAccCheckBareMetalSolutionNfsShareDestroyProducer(t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBareMetalSolutionNfsShare_basic(context, "READ"),
+			},
+			{
+				ResourceName:            "google_bare_metal_solution_nfs_share.share",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"labels", "terraform_labels"},
+			},
+			{
+				Config: testAccBareMetalSolutionNfsShare_basic(context, "READ_WRITE"),
+			},
+			{
+				ResourceName:            "google_bare_metal_solution_nfs_share.share",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"labels", "terraform_labels"},
+			},
+		},
+	})
+}
+
+func testAccBareMetalSolutionNfsShare_basic(context map[string]interface{}, mountPermissions string) string {
+	context["mount_permissions"] = mountPermissions
+	return acctest.Nprintf(`
+resource "google_bare_metal_solution_nfs_share" "share" {
+  name               = "tf-test-nfs-share%{random_suffix}"
+  location           = "%{location}"
+  requested_size_gib = 100
+  storage_type       = "SSD"
+
+  allowed_clients {
+    network              = "%{network}"
+    allowed_clients_cidr = "192.168.1.0/24"
+    mount_permissions    = "%{mount_permissions}"
+  }
+
+  labels = {
+    mount = lower("%{mount_permissions}")
+  }
+}
+`, context)
+}
+
+func testAccCheckBareMetalSolutionNfsShareDestroyProducer(t *testing.T) func(s *terraform.State) error {
+	return func(s *terraform.State) error {
+		for name, rs := range s.RootModule().Resources {
+			if rs.Type != "google_bare_metal_solution_nfs_share" {
+				continue
+			}
+			if strings.HasPrefix(name, "data.") {
+				continue
+			}
+
+			config := acctest.GoogleProviderConfig(t)
+
+			url, err := tpgresource.ReplaceVarsForTest(config, rs, "{{BareMetalSolutionBasePath}}projects/{{project}}/locations/{{location}}/nfsShares/{{name}}")
+			if err != nil {
+				return err
+			}
+
+			billingProject := ""
+
+			if config.BillingProject != "" {
+				billingProject = config.BillingProject
+			}
+
+			_, err = transport_tpg.SendRequest(transport_tpg.SendRequestOptions{
+				Config:    config,
+				Method:    "GET",
+				Project:   billingProject,
+				RawURL:    url,
+				UserAgent: config.UserAgent,
+			})
+			if err == nil {
+				return fmt.Errorf("BareMetalSolutionNfsShare still exists at %s", url)
+			}
+		}
+
+		return nil
+	}
+}
@@ -228,7 +228,7 @@ Please refer to the field 'effective_labels' for all of the labels present on th
 				Type:             schema.TypeString,
 				Optional:         true,
 				ForceNew:         true,
-				DiffSuppressFunc: tpgresource.CaseDiffSuppress,
+				DiffSuppressFunc: tpgresource.CompareLocations,
 				Description: `The geographic location where the dataset should reside.
 See [official docs](https://cloud.google.com/bigquery/docs/dataset-locations).
 
@@ -1226,7 +1226,7 @@ func flattenBigQueryDatasetLocation(v interface{}, d *schema.ResourceData, confi
 	if v == nil {
 		return "US"
 	}
-	return v
+	return tpgresource.PreserveLocation(d.Get("location").(string), v.(string))
 }
 
 func flattenBigQueryDatasetDefaultEncryptionConfiguration(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
@@ -926,11 +926,12 @@ Creation, truncation and append actions occur as one atomic update upon job comp
 				Description: `The ID of the job. The ID must contain only letters (a-z, A-Z), numbers (0-9), underscores (_), or dashes (-). The maximum length is 1,024 characters.`,
 			},
 			"location": {
-				Type:        schema.TypeString,
-				Optional:    true,
-				ForceNew:    true,
-				Description: `The geographic location of the job. The default value is US.`,
-				Default:     "US",
+				Type:             schema.TypeString,
+				Optional:         true,
+				ForceNew:         true,
+				DiffSuppressFunc: tpgresource.CompareLocations,
+				Description:      `The geographic location of the job. The default value is US.`,
+				Default:          "US",
 			},
 
 			"status": {
@@ -2014,7 +2015,10 @@ func flattenBigQueryJobJobReferenceJobId(v interface{}, d *schema.ResourceData,
 }
 
 func flattenBigQueryJobJobReferenceLocation(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
-	return v
+	if v == nil {
+		return v
+	}
+	return tpgresource.PreserveLocation(d.Get("location").(string), v.(string))
 }
 
 func flattenBigQueryJobStatus(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
@@ -21,6 +21,7 @@ import (
 	"fmt"
 	"log"
 	"reflect"
+	"strings"
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
@@ -52,6 +53,85 @@ func waitForRegistrationActive(d *schema.ResourceData, config *transport_tpg.Con
 	})
 }
 
+// checkRegistrationYearlyPrice retrieves the register parameters of the
+// domain, and fails before the domain is purchased if it can't be registered
+// or if yearly_price doesn't match its current yearly price.
+func checkRegistrationYearlyPrice(d *schema.ResourceData, config *transport_tpg.Config, billingProject, userAgent string) error {
+	url, err := tpgresource.ReplaceVars(d, config, "{{ClouddomainsBasePath}}projects/{{project}}/locations/{{location}}/registrations:retrieveRegisterParameters")
+	if err != nil {
+		return err
+	}
+	url, err = transport_tpg.AddQueryParams(url, map[string]string{"domainName": d.Get("domain_name").(string)})
+	if err != nil {
+		return err
+	}
+
+	res, err := transport_tpg.SendRequest(transport_tpg.SendRequestOptions{
+		Config:    config,
+		Method:    "GET",
+		Project:   billingProject,
+		RawURL:    url,
+		UserAgent: userAgent,
+	})
+	if err != nil {
+		return fmt.Errorf("Error retrieving register parameters of domain %q: %s", d.Get("domain_name").(string), err)
+	}
+
+	params, _ := res["registerParameters"].(map[string]interface{})
+	return compareRegistrationRegisterParameters(d.Get("domain_name").(string), d.Get("yearly_price").([]interface{}), params)
+}
+
+func compareRegistrationRegisterParameters(domainName string, yearlyPrice []interface{}, params map[string]interface{}) error {
+	if availability, _ := params["availability"].(string); availability != "" && availability != "AVAILABLE" {
+		return fmt.Errorf("Domain %q can't be registered, its availability is %q", domainName, availability)
+	}
+
+	price, _ := params["yearlyPrice"].(map[string]interface{})
+	if len(price) == 0 {
+		return nil
+	}
+	currentCurrencyCode := fmt.Sprint(price["currencyCode"])
+	currentUnits := "0"
+	if units, ok := price["units"]; ok && units != nil {
+		currentUnits = fmt.Sprint(units)
+	}
+	// yearly_price has no nanos, so a price with a fractional part can't
+	// be matched.
+	var currentNanos int64
+	if nanos, ok := price["nanos"].(float64); ok {
+		currentNanos = int64(nanos)
+	}
+
+	configuredCurrencyCode, configuredUnits := "", "0"
+	if len(yearlyPrice) > 0 && yearlyPrice[0] != nil {
+		configured := yearlyPrice[0].(map[string]interface{})
+		configuredCurrencyCode, _ = configured["currency_code"].(string)
+		if units, _ := configured["units"].(string); units != "" {
+			configuredUnits = units
+		}
+	}
+
+	if !strings.EqualFold(configuredCurrencyCode, currentCurrencyCode) || configuredUnits != currentUnits || currentNanos != 0 {
+		return fmt.Errorf("The yearly price of domain %q is %s %s, but yearly_price is %s %s. Set yearly_price to the current price to acknowledge it", domainName, formatRegistrationPriceAmount(currentUnits, currentNanos), currentCurrencyCode, configuredUnits, configuredCurrencyCode)
+	}
+	return nil
+}
+
+// formatRegistrationPriceAmount formats the units and nanos of a
+// google.type.Money amount as a decimal number.
+func formatRegistrationPriceAmount(units string, nanos int64) string {
+	if nanos == 0 {
+		return units
+	}
+	if nanos < 0 {
+		nanos = -nanos
+		if !strings.HasPrefix(units, "-") {
+			units = "-" + units
+		}
+	}
+	return units + "." + strings.TrimRight(fmt.Sprintf("%09d", nanos), "0")
+}
+
 func ResourceClouddomainsRegistration() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceClouddomainsRegistrationCreate,
@@ -422,7 +502,8 @@ it might contain "care of" information.`,
 				Required: true,
 				ForceNew: true,
 				Description: `Required. Yearly price to register or renew the domain. The value that should be put here can be obtained from
-registrations.retrieveRegisterParameters or registrations.searchDomains calls.`,
+registrations.retrieveRegisterParameters or registrations.searchDomains calls. The domain is only registered if this
+matches its current yearly price.`,
 				MaxItems: 1,
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
@@ -761,6 +842,10 @@ func resourceClouddomainsRegistrationCreate(d *schema.ResourceData, meta interfa
 		billingProject = bp
 	}
 
+	if err := checkRegistrationYearlyPrice(d, config, billingProject, userAgent); err != nil {
+		return err
+	}
+
 	res, err := transport_tpg.SendRequest(transport_tpg.SendRequestOptions{
 		Config:    config,
 		Method:    "POST",
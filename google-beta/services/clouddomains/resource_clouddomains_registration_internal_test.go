@@ -0,0 +1,132 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+package clouddomains
+
+import (
+	"testing"
+)
+
+func TestCompareRegistrationRegisterParameters(t *testing.T) {
+	t.Parallel()
+
+	yearlyPrice := []interface{}{
+		map[string]interface{}{
+			"currency_code": "USD",
+			"units":         "12",
+		},
+	}
+
+	cases := map[string]struct {
+		yearlyPrice []interface{}
+		params      map[string]interface{}
+		expectErr   bool
+	}{
+		"matching price": {
+			yearlyPrice: yearlyPrice,
+			params: map[string]interface{}{
+				"availability": "AVAILABLE",
+				"yearlyPrice": map[string]interface{}{
+					"currencyCode": "USD",
+					"units":        "12",
+				},
+			},
+		},
+		"different units": {
+			yearlyPrice: yearlyPrice,
+			params: map[string]interface{}{
+				"availability": "AVAILABLE",
+				"yearlyPrice": map[string]interface{}{
+					"currencyCode": "USD",
+					"units":        "20",
+				},
+			},
+			expectErr: true,
+		},
+		"different currency": {
+			yearlyPrice: yearlyPrice,
+			params: map[string]interface{}{
+				"availability": "AVAILABLE",
+				"yearlyPrice": map[string]interface{}{
+					"currencyCode": "EUR",
+					"units":        "12",
+				},
+			},
+			expectErr: true,
+		},
+		"currency in lower case": {
+			yearlyPrice: []interface{}{
+				map[string]interface{}{
+					"currency_code": "usd",
+					"units":         "12",
+				},
+			},
+			params: map[string]interface{}{
+				"availability": "AVAILABLE",
+				"yearlyPrice": map[string]interface{}{
+					"currencyCode": "USD",
+					"units":        "12",
+				},
+			},
+		},
+		"fractional price": {
+			yearlyPrice: yearlyPrice,
+			params: map[string]interface{}{
+				"availability": "AVAILABLE",
+				"yearlyPrice": map[string]interface{}{
+					"currencyCode": "USD",
+					"units":        "12",
+					"nanos":        float64(990000000),
+				},
+			},
+			expectErr: true,
+		},
+		"unavailable": {
+			yearlyPrice: yearlyPrice,
+			params: map[string]interface{}{
+				"availability": "UNAVAILABLE",
+			},
+			expectErr: true,
+		},
+		"no price returned": {
+			yearlyPrice: yearlyPrice,
+			params: map[string]interface{}{
+				"availability": "AVAILABLE",
+			},
+		},
+		"no parameters returned": {
+			yearlyPrice: yearlyPrice,
+		},
+	}
+
+	for tn, tc := range cases {
+		err := compareRegistrationRegisterParameters("example.com", tc.yearlyPrice, tc.params)
+		if tc.expectErr && err == nil {
+			t.Errorf("%s: expected an error", tn)
+		}
+		if !tc.expectErr && err != nil {
+			t.Errorf("%s: unexpected error: %s", tn, err)
+		}
+	}
+}
+
+func TestFormatRegistrationPriceAmount(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		units    string
+		nanos    int64
+		expected string
+	}{
+		{"12", 0, "12"},
+		{"12", 990000000, "12.99"},
+		{"0", 500000000, "0.5"},
+		{"-1", -750000000, "-1.75"},
+		{"0", -250000000, "-0.25"},
+	}
+
+	for _, tc := range cases {
+		if got := formatRegistrationPriceAmount(tc.units, tc.nanos); got != tc.expected {
+			t.Errorf("formatRegistrationPriceAmount(%q, %d): expected %q, got %q", tc.units, tc.nanos, tc.expected, got)
+		}
+	}
+}
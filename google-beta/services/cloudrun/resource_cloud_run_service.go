@@ -1689,7 +1689,7 @@ func flattenCloudRunServiceSpecTemplateMetadata(v interface{}, d *schema.Resourc
 	return []interface{}{transformed}
 }
 func flattenCloudRunServiceSpecTemplateMetadataLabels(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
-	return v
+	return tpgresource.FilterIgnoredAnnotations(v, d, "template.0.metadata.0.labels", config)
 }
 
 func flattenCloudRunServiceSpecTemplateMetadataGeneration(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
@@ -1726,7 +1726,7 @@ func flattenCloudRunServiceSpecTemplateMetadataNamespace(v interface{}, d *schem
 }
 
 func flattenCloudRunServiceSpecTemplateMetadataAnnotations(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
-	return v
+	return tpgresource.FilterIgnoredAnnotations(v, d, "template.0.metadata.0.annotations", config)
 }
 
 func flattenCloudRunServiceSpecTemplateMetadataName(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
@@ -1289,11 +1289,11 @@ func flattenCloudRunV2JobTemplate(v interface{}, d *schema.ResourceData, config
 	return []interface{}{transformed}
 }
 func flattenCloudRunV2JobTemplateLabels(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
-	return v
+	return tpgresource.FilterIgnoredAnnotations(v, d, "template.0.labels", config)
 }
 
 func flattenCloudRunV2JobTemplateAnnotations(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
-	return v
+	return tpgresource.FilterIgnoredAnnotations(v, d, "template.0.annotations", config)
 }
 
 func flattenCloudRunV2JobTemplateParallelism(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
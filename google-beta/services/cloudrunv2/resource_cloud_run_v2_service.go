@@ -1806,11 +1806,11 @@ func flattenCloudRunV2ServiceTemplateRevision(v interface{}, d *schema.ResourceD
 }
 
 func flattenCloudRunV2ServiceTemplateLabels(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
-	return v
+	return tpgresource.FilterIgnoredAnnotations(v, d, "template.0.labels", config)
 }
 
 func flattenCloudRunV2ServiceTemplateAnnotations(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
-	return v
+	return tpgresource.FilterIgnoredAnnotations(v, d, "template.0.annotations", config)
 }
 
 func flattenCloudRunV2ServiceTemplateScaling(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
@@ -0,0 +1,110 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+package compute
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/hashicorp/terraform-provider-google-beta/google-beta/tpgresource"
+	transport_tpg "github.com/hashicorp/terraform-provider-google-beta/google-beta/transport"
+)
+
+// Only external addresses can be moved to another project, internal ones are
+// bound to a network of their project and have to be recreated.
+func computeAddressProjectChangeDiff(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	// separate func to allow unit testing
+	return computeAddressProjectChangeDiffFunc(d)
+}
+
+func computeAddressProjectChangeDiffFunc(d tpgresource.TerraformResourceDiff) error {
+	o, _ := d.GetChange("project")
+	if o == nil || o.(string) == "" || !d.HasChange("project") {
+		return nil
+	}
+	if addressType, _ := d.Get("address_type").(string); addressType == "INTERNAL" {
+		return d.ForceNew("project")
+	}
+	return nil
+}
+
+// computeAddressMove moves the address at sourceUrl, in sourceProject, to the
+// address given by destination, a relative resource name in another project.
+func computeAddressMove(config *transport_tpg.Config, userAgent, sourceProject, sourceUrl, destination string, timeout time.Duration) error {
+	res, err := transport_tpg.SendRequest(transport_tpg.SendRequestOptions{
+		Config:    config,
+		Method:    "POST",
+		Project:   sourceProject,
+		RawURL:    sourceUrl + "/move",
+		UserAgent: userAgent,
+		Body: map[string]interface{}{
+			"destinationAddress": destination,
+		},
+		Timeout: timeout,
+	})
+	if err != nil {
+		return fmt.Errorf("Error moving address to %q: %s", destination, err)
+	}
+	log.Printf("[DEBUG] Finished moving address to %q: %#v", destination, res)
+
+	return ComputeOperationWaitTime(config, res, sourceProject, "Moving Address", userAgent, timeout)
+}
+
+// computeAddressSetLabels calls setLabels with the label fingerprint in obj,
+// read from the state. If labels were changed outside of Terraform since the
+// last refresh, or the address was just moved to another project, the
+// fingerprint is stale and the request fails with a 412. The current
+// fingerprint is then read from the address at selfUrl and the request is
+// sent again.
+func computeAddressSetLabels(config *transport_tpg.Config, userAgent, billingProject, url, selfUrl string, obj map[string]interface{}, timeout time.Duration) (map[string]interface{}, error) {
+	res, err := transport_tpg.SendRequest(transport_tpg.SendRequestOptions{
+		Config:    config,
+		Method:    "POST",
+		Project:   billingProject,
+		RawURL:    url,
+		UserAgent: userAgent,
+		Body:      obj,
+		Timeout:   timeout,
+	})
+	if err == nil || !transport_tpg.IsGoogleApiErrorWithCode(err, 412) {
+		return res, err
+	}
+
+	log.Printf("[DEBUG] Label fingerprint of %q is stale, reading it again", selfUrl)
+	fingerprint, err := computeAddressLabelFingerprint(config, userAgent, billingProject, selfUrl)
+	if err != nil {
+		return nil, err
+	}
+	obj["labelFingerprint"] = fingerprint
+
+	return transport_tpg.SendRequest(transport_tpg.SendRequestOptions{
+		Config:    config,
+		Method:    "POST",
+		Project:   billingProject,
+		RawURL:    url,
+		UserAgent: userAgent,
+		Body:      obj,
+		Timeout:   timeout,
+	})
+}
+
+// computeAddressLabelFingerprint returns the current label fingerprint of the
+// address at url.
+func computeAddressLabelFingerprint(config *transport_tpg.Config, userAgent, project, url string) (string, error) {
+	res, err := transport_tpg.SendRequest(transport_tpg.SendRequestOptions{
+		Config:    config,
+		Method:    "GET",
+		Project:   project,
+		RawURL:    url,
+		UserAgent: userAgent,
+	})
+	if err != nil {
+		return "", fmt.Errorf("Error reading label fingerprint of %q: %s", url, err)
+	}
+	fingerprint, _ := res["labelFingerprint"].(string)
+	return fingerprint, nil
+}
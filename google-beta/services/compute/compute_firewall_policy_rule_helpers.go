@@ -0,0 +1,61 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+package compute
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/hashicorp/terraform-provider-google-beta/google-beta/tpgresource"
+)
+
+// Match fields of a firewall policy rule that can only be used in one direction.
+var firewallPolicyRuleMatchDirectionFields = map[string]string{
+	"src_address_groups":  "INGRESS",
+	"src_fqdns":           "INGRESS",
+	"src_region_codes":    "INGRESS",
+	"dest_address_groups": "EGRESS",
+	"dest_fqdns":          "EGRESS",
+	"dest_region_codes":   "EGRESS",
+}
+
+func validateFirewallPolicyRuleMatch(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	// separate func to allow unit testing
+	return validateFirewallPolicyRuleMatchFunc(d)
+}
+
+func validateFirewallPolicyRuleMatchFunc(d tpgresource.TerraformResourceDiff) error {
+	direction, _ := d.Get("direction").(string)
+	if direction == "" {
+		return nil
+	}
+
+	for field, allowed := range firewallPolicyRuleMatchDirectionFields {
+		if direction == allowed {
+			continue
+		}
+		if v, ok := d.GetOk("match.0." + field); ok && len(v.([]interface{})) > 0 {
+			return fmt.Errorf("match.0.%s can only be set on %s rules, got direction %s", field, allowed, direction)
+		}
+	}
+
+	return nil
+}
+
+// Network firewall policy rules select their targets with either secure tags
+// or service accounts, but not both.
+func validateNetworkFirewallPolicyRuleTargets(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	// separate func to allow unit testing
+	return validateNetworkFirewallPolicyRuleTargetsFunc(d)
+}
+
+func validateNetworkFirewallPolicyRuleTargetsFunc(d tpgresource.TerraformResourceDiff) error {
+	tags, _ := d.Get("target_secure_tags").([]interface{})
+	accounts, _ := d.Get("target_service_accounts").([]interface{})
+	if len(tags) > 0 && len(accounts) > 0 {
+		return fmt.Errorf("target_secure_tags can't be set at the same time as target_service_accounts")
+	}
+	return nil
+}
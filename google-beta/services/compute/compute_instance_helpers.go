@@ -3,6 +3,7 @@
 package compute
 
 import (
+	"context"
 	"fmt"
 	"reflect"
 	"strconv"
@@ -131,6 +132,9 @@ func expandScheduling(v interface{}) (*compute.Scheduling, error) {
 		scheduling.InstanceTerminationAction = v.(string)
 		scheduling.ForceSendFields = append(scheduling.ForceSendFields, "InstanceTerminationAction")
 	}
+	if v, ok := original["termination_time"]; ok {
+		scheduling.TerminationTime = v.(string)
+	}
 	if v, ok := original["max_run_duration"]; ok {
 		transformedMaxRunDuration, err := expandComputeMaxRunDuration(v)
 		if scheduling.InstanceTerminationAction == "STOP" && transformedMaxRunDuration != nil {
@@ -239,6 +243,9 @@ func flattenScheduling(resp *compute.Scheduling) []map[string]interface{} {
 	if resp.MaxRunDuration != nil {
 		schedulingMap["max_run_duration"] = flattenComputeMaxRunDuration(resp.MaxRunDuration)
 	}
+	if resp.TerminationTime != "" {
+		schedulingMap["termination_time"] = resp.TerminationTime
+	}
 	if resp.MaintenanceInterval != "" {
 		schedulingMap["maintenance_interval"] = resp.MaintenanceInterval
 	}
@@ -508,7 +515,11 @@ func flattenGuestAccelerators(accelerators []*compute.AcceleratorConfig) []map[s
 func resourceInstanceTags(d tpgresource.TerraformResourceData) *compute.Tags {
 	// Calculate the tags
 	var tags *compute.Tags
-	if v := d.Get("tags"); v != nil {
+	v := d.Get("tags")
+	if ev, ok := d.GetOk("effective_tags"); ok {
+		v = ev
+	}
+	if v != nil {
 		vs := v.(*schema.Set)
 		tags = new(compute.Tags)
 		tags.Items = make([]string, vs.Len())
@@ -522,6 +533,89 @@ func resourceInstanceTags(d tpgresource.TerraformResourceData) *compute.Tags {
 	return tags
 }
 
+// setEffectiveTagsDiff sets "effective_tags" to the provider's default_tags
+// together with the tags configured on the resource.
+func setEffectiveTagsDiff(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	// Tags left to the source instance template are merged with the defaults
+	// at creation, see resourceComputeInstanceFromTemplateCreate.
+	if isInstanceFieldFromTemplate(d, "tags") {
+		if d.Id() == "" {
+			if err := d.SetNewComputed("effective_tags"); err != nil {
+				return fmt.Errorf("error setting effective_tags to computed: %w", err)
+			}
+		}
+		return nil
+	}
+
+	// If "tags" is not known yet, neither are the merged tags.
+	if !d.GetRawPlan().GetAttr("tags").IsWhollyKnown() {
+		if err := d.SetNewComputed("effective_tags"); err != nil {
+			return fmt.Errorf("error setting effective_tags to computed: %w", err)
+		}
+		return nil
+	}
+
+	config := meta.(*transport_tpg.Config)
+	effectiveTags := mergeDefaultTags(config.DefaultTags, d.Get("tags").(*schema.Set).List())
+	if err := d.SetNew("effective_tags", effectiveTags); err != nil {
+		return fmt.Errorf("error setting new effective_tags diff: %w", err)
+	}
+
+	return nil
+}
+
+// isInstanceFieldFromTemplate returns whether field is left unset on a
+// google_compute_instance_from_template, so that its value comes from the
+// source instance template.
+func isInstanceFieldFromTemplate(d *schema.ResourceDiff, field string) bool {
+	rawConfig := d.GetRawConfig()
+	if rawConfig.IsNull() || !rawConfig.Type().IsObjectType() || !rawConfig.Type().HasAttribute("source_instance_template") {
+		return false
+	}
+	return rawConfig.GetAttr(field).IsNull()
+}
+
+// mergeDefaultTagsOverTemplate returns the tags of an instance template
+// together with the provider's default_tags.
+func mergeDefaultTagsOverTemplate(tmpl *compute.Tags, defaults []string) *compute.Tags {
+	var items []interface{}
+	if tmpl != nil {
+		items = tpgresource.ConvertStringArrToInterface(tmpl.Items)
+	}
+	return &compute.Tags{
+		Items: tpgresource.ConvertStringArr(mergeDefaultTags(defaults, items)),
+	}
+}
+
+// mergeDefaultTags returns the union of the default tags and the tags
+// configured on the resource.
+func mergeDefaultTags(defaults []string, tags []interface{}) []interface{} {
+	merged := schema.NewSet(schema.HashString, tags)
+	for _, tag := range defaults {
+		merged.Add(tag)
+	}
+	return merged.List()
+}
+
+// removeDefaultTags drops the default tags that aren't configured on the
+// resource from tags, so that they are only reported in "effective_tags".
+func removeDefaultTags(tags []string, configured *schema.Set, defaults []string) []string {
+	isDefault := make(map[string]bool, len(defaults))
+	for _, tag := range defaults {
+		if !configured.Contains(tag) {
+			isDefault[tag] = true
+		}
+	}
+
+	var result []string
+	for _, tag := range tags {
+		if !isDefault[tag] {
+			result = append(result, tag)
+		}
+	}
+	return result
+}
+
 func expandShieldedVmConfigs(d tpgresource.TerraformResourceData) *compute.ShieldedInstanceConfig {
 	if _, ok := d.GetOk("shielded_instance_config"); !ok {
 		return nil
@@ -46,6 +46,9 @@ func dataSourceGoogleComputeInstanceRead(d *schema.ResourceData, meta interface{
 	if err = d.Set("metadata", md); err != nil {
 		return fmt.Errorf("error setting metadata: %s", err)
 	}
+	if err = d.Set("effective_metadata", md); err != nil {
+		return fmt.Errorf("error setting effective_metadata: %s", err)
+	}
 
 	if err := d.Set("can_ip_forward", instance.CanIpForward); err != nil {
 		return fmt.Errorf("Error setting can_ip_forward: %s", err)
@@ -93,6 +96,9 @@ func dataSourceGoogleComputeInstanceRead(d *schema.ResourceData, meta interface{
 		if err := d.Set("tags", tpgresource.ConvertStringArrToInterface(instance.Tags.Items)); err != nil {
 			return fmt.Errorf("Error setting tags: %s", err)
 		}
+		if err := d.Set("effective_tags", tpgresource.ConvertStringArrToInterface(instance.Tags.Items)); err != nil {
+			return fmt.Errorf("Error setting effective_tags: %s", err)
+		}
 	}
 
 	if err := d.Set("labels", instance.Labels); err != nil {
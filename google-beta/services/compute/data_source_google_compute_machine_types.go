@@ -158,8 +158,12 @@ func dataSourceGoogleComputeMachineTypesRead(ctx context.Context, d *schema.Reso
 		return diag.FromErr(err)
 	}
 
+	zone, err := tpgresource.GetZone(d, config)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
 	filter := d.Get("filter").(string)
-	zone := d.Get("zone").(string)
 
 	machineTypes := make([]map[string]interface{}, 0)
 	token := ""
@@ -54,14 +54,16 @@ func dataSourceGoogleComputeRegionsRead(d *schema.ResourceData, meta interface{}
 		filter = fmt.Sprintf(" (status eq %s)", s)
 	}
 
-	call := config.NewComputeClient(userAgent).Regions.List(project).Filter(filter)
-
-	resp, err := call.Do()
+	var items []*compute.Region
+	err = config.NewComputeClient(userAgent).Regions.List(project).Filter(filter).Pages(config.Context, func(rl *compute.RegionList) error {
+		items = append(items, rl.Items...)
+		return nil
+	})
 	if err != nil {
 		return err
 	}
 
-	regions := flattenRegions(resp.Items)
+	regions := flattenRegions(items)
 	log.Printf("[DEBUG] Received Google Compute Regions: %q", regions)
 
 	if err := d.Set("names", regions); err != nil {
@@ -3,9 +3,13 @@
 package compute
 
 import (
+	"context"
 	"errors"
+	"fmt"
 	"sort"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
 	compute "google.golang.org/api/compute/v0.beta"
 
 	"github.com/hashicorp/terraform-provider-google-beta/google-beta/tpgresource"
@@ -127,6 +131,9 @@ func FlattenMetadata(metadata *compute.Metadata) map[string]interface{} {
 func resourceInstanceMetadata(d tpgresource.TerraformResourceData) (*compute.Metadata, error) {
 	m := &compute.Metadata{}
 	mdMap := d.Get("metadata").(map[string]interface{})
+	if v, ok := d.GetOk("effective_metadata"); ok {
+		mdMap = v.(map[string]interface{})
+	}
 	if v, ok := d.GetOk("metadata_startup_script"); ok && v.(string) != "" {
 		if w, ok := mdMap["startup-script"]; ok {
 			// metadata.startup-script could be from metadata_startup_script in the first place
@@ -158,3 +165,91 @@ func resourceInstanceMetadata(d tpgresource.TerraformResourceData) (*compute.Met
 
 	return m, nil
 }
+
+// setEffectiveMetadataDiff sets "effective_metadata" to the provider's
+// default_metadata merged with the metadata configured on the resource.
+func setEffectiveMetadataDiff(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	// Metadata left to the source instance template is merged with the
+	// defaults at creation, see resourceComputeInstanceFromTemplateCreate.
+	if isInstanceFieldFromTemplate(d, "metadata") {
+		if d.Id() == "" {
+			if err := d.SetNewComputed("effective_metadata"); err != nil {
+				return fmt.Errorf("error setting effective_metadata to computed: %w", err)
+			}
+		}
+		return nil
+	}
+
+	// If "metadata" is not known yet, neither is the merged metadata.
+	if !d.GetRawPlan().GetAttr("metadata").IsWhollyKnown() {
+		if err := d.SetNewComputed("effective_metadata"); err != nil {
+			return fmt.Errorf("error setting effective_metadata to computed: %w", err)
+		}
+		return nil
+	}
+
+	config := meta.(*transport_tpg.Config)
+	effectiveMetadata := mergeDefaultMetadata(config.DefaultMetadata, d.Get("metadata").(map[string]interface{}))
+	if err := d.SetNew("effective_metadata", effectiveMetadata); err != nil {
+		return fmt.Errorf("error setting new effective_metadata diff: %w", err)
+	}
+
+	return nil
+}
+
+// mergeDefaultMetadata returns the default metadata overridden by the
+// metadata configured on the resource.
+func mergeDefaultMetadata(defaults map[string]string, md map[string]interface{}) map[string]interface{} {
+	merged := make(map[string]interface{}, len(defaults)+len(md))
+	for k, v := range defaults {
+		merged[k] = v
+	}
+	for k, v := range md {
+		merged[k] = v
+	}
+	return merged
+}
+
+// removeDefaultMetadata drops the keys of the default metadata that aren't
+// configured on the resource from md, so that they are only reported in
+// "effective_metadata".
+func removeDefaultMetadata(md map[string]string, configured map[string]interface{}, defaults map[string]string) map[string]string {
+	for k := range defaults {
+		if _, ok := configured[k]; !ok {
+			delete(md, k)
+		}
+	}
+	return md
+}
+
+// mergeDefaultMetadataOverTemplate returns the metadata of an instance
+// template with the provider's default_metadata merged over it.
+func mergeDefaultMetadataOverTemplate(tmpl *compute.Metadata, defaults map[string]string) *compute.Metadata {
+	mdMap := make(map[string]string)
+	if tmpl != nil {
+		for _, item := range tmpl.Items {
+			if item.Value != nil {
+				mdMap[item.Key] = *item.Value
+			}
+		}
+	}
+	for k, v := range defaults {
+		mdMap[k] = v
+	}
+
+	var keys []string
+	for k := range mdMap {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	m := &compute.Metadata{}
+	for _, k := range keys {
+		v := mdMap[k]
+		m.Items = append(m.Items, &compute.MetadataItems{
+			Key:   k,
+			Value: &v,
+		})
+	}
+	return m
+}
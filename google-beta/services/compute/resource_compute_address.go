@@ -51,6 +51,7 @@ func ResourceComputeAddress() *schema.Resource {
 		CustomizeDiff: customdiff.All(
 			tpgresource.SetLabelsDiff,
 			tpgresource.DefaultProviderProject,
+			computeAddressProjectChangeDiff,
 		),
 
 		Schema: map[string]*schema.Schema{
@@ -225,7 +226,6 @@ internally during updates.`,
 				Type:     schema.TypeString,
 				Optional: true,
 				Computed: true,
-				ForceNew: true,
 			},
 			"self_link": {
 				Type:     schema.TypeString,
@@ -561,6 +561,23 @@ func resourceComputeAddressUpdate(d *schema.ResourceData, meta interface{}) erro
 
 	d.Partial(true)
 
+	if d.HasChange("project") {
+		oldProject, _ := d.GetChange("project")
+		sourceUrl, err := tpgresource.ReplaceVars(d, config, fmt.Sprintf("{{ComputeBasePath}}projects/%s/regions/{{region}}/addresses/{{name}}", oldProject.(string)))
+		if err != nil {
+			return err
+		}
+		destination, err := tpgresource.ReplaceVars(d, config, "projects/{{project}}/regions/{{region}}/addresses/{{name}}")
+		if err != nil {
+			return err
+		}
+
+		if err := computeAddressMove(config, userAgent, oldProject.(string), sourceUrl, destination, d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return err
+		}
+		d.SetId(destination)
+	}
+
 	if d.HasChange("label_fingerprint") || d.HasChange("effective_labels") {
 		obj := make(map[string]interface{})
 
@@ -582,20 +599,17 @@ func resourceComputeAddressUpdate(d *schema.ResourceData, meta interface{}) erro
 			return err
 		}
 
+		selfUrl, err := tpgresource.ReplaceVars(d, config, "{{ComputeBasePath}}projects/{{project}}/regions/{{region}}/addresses/{{name}}")
+		if err != nil {
+			return err
+		}
+
 		// err == nil indicates that the billing_project value was found
 		if bp, err := tpgresource.GetBillingProject(d, config); err == nil {
 			billingProject = bp
 		}
 
-		res, err := transport_tpg.SendRequest(transport_tpg.SendRequestOptions{
-			Config:    config,
-			Method:    "POST",
-			Project:   billingProject,
-			RawURL:    url,
-			UserAgent: userAgent,
-			Body:      obj,
-			Timeout:   d.Timeout(schema.TimeoutUpdate),
-		})
+		res, err := computeAddressSetLabels(config, userAgent, billingProject, url, selfUrl, obj, d.Timeout(schema.TimeoutUpdate))
 		if err != nil {
 			return fmt.Errorf("Error updating Address %q: %s", d.Id(), err)
 		} else {
@@ -0,0 +1,160 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+package compute
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-provider-google-beta/google-beta/tpgresource"
+	transport_tpg "github.com/hashicorp/terraform-provider-google-beta/google-beta/transport"
+)
+
+func TestComputeAddress_projectChangeDiff(t *testing.T) {
+	t.Parallel()
+
+	cases := map[string]struct {
+		OldProject     string
+		NewProject     string
+		AddressType    string
+		ExpectForceNew bool
+	}{
+		"create": {
+			NewProject:  "project-a",
+			AddressType: "INTERNAL",
+		},
+		"unchanged internal": {
+			OldProject:  "project-a",
+			NewProject:  "project-a",
+			AddressType: "INTERNAL",
+		},
+		"move external": {
+			OldProject:  "project-a",
+			NewProject:  "project-b",
+			AddressType: "EXTERNAL",
+		},
+		"move global address with default type": {
+			OldProject: "project-a",
+			NewProject: "project-b",
+		},
+		"move internal": {
+			OldProject:     "project-a",
+			NewProject:     "project-b",
+			AddressType:    "INTERNAL",
+			ExpectForceNew: true,
+		},
+	}
+
+	for tn, tc := range cases {
+		d := &tpgresource.ResourceDiffMock{
+			Before: map[string]interface{}{
+				"project": tc.OldProject,
+			},
+			After: map[string]interface{}{
+				"project":      tc.NewProject,
+				"address_type": tc.AddressType,
+			},
+		}
+		if err := computeAddressProjectChangeDiffFunc(d); err != nil {
+			t.Errorf("%s: unexpected error: %s", tn, err)
+		}
+		if d.IsForceNew != tc.ExpectForceNew {
+			t.Errorf("%s: expected IsForceNew to be %v, but was %v", tn, tc.ExpectForceNew, d.IsForceNew)
+		}
+	}
+}
+
+// fakeAddressLabels serves the address GET and setLabels calls used to update
+// the labels of an address whose label fingerprint is "current".
+type fakeAddressLabels struct {
+	mu   sync.Mutex
+	gets int
+	sets int
+}
+
+func (f *fakeAddressLabels) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	switch {
+	case r.Method == "GET" && r.URL.Path == "/projects/my-project/global/addresses/my-address":
+		f.gets++
+		_ = json.NewEncoder(w).Encode(map[string]interface{}{"labelFingerprint": "current"})
+	case r.Method == "POST" && r.URL.Path == "/projects/my-project/global/addresses/my-address/setLabels":
+		f.sets++
+		var body map[string]interface{}
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		if body["labelFingerprint"] != "current" {
+			w.WriteHeader(http.StatusPreconditionFailed)
+			_ = json.NewEncoder(w).Encode(map[string]interface{}{
+				"error": map[string]interface{}{
+					"code":    http.StatusPreconditionFailed,
+					"message": "Labels fingerprint either invalid or resource labels have changed",
+				},
+			})
+			return
+		}
+		_ = json.NewEncoder(w).Encode(map[string]interface{}{"name": "operation-1"})
+	default:
+		http.NotFound(w, r)
+	}
+}
+
+func TestComputeAddressSetLabels(t *testing.T) {
+	t.Parallel()
+
+	cases := map[string]struct {
+		Fingerprint string
+		ExpectGets  int
+		ExpectSets  int
+	}{
+		"state fingerprint is current": {
+			Fingerprint: "current",
+			ExpectGets:  0,
+			ExpectSets:  1,
+		},
+		"state fingerprint is stale": {
+			Fingerprint: "stale",
+			ExpectGets:  1,
+			ExpectSets:  2,
+		},
+	}
+
+	for tn, tc := range cases {
+		address := &fakeAddressLabels{}
+		server := httptest.NewServer(address)
+
+		config := &transport_tpg.Config{
+			Client:          server.Client(),
+			ComputeBasePath: server.URL + "/",
+		}
+		selfUrl := server.URL + "/projects/my-project/global/addresses/my-address"
+		obj := map[string]interface{}{
+			"labelFingerprint": tc.Fingerprint,
+			"labels":           map[string]string{"env": "test"},
+		}
+
+		res, err := computeAddressSetLabels(config, "", "my-project", selfUrl+"/setLabels", selfUrl, obj, time.Minute)
+		server.Close()
+		if err != nil {
+			t.Errorf("%s: unexpected error: %s", tn, err)
+			continue
+		}
+		if res["name"] != "operation-1" {
+			t.Errorf("%s: expected the setLabels operation to be returned, got %#v", tn, res)
+		}
+		if address.gets != tc.ExpectGets {
+			t.Errorf("%s: expected %d reads of the address, got %d", tn, tc.ExpectGets, address.gets)
+		}
+		if address.sets != tc.ExpectSets {
+			t.Errorf("%s: expected %d setLabels calls, got %d", tn, tc.ExpectSets, address.sets)
+		}
+	}
+}
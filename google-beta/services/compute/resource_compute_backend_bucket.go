@@ -18,6 +18,7 @@
 package compute
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"reflect"
@@ -32,6 +33,22 @@ import (
 	"github.com/hashicorp/terraform-provider-google-beta/google-beta/verify"
 )
 
+func validateBackendBucketNegativeCaching(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	// separate func to allow unit testing
+	return validateBackendBucketNegativeCachingFunc(d)
+}
+
+// The API rejects a negativeCachingPolicy unless negativeCaching is enabled.
+func validateBackendBucketNegativeCachingFunc(d tpgresource.TerraformResourceDiff) error {
+	if n, _ := d.Get("cdn_policy.0.negative_caching_policy.#").(int); n == 0 {
+		return nil
+	}
+	if enabled, _ := d.Get("cdn_policy.0.negative_caching").(bool); !enabled {
+		return fmt.Errorf("cdn_policy.0.negative_caching must be true when cdn_policy.0.negative_caching_policy is set")
+	}
+	return nil
+}
+
 func ResourceComputeBackendBucket() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceComputeBackendBucketCreate,
@@ -50,6 +67,7 @@ func ResourceComputeBackendBucket() *schema.Resource {
 		},
 
 		CustomizeDiff: customdiff.All(
+			validateBackendBucketNegativeCaching,
 			tpgresource.DefaultProviderProject,
 		),
 
@@ -231,7 +249,8 @@ client when the resource is created.`,
 				Type:             schema.TypeString,
 				Optional:         true,
 				DiffSuppressFunc: tpgresource.CompareSelfLinkOrResourceName,
-				Description:      `The security policy associated with this backend bucket.`,
+				Description: `The security policy associated with this backend bucket. The policy must be
+of type CLOUD_ARMOR_EDGE.`,
 			},
 			"enable_cdn": {
 				Type:        schema.TypeBool,
@@ -373,17 +392,17 @@ func resourceComputeBackendBucketCreate(d *schema.ResourceData, meta interface{}
 	if o, n := d.GetChange("edge_security_policy"); o.(string) != n.(string) {
 		pol, err := tpgresource.ParseSecurityPolicyFieldValue(n.(string), d, config)
 		if err != nil {
-			return errwrap.Wrapf("Error parsing Backend Service security policy: {{err}}", err)
+			return errwrap.Wrapf("Error parsing Backend Bucket edge security policy: {{err}}", err)
 		}
 
 		spr := emptySecurityPolicyReference()
 		spr.SecurityPolicy = pol.RelativeLink()
 		op, err := config.NewComputeClient(userAgent).BackendBuckets.SetEdgeSecurityPolicy(project, obj["name"].(string), spr).Do()
 		if err != nil {
-			return errwrap.Wrapf("Error setting Backend Service security policy: {{err}}", err)
+			return errwrap.Wrapf("Error setting Backend Bucket edge security policy: {{err}}", err)
 		}
 		// This uses the create timeout for simplicity, though technically this code appears in both create and update
-		waitErr := ComputeOperationWaitTime(config, op, project, "Setting Backend Service Security Policy", userAgent, d.Timeout(schema.TimeoutCreate))
+		waitErr := ComputeOperationWaitTime(config, op, project, "Setting Backend Bucket Edge Security Policy", userAgent, d.Timeout(schema.TimeoutCreate))
 		if waitErr != nil {
 			return waitErr
 		}
@@ -578,17 +597,17 @@ func resourceComputeBackendBucketUpdate(d *schema.ResourceData, meta interface{}
 	if o, n := d.GetChange("edge_security_policy"); o.(string) != n.(string) {
 		pol, err := tpgresource.ParseSecurityPolicyFieldValue(n.(string), d, config)
 		if err != nil {
-			return errwrap.Wrapf("Error parsing Backend Service security policy: {{err}}", err)
+			return errwrap.Wrapf("Error parsing Backend Bucket edge security policy: {{err}}", err)
 		}
 
 		spr := emptySecurityPolicyReference()
 		spr.SecurityPolicy = pol.RelativeLink()
 		op, err := config.NewComputeClient(userAgent).BackendBuckets.SetEdgeSecurityPolicy(project, obj["name"].(string), spr).Do()
 		if err != nil {
-			return errwrap.Wrapf("Error setting Backend Service security policy: {{err}}", err)
+			return errwrap.Wrapf("Error setting Backend Bucket edge security policy: {{err}}", err)
 		}
 		// This uses the create timeout for simplicity, though technically this code appears in both create and update
-		waitErr := ComputeOperationWaitTime(config, op, project, "Setting Backend Service Security Policy", userAgent, d.Timeout(schema.TimeoutCreate))
+		waitErr := ComputeOperationWaitTime(config, op, project, "Setting Backend Bucket Edge Security Policy", userAgent, d.Timeout(schema.TimeoutCreate))
 		if waitErr != nil {
 			return waitErr
 		}
@@ -1196,7 +1215,7 @@ func resourceComputeBackendBucketEncoder(d *schema.ResourceData, meta interface{
 	futureCdnPolicy := obj["cdnPolicy"].(map[string]interface{})
 	currentCdnPolicy := currentCdnPolicies[0].(map[string]interface{})
 
-	cacheMode, ok := futureCdnPolicy["cache_mode"].(string)
+	cacheMode, ok := futureCdnPolicy["cacheMode"].(string)
 	// Fallback to state if doesn't exist in object
 	if !ok {
 		cacheMode = currentCdnPolicy["cache_mode"].(string)
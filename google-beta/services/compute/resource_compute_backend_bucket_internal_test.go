@@ -0,0 +1,49 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+package compute
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-google-beta/google-beta/tpgresource"
+)
+
+func TestComputeBackendBucket_validateNegativeCaching(t *testing.T) {
+	t.Parallel()
+
+	cases := map[string]struct {
+		NegativeCaching bool
+		PolicyCount     int
+		ExpectError     bool
+	}{
+		"no policy": {},
+		"negative caching without policy": {
+			NegativeCaching: true,
+		},
+		"policy with negative caching": {
+			NegativeCaching: true,
+			PolicyCount:     2,
+		},
+		"policy without negative caching": {
+			PolicyCount: 1,
+			ExpectError: true,
+		},
+	}
+
+	for tn, tc := range cases {
+		d := &tpgresource.ResourceDiffMock{
+			After: map[string]interface{}{
+				"cdn_policy.0.negative_caching":          tc.NegativeCaching,
+				"cdn_policy.0.negative_caching_policy.#": tc.PolicyCount,
+			},
+		}
+
+		err := validateBackendBucketNegativeCachingFunc(d)
+		if tc.ExpectError && err == nil {
+			t.Errorf("%s: expected an error", tn)
+		}
+		if !tc.ExpectError && err != nil {
+			t.Errorf("%s: unexpected error: %s", tn, err)
+		}
+	}
+}
@@ -314,6 +314,7 @@ func ResourceComputeDisk() *schema.Resource {
 			customdiff.ForceNewIfChange("size", IsDiskShrinkage),
 			hyperDiskIopsUpdateDiffSupress,
 			tpgresource.SetLabelsDiff,
+			tpgresource.SetEffectiveKmsKeyDiff("disk_encryption_key.0.kms_key_self_link", tpgresource.GetZoneFromDiff),
 			tpgresource.DefaultProviderProject,
 		),
 
@@ -699,6 +700,12 @@ create the disk. Provide this when creating the disk.`,
 				Description: `All of labels (key/value pairs) present on the resource in GCP, including the labels configured through Terraform, other clients and services.`,
 				Elem:        &schema.Schema{Type: schema.TypeString},
 			},
+			"effective_kms_key": {
+				Type:     schema.TypeString,
+				Computed: true,
+				Description: `The KMS key used to encrypt the disk, either configured in 'disk_encryption_key' or inherited
+from the provider-level 'default_kms_key' for the disk's location.`,
+			},
 			"label_fingerprint": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -1126,6 +1133,9 @@ func resourceComputeDiskRead(d *schema.ResourceData, meta interface{}) error {
 	if err := d.Set("disk_encryption_key", flattenComputeDiskDiskEncryptionKey(res["diskEncryptionKey"], d, config)); err != nil {
 		return fmt.Errorf("Error reading Disk: %s", err)
 	}
+	if err := d.Set("effective_kms_key", flattenComputeDiskEffectiveKmsKey(res["diskEncryptionKey"], d, config)); err != nil {
+		return fmt.Errorf("Error reading Disk: %s", err)
+	}
 	if err := d.Set("snapshot", flattenComputeDiskSnapshot(res["sourceSnapshot"], d, config)); err != nil {
 		return fmt.Errorf("Error reading Disk: %s", err)
 	}
@@ -1766,6 +1776,10 @@ func flattenComputeDiskDiskEncryptionKey(v interface{}, d *schema.ResourceData,
 	if len(original) == 0 {
 		return nil
 	}
+	// A key inherited from the provider-level default_kms_key is only tracked in effective_kms_key
+	if kmsKeyName, ok := original["kmsKeyName"].(string); ok && tpgresource.IsDefaultKmsKeyInherited(d, "disk_encryption_key", kmsKeyName) {
+		return nil
+	}
 	transformed := make(map[string]interface{})
 	transformed["raw_key"] =
 		flattenComputeDiskDiskEncryptionKeyRawKey(original["rawKey"], d, config)
@@ -1799,6 +1813,15 @@ func flattenComputeDiskDiskEncryptionKeyKmsKeyServiceAccount(v interface{}, d *s
 	return v
 }
 
+func flattenComputeDiskEffectiveKmsKey(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
+	if v == nil {
+		return ""
+	}
+	original := v.(map[string]interface{})
+	kmsKeyName, _ := original["kmsKeyName"].(string)
+	return kmsKeyName
+}
+
 func flattenComputeDiskSnapshot(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
 	if v == nil {
 		return v
@@ -2223,6 +2246,16 @@ func resourceComputeDiskEncoder(d *schema.ResourceData, meta interface{}, obj ma
 		log.Printf("[DEBUG] Image name resolved to: %s", imageUrl)
 	}
 
+	// Encrypt the disk with the provider-level default_kms_key for its location
+	// if no disk_encryption_key was configured.
+	if _, ok := obj["diskEncryptionKey"]; !ok {
+		if v, ok := d.GetOk("effective_kms_key"); ok {
+			obj["diskEncryptionKey"] = map[string]interface{}{
+				"kmsKeyName": v.(string),
+			}
+		}
+	}
+
 	return obj, nil
 }
 
@@ -44,13 +44,13 @@ func testSweepDisk(region string) error {
 		})
 		if err != nil {
 			log.Printf("[INFO][SWEEPER_LOG] Error in response from request %s: %s", servicesUrl, err)
-			return nil
+			continue
 		}
 
 		resourceList, ok := res["items"]
 		if !ok {
-			log.Printf("[INFO][SWEEPER_LOG] Nothing found in response.")
-			return nil
+			log.Printf("[INFO][SWEEPER_LOG] Nothing found in response for zone %s.", zone)
+			continue
 		}
 
 		rl := resourceList.([]interface{})
@@ -58,11 +58,12 @@ func testSweepDisk(region string) error {
 		log.Printf("[INFO][SWEEPER_LOG] Found %d items in %s list response.", len(rl), resourceName)
 		// Count items that weren't sweeped.
 		nonPrefixCount := 0
+		var ids []string
 		for _, ri := range rl {
 			obj := ri.(map[string]interface{})
 			if obj["id"] == nil {
 				log.Printf("[INFO][SWEEPER_LOG] %s resource id was nil", resourceName)
-				return nil
+				continue
 			}
 
 			id := obj["name"].(string)
@@ -71,21 +72,22 @@ func testSweepDisk(region string) error {
 				nonPrefixCount++
 				continue
 			}
+			ids = append(ids, id)
+		}
 
-			deleteUrl := servicesUrl + "/" + id
-			// Don't wait on operations as we may have a lot to delete
-			_, err = transport_tpg.SendRequest(transport_tpg.SendRequestOptions{
+		// Don't wait on operations as we may have a lot to delete
+		deleted, err := sweeper.DeleteInParallel(resourceName, ids, func(id string) error {
+			_, err := transport_tpg.SendRequest(transport_tpg.SendRequestOptions{
 				Config:    config,
 				Method:    "DELETE",
 				Project:   config.Project,
-				RawURL:    deleteUrl,
+				RawURL:    servicesUrl + "/" + id,
 				UserAgent: config.UserAgent,
 			})
-			if err != nil {
-				log.Printf("[INFO][SWEEPER_LOG] Error deleting for url %s : %s", deleteUrl, err)
-			} else {
-				log.Printf("[INFO][SWEEPER_LOG] Sent delete request for %s resource: %s", resourceName, id)
-			}
+			return err
+		})
+		if err != nil {
+			log.Printf("[INFO][SWEEPER_LOG] Deleted %d of %d %s resources: %s", deleted, len(ids), resourceName, err)
 		}
 
 		if nonPrefixCount > 0 {
@@ -53,6 +53,7 @@ func ResourceComputeFirewallPolicyRule() *schema.Resource {
 		},
 		CustomizeDiff: customdiff.All(
 			tpgresource.DefaultProviderProject,
+			validateFirewallPolicyRuleMatch,
 		),
 
 		Schema: map[string]*schema.Schema{
@@ -50,6 +50,52 @@ func forwardingRuleCustomizeDiff(_ context.Context, diff *schema.ResourceDiff, v
 	return nil
 }
 
+func forwardingRuleInternalOptionsCustomizeDiff(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
+	// separate func to allow unit testing
+	return validateForwardingRuleInternalOptions(diff)
+}
+
+// forwardingRuleResourceDiff is implemented by *schema.ResourceDiff.
+type forwardingRuleResourceDiff interface {
+	tpgresource.TerraformResourceDiff
+	NewValueKnown(string) bool
+}
+
+// Several options are only accepted for some load balancing schemes and
+// protocols. Catch invalid combinations at plan time instead of at create.
+// Values that aren't known until apply are not checked.
+func validateForwardingRuleInternalOptions(d forwardingRuleResourceDiff) error {
+	if d.NewValueKnown("load_balancing_scheme") {
+		scheme, _ := d.Get("load_balancing_scheme").(string)
+		internal := scheme == "INTERNAL" || scheme == "INTERNAL_MANAGED"
+
+		if v, _ := d.Get("allow_global_access").(bool); v && !internal {
+			return fmt.Errorf("allow_global_access can only be set when load_balancing_scheme is INTERNAL or INTERNAL_MANAGED, got %q", scheme)
+		}
+		if v, _ := d.Get("service_label").(string); v != "" && !internal {
+			return fmt.Errorf("service_label can only be set when load_balancing_scheme is INTERNAL or INTERNAL_MANAGED, got %q", scheme)
+		}
+		if v, _ := d.Get("is_mirroring_collector").(bool); v && scheme != "INTERNAL" {
+			return fmt.Errorf("is_mirroring_collector can only be set when load_balancing_scheme is INTERNAL, got %q", scheme)
+		}
+	}
+
+	if !d.NewValueKnown("ip_protocol") || !d.NewValueKnown("all_ports") {
+		return nil
+	}
+	protocol, _ := d.Get("ip_protocol").(string)
+	protocol = strings.ToUpper(protocol)
+	allPorts, _ := d.Get("all_ports").(bool)
+	if allPorts && protocol != "" && !tpgresource.StringInSlice([]string{"TCP", "UDP", "SCTP", "L3_DEFAULT"}, protocol) {
+		return fmt.Errorf("all_ports requires ip_protocol to be TCP, UDP, SCTP or L3_DEFAULT, got %s", protocol)
+	}
+	if protocol == "L3_DEFAULT" && !allPorts {
+		return fmt.Errorf("ip_protocol L3_DEFAULT requires all_ports to be true")
+	}
+
+	return nil
+}
+
 func ResourceComputeForwardingRule() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceComputeForwardingRuleCreate,
@@ -69,6 +115,7 @@ func ResourceComputeForwardingRule() *schema.Resource {
 
 		CustomizeDiff: customdiff.All(
 			forwardingRuleCustomizeDiff,
+			forwardingRuleInternalOptionsCustomizeDiff,
 			tpgresource.SetLabelsDiff,
 			tpgresource.DefaultProviderProject,
 		),
@@ -0,0 +1,121 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+package compute
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-google-beta/google-beta/tpgresource"
+)
+
+// forwardingRuleResourceDiffMock is a ResourceDiffMock whose keys in Unknown
+// aren't known until apply.
+type forwardingRuleResourceDiffMock struct {
+	*tpgresource.ResourceDiffMock
+	Unknown map[string]bool
+}
+
+func (d *forwardingRuleResourceDiffMock) NewValueKnown(key string) bool {
+	return !d.Unknown[key]
+}
+
+func TestComputeForwardingRule_validateInternalOptions(t *testing.T) {
+	t.Parallel()
+
+	cases := map[string]struct {
+		After       map[string]interface{}
+		Unknown     map[string]bool
+		ExpectError bool
+	}{
+		"internal with global access and all ports": {
+			After: map[string]interface{}{
+				"load_balancing_scheme":  "INTERNAL",
+				"ip_protocol":            "TCP",
+				"allow_global_access":    true,
+				"all_ports":              true,
+				"service_label":          "my-service",
+				"is_mirroring_collector": true,
+			},
+		},
+		"internal managed with global access": {
+			After: map[string]interface{}{
+				"load_balancing_scheme": "INTERNAL_MANAGED",
+				"allow_global_access":   true,
+			},
+		},
+		"external with global access": {
+			After: map[string]interface{}{
+				"load_balancing_scheme": "EXTERNAL",
+				"allow_global_access":   true,
+			},
+			ExpectError: true,
+		},
+		"external with service label": {
+			After: map[string]interface{}{
+				"load_balancing_scheme": "EXTERNAL",
+				"service_label":         "my-service",
+			},
+			ExpectError: true,
+		},
+		"internal managed mirroring collector": {
+			After: map[string]interface{}{
+				"load_balancing_scheme":  "INTERNAL_MANAGED",
+				"is_mirroring_collector": true,
+			},
+			ExpectError: true,
+		},
+		"all ports with icmp": {
+			After: map[string]interface{}{
+				"load_balancing_scheme": "INTERNAL",
+				"ip_protocol":           "ICMP",
+				"all_ports":             true,
+			},
+			ExpectError: true,
+		},
+		"l3 default with all ports": {
+			After: map[string]interface{}{
+				"load_balancing_scheme": "EXTERNAL",
+				"ip_protocol":           "l3_default",
+				"all_ports":             true,
+			},
+		},
+		"l3 default without all ports": {
+			After: map[string]interface{}{
+				"load_balancing_scheme": "EXTERNAL",
+				"ip_protocol":           "L3_DEFAULT",
+			},
+			ExpectError: true,
+		},
+		"global access with unknown scheme": {
+			After: map[string]interface{}{
+				"allow_global_access": true,
+				"service_label":       "my-service",
+			},
+			Unknown: map[string]bool{"load_balancing_scheme": true},
+		},
+		"l3 default with unknown all ports": {
+			After: map[string]interface{}{
+				"load_balancing_scheme": "INTERNAL",
+				"ip_protocol":           "L3_DEFAULT",
+			},
+			Unknown: map[string]bool{"all_ports": true},
+		},
+	}
+
+	for tn, tc := range cases {
+		d := &forwardingRuleResourceDiffMock{
+			ResourceDiffMock: &tpgresource.ResourceDiffMock{
+				After: tc.After,
+			},
+			Unknown: tc.Unknown,
+		}
+
+		err := validateForwardingRuleInternalOptions(d)
+		if tc.ExpectError && err == nil {
+			t.Errorf("%s: expected an error", tn)
+		}
+		if !tc.ExpectError && err != nil {
+			t.Errorf("%s: unexpected error: %s", tn, err)
+		}
+	}
+}
@@ -0,0 +1,1304 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+package compute
+
+import (
+	"fmt"
+	"log"
+	"reflect"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	"github.com/hashicorp/terraform-provider-google-beta/google-beta/tpgresource"
+	transport_tpg "github.com/hashicorp/terraform-provider-google-beta/google-beta/transport"
+	"github.com/hashicorp/terraform-provider-google-beta/google-beta/verify"
+)
+
+func ResourceComputeFutureReservation() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceComputeFutureReservationCreate,
+		Read:   resourceComputeFutureReservationRead,
+		Update: resourceComputeFutureReservationUpdate,
+		Delete: resourceComputeFutureReservationDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: resourceComputeFutureReservationImport,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(20 * time.Minute),
+			Update: schema.DefaultTimeout(20 * time.Minute),
+			Delete: schema.DefaultTimeout(20 * time.Minute),
+		},
+
+		CustomizeDiff: customdiff.All(
+			tpgresource.DefaultProviderProject,
+		),
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				Description: `Name of the resource. Provided by the client when the resource is
+created. The name must be 1-63 characters long, and comply with
+RFC1035. Specifically, the name must be 1-63 characters long and match
+the regular expression '[a-z]([-a-z0-9]*[a-z0-9])?' which means the
+first character must be a lowercase letter, and all following
+characters must be a dash, lowercase letter, or digit, except the last
+character, which cannot be a dash.`,
+			},
+			"specific_sku_properties": {
+				Type:        schema.TypeList,
+				Required:    true,
+				Description: `Future reservation for instances with specific machine shapes.`,
+				MaxItems:    1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"total_count": {
+							Type:         schema.TypeInt,
+							Required:     true,
+							ValidateFunc: validation.IntAtLeast(1),
+							Description:  `Total number of instances for which capacity assurance is requested at a future time period.`,
+						},
+						"instance_properties": {
+							Type:        schema.TypeList,
+							Optional:    true,
+							ForceNew:    true,
+							Description: `Properties of the SKU instances being reserved.`,
+							MaxItems:    1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"machine_type": {
+										Type:        schema.TypeString,
+										Required:    true,
+										ForceNew:    true,
+										Description: `The name of the machine type to reserve.`,
+									},
+									"guest_accelerators": {
+										Type:        schema.TypeList,
+										Optional:    true,
+										ForceNew:    true,
+										Description: `Guest accelerator type and count.`,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"accelerator_count": {
+													Type:     schema.TypeInt,
+													Required: true,
+													ForceNew: true,
+													Description: `The number of the guest accelerator cards exposed to
+this instance.`,
+												},
+												"accelerator_type": {
+													Type:     schema.TypeString,
+													Required: true,
+													ForceNew: true,
+													Description: `The full or partial URL of the accelerator type to
+attach to this instance. For example:
+'projects/my-project/zones/us-central1-c/acceleratorTypes/nvidia-tesla-p100'`,
+												},
+											},
+										},
+									},
+									"local_ssds": {
+										Type:     schema.TypeList,
+										Optional: true,
+										ForceNew: true,
+										Description: `The amount of local ssd to reserve with each instance. This
+reserves disks of type 'local-ssd'.`,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"disk_size_gb": {
+													Type:        schema.TypeInt,
+													Required:    true,
+													ForceNew:    true,
+													Description: `The size of the disk in base-2 GB.`,
+												},
+												"interface": {
+													Type:         schema.TypeString,
+													Optional:     true,
+													ForceNew:     true,
+													ValidateFunc: verify.ValidateEnum([]string{"SCSI", "NVME", ""}),
+													Description:  `The disk interface to use for attaching this disk. Default value: "SCSI" Possible values: ["SCSI", "NVME"]`,
+													Default:      "SCSI",
+												},
+											},
+										},
+									},
+									"min_cpu_platform": {
+										Type:     schema.TypeString,
+										Computed: true,
+										Optional: true,
+										ForceNew: true,
+										Description: `The minimum CPU platform for the reservation. For example,
+'"Intel Skylake"'.`,
+									},
+								},
+							},
+							ExactlyOneOf: []string{"specific_sku_properties.0.instance_properties", "specific_sku_properties.0.source_instance_template"},
+						},
+						"source_instance_template": {
+							Type:             schema.TypeString,
+							Optional:         true,
+							ForceNew:         true,
+							DiffSuppressFunc: tpgresource.CompareSelfLinkRelativePaths,
+							Description: `The instance template that will be used to populate the
+instance properties of the future reservation.`,
+							ExactlyOneOf: []string{"specific_sku_properties.0.instance_properties", "specific_sku_properties.0.source_instance_template"},
+						},
+					},
+				},
+			},
+			"time_window": {
+				Type:        schema.TypeList,
+				Required:    true,
+				Description: `Time window for this future reservation.`,
+				MaxItems:    1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"start_time": {
+							Type:             schema.TypeString,
+							Required:         true,
+							ValidateFunc:     validation.IsRFC3339Time,
+							DiffSuppressFunc: tpgresource.TimestampDiffSuppress(time.RFC3339),
+							Description:      `Start time of the future reservation in RFC3339 format.`,
+						},
+						"duration": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Description: `Duration of the future reservation, measured from the start time.
+Exactly one of 'end_time' or 'duration' must be set.`,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"seconds": {
+										Type:        schema.TypeInt,
+										Required:    true,
+										Description: `Span of time at a resolution of a second.`,
+									},
+									"nanos": {
+										Type:     schema.TypeInt,
+										Optional: true,
+										Description: `Span of time that's a fraction of a second at nanosecond
+resolution.`,
+									},
+								},
+							},
+							ExactlyOneOf: []string{"time_window.0.end_time", "time_window.0.duration"},
+						},
+						"end_time": {
+							Type:             schema.TypeString,
+							Optional:         true,
+							ValidateFunc:     validation.IsRFC3339Time,
+							DiffSuppressFunc: tpgresource.TimestampDiffSuppress(time.RFC3339),
+							Description:      `End time of the future reservation in RFC3339 format.`,
+							ExactlyOneOf:     []string{"time_window.0.end_time", "time_window.0.duration"},
+						},
+					},
+				},
+			},
+			"zone": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				DiffSuppressFunc: tpgresource.CompareSelfLinkOrResourceName,
+				Description:      `The zone where the future reservation is made.`,
+			},
+			"auto_created_reservations_delete_time": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateFunc:     validation.IsRFC3339Time,
+				DiffSuppressFunc: tpgresource.TimestampDiffSuppress(time.RFC3339),
+				Description: `Future timestamp when the reservations auto-created for this future
+reservation will be deleted by Compute Engine, in RFC3339 format.`,
+			},
+			"auto_delete_auto_created_reservations": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Description: `Whether the reservations auto-created for this future reservation
+should be deleted at 'auto_created_reservations_delete_time'.`,
+			},
+			"description": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: `An optional description of this resource.`,
+			},
+			"name_prefix": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Description: `Name prefix for the reservations to be created at the time of
+delivery. If not set, the future reservation's name is used.`,
+			},
+			"planning_status": {
+				Type:         schema.TypeString,
+				Computed:     true,
+				Optional:     true,
+				ValidateFunc: verify.ValidateEnum([]string{"DRAFT", "SUBMITTED", ""}),
+				Description: `Planning state of the future reservation. A future reservation in
+'DRAFT' can be edited freely; setting it to 'SUBMITTED' sends the
+request for approval. Possible values: ["DRAFT", "SUBMITTED"]`,
+			},
+			"share_settings": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Optional:    true,
+				Description: `The share setting for the future reservation.`,
+				MaxItems:    1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"projects": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Description: `The project IDs or numbers the future reservation is shared with.
+This is only valid when 'share_type' is SPECIFIC_PROJECTS.`,
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+						},
+						"share_type": {
+							Type:         schema.TypeString,
+							Computed:     true,
+							Optional:     true,
+							ValidateFunc: verify.ValidateEnum([]string{"LOCAL", "SPECIFIC_PROJECTS", ""}),
+							Description:  `Type of sharing for this future reservation Possible values: ["LOCAL", "SPECIFIC_PROJECTS"]`,
+						},
+					},
+				},
+			},
+			"creation_timestamp": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: `Creation timestamp in RFC3339 text format.`,
+			},
+			"procurement_status": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: `Current state of this future reservation, such as PENDING_APPROVAL, APPROVED or FULFILLED.`,
+			},
+			"project": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"self_link": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+		UseJSONNumber: true,
+	}
+}
+
+func resourceComputeFutureReservationCreate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*transport_tpg.Config)
+	userAgent, err := tpgresource.GenerateUserAgentString(d, config.UserAgent)
+	if err != nil {
+		return err
+	}
+
+	obj := make(map[string]interface{})
+	descriptionProp, err := expandComputeFutureReservationDescription(d.Get("description"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("description"); !tpgresource.IsEmptyValue(reflect.ValueOf(descriptionProp)) && (ok || !reflect.DeepEqual(v, descriptionProp)) {
+		obj["description"] = descriptionProp
+	}
+	nameProp, err := expandComputeFutureReservationName(d.Get("name"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("name"); !tpgresource.IsEmptyValue(reflect.ValueOf(nameProp)) && (ok || !reflect.DeepEqual(v, nameProp)) {
+		obj["name"] = nameProp
+	}
+	namePrefixProp, err := expandComputeFutureReservationNamePrefix(d.Get("name_prefix"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("name_prefix"); !tpgresource.IsEmptyValue(reflect.ValueOf(namePrefixProp)) && (ok || !reflect.DeepEqual(v, namePrefixProp)) {
+		obj["namePrefix"] = namePrefixProp
+	}
+	planningStatusProp, err := expandComputeFutureReservationPlanningStatus(d.Get("planning_status"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("planning_status"); !tpgresource.IsEmptyValue(reflect.ValueOf(planningStatusProp)) && (ok || !reflect.DeepEqual(v, planningStatusProp)) {
+		obj["planningStatus"] = planningStatusProp
+	}
+	shareSettingsProp, err := expandComputeFutureReservationShareSettings(d.Get("share_settings"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("share_settings"); !tpgresource.IsEmptyValue(reflect.ValueOf(shareSettingsProp)) && (ok || !reflect.DeepEqual(v, shareSettingsProp)) {
+		obj["shareSettings"] = shareSettingsProp
+	}
+	specificSkuPropertiesProp, err := expandComputeFutureReservationSpecificSkuProperties(d.Get("specific_sku_properties"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("specific_sku_properties"); !tpgresource.IsEmptyValue(reflect.ValueOf(specificSkuPropertiesProp)) && (ok || !reflect.DeepEqual(v, specificSkuPropertiesProp)) {
+		obj["specificSkuProperties"] = specificSkuPropertiesProp
+	}
+	timeWindowProp, err := expandComputeFutureReservationTimeWindow(d.Get("time_window"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("time_window"); !tpgresource.IsEmptyValue(reflect.ValueOf(timeWindowProp)) && (ok || !reflect.DeepEqual(v, timeWindowProp)) {
+		obj["timeWindow"] = timeWindowProp
+	}
+	autoCreatedReservationsDeleteTimeProp, err := expandComputeFutureReservationAutoCreatedReservationsDeleteTime(d.Get("auto_created_reservations_delete_time"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("auto_created_reservations_delete_time"); !tpgresource.IsEmptyValue(reflect.ValueOf(autoCreatedReservationsDeleteTimeProp)) && (ok || !reflect.DeepEqual(v, autoCreatedReservationsDeleteTimeProp)) {
+		obj["autoCreatedReservationsDeleteTime"] = autoCreatedReservationsDeleteTimeProp
+	}
+	autoDeleteAutoCreatedReservationsProp, err := expandComputeFutureReservationAutoDeleteAutoCreatedReservations(d.Get("auto_delete_auto_created_reservations"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("auto_delete_auto_created_reservations"); !tpgresource.IsEmptyValue(reflect.ValueOf(autoDeleteAutoCreatedReservationsProp)) && (ok || !reflect.DeepEqual(v, autoDeleteAutoCreatedReservationsProp)) {
+		obj["autoDeleteAutoCreatedReservations"] = autoDeleteAutoCreatedReservationsProp
+	}
+	zoneProp, err := expandComputeFutureReservationZone(d.Get("zone"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("zone"); !tpgresource.IsEmptyValue(reflect.ValueOf(zoneProp)) && (ok || !reflect.DeepEqual(v, zoneProp)) {
+		obj["zone"] = zoneProp
+	}
+
+	url, err := tpgresource.ReplaceVars(d, config, "{{ComputeBasePath}}projects/{{project}}/zones/{{zone}}/futureReservations")
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Creating new FutureReservation: %#v", obj)
+	billingProject := ""
+
+	project, err := tpgresource.GetProject(d, config)
+	if err != nil {
+		return fmt.Errorf("Error fetching project for FutureReservation: %s", err)
+	}
+	billingProject = project
+
+	// err == nil indicates that the billing_project value was found
+	if bp, err := tpgresource.GetBillingProject(d, config); err == nil {
+		billingProject = bp
+	}
+
+	res, err := transport_tpg.SendRequest(transport_tpg.SendRequestOptions{
+		Config:    config,
+		Method:    "POST",
+		Project:   billingProject,
+		RawURL:    url,
+		UserAgent: userAgent,
+		Body:      obj,
+		Timeout:   d.Timeout(schema.TimeoutCreate),
+	})
+	if err != nil {
+		return fmt.Errorf("Error creating FutureReservation: %s", err)
+	}
+
+	// Store the ID now
+	id, err := tpgresource.ReplaceVars(d, config, "projects/{{project}}/zones/{{zone}}/futureReservations/{{name}}")
+	if err != nil {
+		return fmt.Errorf("Error constructing id: %s", err)
+	}
+	d.SetId(id)
+
+	err = ComputeOperationWaitTime(
+		config, res, project, "Creating FutureReservation", userAgent,
+		d.Timeout(schema.TimeoutCreate))
+
+	if err != nil {
+		// The resource didn't actually create
+		d.SetId("")
+		return fmt.Errorf("Error waiting to create FutureReservation: %s", err)
+	}
+
+	log.Printf("[DEBUG] Finished creating FutureReservation %q: %#v", d.Id(), res)
+
+	return resourceComputeFutureReservationRead(d, meta)
+}
+
+func resourceComputeFutureReservationRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*transport_tpg.Config)
+	userAgent, err := tpgresource.GenerateUserAgentString(d, config.UserAgent)
+	if err != nil {
+		return err
+	}
+
+	url, err := tpgresource.ReplaceVars(d, config, "{{ComputeBasePath}}projects/{{project}}/zones/{{zone}}/futureReservations/{{name}}")
+	if err != nil {
+		return err
+	}
+
+	billingProject := ""
+
+	project, err := tpgresource.GetProject(d, config)
+	if err != nil {
+		return fmt.Errorf("Error fetching project for FutureReservation: %s", err)
+	}
+	billingProject = project
+
+	// err == nil indicates that the billing_project value was found
+	if bp, err := tpgresource.GetBillingProject(d, config); err == nil {
+		billingProject = bp
+	}
+
+	res, err := transport_tpg.SendRequest(transport_tpg.SendRequestOptions{
+		Config:    config,
+		Method:    "GET",
+		Project:   billingProject,
+		RawURL:    url,
+		UserAgent: userAgent,
+	})
+	if err != nil {
+		return transport_tpg.HandleNotFoundError(err, d, fmt.Sprintf("ComputeFutureReservation %q", d.Id()))
+	}
+
+	if err := d.Set("project", project); err != nil {
+		return fmt.Errorf("Error reading FutureReservation: %s", err)
+	}
+
+	if err := d.Set("creation_timestamp", flattenComputeFutureReservationCreationTimestamp(res["creationTimestamp"], d, config)); err != nil {
+		return fmt.Errorf("Error reading FutureReservation: %s", err)
+	}
+	if err := d.Set("description", flattenComputeFutureReservationDescription(res["description"], d, config)); err != nil {
+		return fmt.Errorf("Error reading FutureReservation: %s", err)
+	}
+	if err := d.Set("name", flattenComputeFutureReservationName(res["name"], d, config)); err != nil {
+		return fmt.Errorf("Error reading FutureReservation: %s", err)
+	}
+	if err := d.Set("name_prefix", flattenComputeFutureReservationNamePrefix(res["namePrefix"], d, config)); err != nil {
+		return fmt.Errorf("Error reading FutureReservation: %s", err)
+	}
+	if err := d.Set("planning_status", flattenComputeFutureReservationPlanningStatus(res["planningStatus"], d, config)); err != nil {
+		return fmt.Errorf("Error reading FutureReservation: %s", err)
+	}
+	if err := d.Set("procurement_status", flattenComputeFutureReservationProcurementStatus(res["status"], d, config)); err != nil {
+		return fmt.Errorf("Error reading FutureReservation: %s", err)
+	}
+	if err := d.Set("share_settings", flattenComputeFutureReservationShareSettings(res["shareSettings"], d, config)); err != nil {
+		return fmt.Errorf("Error reading FutureReservation: %s", err)
+	}
+	if err := d.Set("specific_sku_properties", flattenComputeFutureReservationSpecificSkuProperties(res["specificSkuProperties"], d, config)); err != nil {
+		return fmt.Errorf("Error reading FutureReservation: %s", err)
+	}
+	if err := d.Set("time_window", flattenComputeFutureReservationTimeWindow(res["timeWindow"], d, config)); err != nil {
+		return fmt.Errorf("Error reading FutureReservation: %s", err)
+	}
+	if err := d.Set("auto_created_reservations_delete_time", flattenComputeFutureReservationAutoCreatedReservationsDeleteTime(res["autoCreatedReservationsDeleteTime"], d, config)); err != nil {
+		return fmt.Errorf("Error reading FutureReservation: %s", err)
+	}
+	if err := d.Set("auto_delete_auto_created_reservations", flattenComputeFutureReservationAutoDeleteAutoCreatedReservations(res["autoDeleteAutoCreatedReservations"], d, config)); err != nil {
+		return fmt.Errorf("Error reading FutureReservation: %s", err)
+	}
+	if err := d.Set("zone", flattenComputeFutureReservationZone(res["zone"], d, config)); err != nil {
+		return fmt.Errorf("Error reading FutureReservation: %s", err)
+	}
+	if err := d.Set("self_link", tpgresource.ConvertSelfLinkToV1(res["selfLink"].(string))); err != nil {
+		return fmt.Errorf("Error reading FutureReservation: %s", err)
+	}
+
+	return nil
+}
+
+func resourceComputeFutureReservationUpdate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*transport_tpg.Config)
+	userAgent, err := tpgresource.GenerateUserAgentString(d, config.UserAgent)
+	if err != nil {
+		return err
+	}
+
+	billingProject := ""
+
+	project, err := tpgresource.GetProject(d, config)
+	if err != nil {
+		return fmt.Errorf("Error fetching project for FutureReservation: %s", err)
+	}
+	billingProject = project
+
+	obj := make(map[string]interface{})
+	descriptionProp, err := expandComputeFutureReservationDescription(d.Get("description"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("description"); !tpgresource.IsEmptyValue(reflect.ValueOf(v)) && (ok || !reflect.DeepEqual(v, descriptionProp)) {
+		obj["description"] = descriptionProp
+	}
+	planningStatusProp, err := expandComputeFutureReservationPlanningStatus(d.Get("planning_status"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("planning_status"); !tpgresource.IsEmptyValue(reflect.ValueOf(v)) && (ok || !reflect.DeepEqual(v, planningStatusProp)) {
+		obj["planningStatus"] = planningStatusProp
+	}
+	shareSettingsProp, err := expandComputeFutureReservationShareSettings(d.Get("share_settings"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("share_settings"); !tpgresource.IsEmptyValue(reflect.ValueOf(v)) && (ok || !reflect.DeepEqual(v, shareSettingsProp)) {
+		obj["shareSettings"] = shareSettingsProp
+	}
+	specificSkuPropertiesProp, err := expandComputeFutureReservationSpecificSkuProperties(d.Get("specific_sku_properties"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("specific_sku_properties"); !tpgresource.IsEmptyValue(reflect.ValueOf(v)) && (ok || !reflect.DeepEqual(v, specificSkuPropertiesProp)) {
+		obj["specificSkuProperties"] = specificSkuPropertiesProp
+	}
+	timeWindowProp, err := expandComputeFutureReservationTimeWindow(d.Get("time_window"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("time_window"); !tpgresource.IsEmptyValue(reflect.ValueOf(v)) && (ok || !reflect.DeepEqual(v, timeWindowProp)) {
+		obj["timeWindow"] = timeWindowProp
+	}
+	autoCreatedReservationsDeleteTimeProp, err := expandComputeFutureReservationAutoCreatedReservationsDeleteTime(d.Get("auto_created_reservations_delete_time"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("auto_created_reservations_delete_time"); !tpgresource.IsEmptyValue(reflect.ValueOf(v)) && (ok || !reflect.DeepEqual(v, autoCreatedReservationsDeleteTimeProp)) {
+		obj["autoCreatedReservationsDeleteTime"] = autoCreatedReservationsDeleteTimeProp
+	}
+	autoDeleteAutoCreatedReservationsProp, err := expandComputeFutureReservationAutoDeleteAutoCreatedReservations(d.Get("auto_delete_auto_created_reservations"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("auto_delete_auto_created_reservations"); !tpgresource.IsEmptyValue(reflect.ValueOf(v)) && (ok || !reflect.DeepEqual(v, autoDeleteAutoCreatedReservationsProp)) {
+		obj["autoDeleteAutoCreatedReservations"] = autoDeleteAutoCreatedReservationsProp
+	}
+
+	url, err := tpgresource.ReplaceVars(d, config, "{{ComputeBasePath}}projects/{{project}}/zones/{{zone}}/futureReservations/{{name}}")
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Updating FutureReservation %q: %#v", d.Id(), obj)
+	updateMask := []string{}
+
+	if d.HasChange("description") {
+		updateMask = append(updateMask, "description")
+	}
+
+	if d.HasChange("planning_status") {
+		updateMask = append(updateMask, "planningStatus")
+	}
+
+	if d.HasChange("share_settings") {
+		updateMask = append(updateMask, "shareSettings")
+	}
+
+	if d.HasChange("specific_sku_properties") {
+		updateMask = append(updateMask, "specificSkuProperties.totalCount")
+	}
+
+	if d.HasChange("time_window") {
+		updateMask = append(updateMask, "timeWindow")
+	}
+
+	if d.HasChange("auto_created_reservations_delete_time") {
+		updateMask = append(updateMask, "autoCreatedReservationsDeleteTime")
+	}
+
+	if d.HasChange("auto_delete_auto_created_reservations") {
+		updateMask = append(updateMask, "autoDeleteAutoCreatedReservations")
+	}
+	// updateMask is a URL parameter but not present in the schema, so ReplaceVars
+	// won't set it
+	url, err = transport_tpg.AddQueryParams(url, map[string]string{"updateMask": strings.Join(updateMask, ",")})
+	if err != nil {
+		return err
+	}
+
+	// err == nil indicates that the billing_project value was found
+	if bp, err := tpgresource.GetBillingProject(d, config); err == nil {
+		billingProject = bp
+	}
+
+	// if updateMask is empty we are not updating anything so skip the post
+	if len(updateMask) > 0 {
+		res, err := transport_tpg.SendRequest(transport_tpg.SendRequestOptions{
+			Config:    config,
+			Method:    "PATCH",
+			Project:   billingProject,
+			RawURL:    url,
+			UserAgent: userAgent,
+			Body:      obj,
+			Timeout:   d.Timeout(schema.TimeoutUpdate),
+		})
+
+		if err != nil {
+			return fmt.Errorf("Error updating FutureReservation %q: %s", d.Id(), err)
+		} else {
+			log.Printf("[DEBUG] Finished updating FutureReservation %q: %#v", d.Id(), res)
+		}
+
+		err = ComputeOperationWaitTime(
+			config, res, project, "Updating FutureReservation", userAgent,
+			d.Timeout(schema.TimeoutUpdate))
+
+		if err != nil {
+			return err
+		}
+	}
+
+	return resourceComputeFutureReservationRead(d, meta)
+}
+
+func resourceComputeFutureReservationDelete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*transport_tpg.Config)
+	userAgent, err := tpgresource.GenerateUserAgentString(d, config.UserAgent)
+	if err != nil {
+		return err
+	}
+
+	billingProject := ""
+
+	project, err := tpgresource.GetProject(d, config)
+	if err != nil {
+		return fmt.Errorf("Error fetching project for FutureReservation: %s", err)
+	}
+	billingProject = project
+
+	url, err := tpgresource.ReplaceVars(d, config, "{{ComputeBasePath}}projects/{{project}}/zones/{{zone}}/futureReservations/{{name}}")
+	if err != nil {
+		return err
+	}
+
+	var obj map[string]interface{}
+
+	// err == nil indicates that the billing_project value was found
+	if bp, err := tpgresource.GetBillingProject(d, config); err == nil {
+		billingProject = bp
+	}
+
+	log.Printf("[DEBUG] Deleting FutureReservation %q", d.Id())
+	res, err := transport_tpg.SendRequest(transport_tpg.SendRequestOptions{
+		Config:    config,
+		Method:    "DELETE",
+		Project:   billingProject,
+		RawURL:    url,
+		UserAgent: userAgent,
+		Body:      obj,
+		Timeout:   d.Timeout(schema.TimeoutDelete),
+	})
+	if err != nil {
+		return transport_tpg.HandleNotFoundError(err, d, "FutureReservation")
+	}
+
+	err = ComputeOperationWaitTime(
+		config, res, project, "Deleting FutureReservation", userAgent,
+		d.Timeout(schema.TimeoutDelete))
+
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Finished deleting FutureReservation %q: %#v", d.Id(), res)
+	return nil
+}
+
+func resourceComputeFutureReservationImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	config := meta.(*transport_tpg.Config)
+	if err := tpgresource.ParseImportId([]string{
+		"^projects/(?P<project>[^/]+)/zones/(?P<zone>[^/]+)/futureReservations/(?P<name>[^/]+)$",
+		"^(?P<project>[^/]+)/(?P<zone>[^/]+)/(?P<name>[^/]+)$",
+		"^(?P<zone>[^/]+)/(?P<name>[^/]+)$",
+		"^(?P<name>[^/]+)$",
+	}, d, config); err != nil {
+		return nil, err
+	}
+
+	// Replace import id for the resource id
+	id, err := tpgresource.ReplaceVars(d, config, "projects/{{project}}/zones/{{zone}}/futureReservations/{{name}}")
+	if err != nil {
+		return nil, fmt.Errorf("Error constructing id: %s", err)
+	}
+	d.SetId(id)
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func flattenComputeFutureReservationCreationTimestamp(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
+	return v
+}
+
+func flattenComputeFutureReservationDescription(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
+	return v
+}
+
+func flattenComputeFutureReservationName(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
+	return v
+}
+
+func flattenComputeFutureReservationNamePrefix(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
+	return v
+}
+
+func flattenComputeFutureReservationPlanningStatus(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
+	return v
+}
+
+func flattenComputeFutureReservationProcurementStatus(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
+	if v == nil {
+		return nil
+	}
+	original, ok := v.(map[string]interface{})
+	if !ok {
+		return nil
+	}
+	return original["procurementStatus"]
+}
+
+func flattenComputeFutureReservationShareSettings(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
+	if v == nil {
+		return nil
+	}
+	original := v.(map[string]interface{})
+	if len(original) == 0 {
+		return nil
+	}
+	transformed := make(map[string]interface{})
+	transformed["share_type"] =
+		flattenComputeFutureReservationShareSettingsShareType(original["shareType"], d, config)
+	transformed["projects"] =
+		flattenComputeFutureReservationShareSettingsProjects(original["projects"], d, config)
+	return []interface{}{transformed}
+}
+func flattenComputeFutureReservationShareSettingsShareType(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
+	return v
+}
+
+func flattenComputeFutureReservationShareSettingsProjects(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
+	return v
+}
+
+func flattenComputeFutureReservationSpecificSkuProperties(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
+	if v == nil {
+		return nil
+	}
+	original := v.(map[string]interface{})
+	if len(original) == 0 {
+		return nil
+	}
+	transformed := make(map[string]interface{})
+	transformed["total_count"] =
+		flattenComputeFutureReservationSpecificSkuPropertiesTotalCount(original["totalCount"], d, config)
+	transformed["instance_properties"] =
+		flattenComputeFutureReservationSpecificSkuPropertiesInstanceProperties(original["instanceProperties"], d, config)
+	transformed["source_instance_template"] =
+		flattenComputeFutureReservationSpecificSkuPropertiesSourceInstanceTemplate(original["sourceInstanceTemplate"], d, config)
+	return []interface{}{transformed}
+}
+func flattenComputeFutureReservationSpecificSkuPropertiesTotalCount(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
+	// Handles the string fixed64 format
+	if strVal, ok := v.(string); ok {
+		if intVal, err := tpgresource.StringToFixed64(strVal); err == nil {
+			return intVal
+		}
+	}
+
+	// number values are represented as float64
+	if floatVal, ok := v.(float64); ok {
+		intVal := int(floatVal)
+		return intVal
+	}
+
+	return v // let terraform core handle it otherwise
+}
+
+func flattenComputeFutureReservationSpecificSkuPropertiesInstanceProperties(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
+	if v == nil {
+		return nil
+	}
+	original := v.(map[string]interface{})
+	if len(original) == 0 {
+		return nil
+	}
+	transformed := make(map[string]interface{})
+	transformed["machine_type"] =
+		flattenComputeFutureReservationSpecificSkuPropertiesInstancePropertiesMachineType(original["machineType"], d, config)
+	transformed["min_cpu_platform"] =
+		flattenComputeFutureReservationSpecificSkuPropertiesInstancePropertiesMinCpuPlatform(original["minCpuPlatform"], d, config)
+	transformed["guest_accelerators"] =
+		flattenComputeFutureReservationSpecificSkuPropertiesInstancePropertiesGuestAccelerators(original["guestAccelerators"], d, config)
+	transformed["local_ssds"] =
+		flattenComputeFutureReservationSpecificSkuPropertiesInstancePropertiesLocalSsds(original["localSsds"], d, config)
+	return []interface{}{transformed}
+}
+func flattenComputeFutureReservationSpecificSkuPropertiesInstancePropertiesMachineType(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
+	return v
+}
+
+func flattenComputeFutureReservationSpecificSkuPropertiesInstancePropertiesMinCpuPlatform(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
+	return v
+}
+
+func flattenComputeFutureReservationSpecificSkuPropertiesInstancePropertiesGuestAccelerators(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
+	if v == nil {
+		return v
+	}
+	l := v.([]interface{})
+	transformed := make([]interface{}, 0, len(l))
+	for _, raw := range l {
+		original := raw.(map[string]interface{})
+		if len(original) < 1 {
+			// Do not include empty json objects coming back from the api
+			continue
+		}
+		transformed = append(transformed, map[string]interface{}{
+			"accelerator_type":  flattenComputeFutureReservationSpecificSkuPropertiesInstancePropertiesGuestAcceleratorsAcceleratorType(original["acceleratorType"], d, config),
+			"accelerator_count": flattenComputeFutureReservationSpecificSkuPropertiesInstancePropertiesGuestAcceleratorsAcceleratorCount(original["acceleratorCount"], d, config),
+		})
+	}
+	return transformed
+}
+func flattenComputeFutureReservationSpecificSkuPropertiesInstancePropertiesGuestAcceleratorsAcceleratorType(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
+	return v
+}
+
+func flattenComputeFutureReservationSpecificSkuPropertiesInstancePropertiesGuestAcceleratorsAcceleratorCount(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
+	// Handles the string fixed64 format
+	if strVal, ok := v.(string); ok {
+		if intVal, err := tpgresource.StringToFixed64(strVal); err == nil {
+			return intVal
+		}
+	}
+
+	// number values are represented as float64
+	if floatVal, ok := v.(float64); ok {
+		intVal := int(floatVal)
+		return intVal
+	}
+
+	return v // let terraform core handle it otherwise
+}
+
+func flattenComputeFutureReservationSpecificSkuPropertiesInstancePropertiesLocalSsds(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
+	if v == nil {
+		return v
+	}
+	l := v.([]interface{})
+	transformed := make([]interface{}, 0, len(l))
+	for _, raw := range l {
+		original := raw.(map[string]interface{})
+		if len(original) < 1 {
+			// Do not include empty json objects coming back from the api
+			continue
+		}
+		transformed = append(transformed, map[string]interface{}{
+			"interface":    flattenComputeFutureReservationSpecificSkuPropertiesInstancePropertiesLocalSsdsInterface(original["interface"], d, config),
+			"disk_size_gb": flattenComputeFutureReservationSpecificSkuPropertiesInstancePropertiesLocalSsdsDiskSizeGb(original["diskSizeGb"], d, config),
+		})
+	}
+	return transformed
+}
+func flattenComputeFutureReservationSpecificSkuPropertiesInstancePropertiesLocalSsdsInterface(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
+	return v
+}
+
+func flattenComputeFutureReservationSpecificSkuPropertiesInstancePropertiesLocalSsdsDiskSizeGb(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
+	// Handles the string fixed64 format
+	if strVal, ok := v.(string); ok {
+		if intVal, err := tpgresource.StringToFixed64(strVal); err == nil {
+			return intVal
+		}
+	}
+
+	// number values are represented as float64
+	if floatVal, ok := v.(float64); ok {
+		intVal := int(floatVal)
+		return intVal
+	}
+
+	return v // let terraform core handle it otherwise
+}
+
+func flattenComputeFutureReservationSpecificSkuPropertiesSourceInstanceTemplate(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
+	return v
+}
+
+func flattenComputeFutureReservationTimeWindow(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
+	if v == nil {
+		return nil
+	}
+	original := v.(map[string]interface{})
+	if len(original) == 0 {
+		return nil
+	}
+	transformed := make(map[string]interface{})
+	transformed["start_time"] =
+		flattenComputeFutureReservationTimeWindowStartTime(original["startTime"], d, config)
+	transformed["end_time"] =
+		flattenComputeFutureReservationTimeWindowEndTime(original["endTime"], d, config)
+	transformed["duration"] =
+		flattenComputeFutureReservationTimeWindowDuration(original["duration"], d, config)
+	return []interface{}{transformed}
+}
+func flattenComputeFutureReservationTimeWindowStartTime(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
+	return v
+}
+
+func flattenComputeFutureReservationTimeWindowEndTime(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
+	return v
+}
+
+func flattenComputeFutureReservationTimeWindowDuration(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
+	if v == nil {
+		return nil
+	}
+	original := v.(map[string]interface{})
+	if len(original) == 0 {
+		return nil
+	}
+	transformed := make(map[string]interface{})
+	transformed["seconds"] =
+		flattenComputeFutureReservationTimeWindowDurationSeconds(original["seconds"], d, config)
+	transformed["nanos"] =
+		flattenComputeFutureReservationTimeWindowDurationNanos(original["nanos"], d, config)
+	return []interface{}{transformed}
+}
+func flattenComputeFutureReservationTimeWindowDurationSeconds(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
+	// Handles the string fixed64 format
+	if strVal, ok := v.(string); ok {
+		if intVal, err := tpgresource.StringToFixed64(strVal); err == nil {
+			return intVal
+		}
+	}
+
+	// number values are represented as float64
+	if floatVal, ok := v.(float64); ok {
+		intVal := int(floatVal)
+		return intVal
+	}
+
+	return v // let terraform core handle it otherwise
+}
+
+func flattenComputeFutureReservationTimeWindowDurationNanos(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
+	// Handles the string fixed64 format
+	if strVal, ok := v.(string); ok {
+		if intVal, err := tpgresource.StringToFixed64(strVal); err == nil {
+			return intVal
+		}
+	}
+
+	// number values are represented as float64
+	if floatVal, ok := v.(float64); ok {
+		intVal := int(floatVal)
+		return intVal
+	}
+
+	return v // let terraform core handle it otherwise
+}
+
+func flattenComputeFutureReservationAutoCreatedReservationsDeleteTime(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
+	return v
+}
+
+func flattenComputeFutureReservationAutoDeleteAutoCreatedReservations(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
+	return v
+}
+
+func flattenComputeFutureReservationZone(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
+	if v == nil {
+		return v
+	}
+	return tpgresource.ConvertSelfLinkToV1(v.(string))
+}
+
+func expandComputeFutureReservationDescription(v interface{}, d tpgresource.TerraformResourceData, config *transport_tpg.Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandComputeFutureReservationName(v interface{}, d tpgresource.TerraformResourceData, config *transport_tpg.Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandComputeFutureReservationNamePrefix(v interface{}, d tpgresource.TerraformResourceData, config *transport_tpg.Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandComputeFutureReservationPlanningStatus(v interface{}, d tpgresource.TerraformResourceData, config *transport_tpg.Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandComputeFutureReservationShareSettings(v interface{}, d tpgresource.TerraformResourceData, config *transport_tpg.Config) (interface{}, error) {
+	l := v.([]interface{})
+	if len(l) == 0 || l[0] == nil {
+		return nil, nil
+	}
+	raw := l[0]
+	original := raw.(map[string]interface{})
+	transformed := make(map[string]interface{})
+
+	transformedShareType, err := expandComputeFutureReservationShareSettingsShareType(original["share_type"], d, config)
+	if err != nil {
+		return nil, err
+	} else if val := reflect.ValueOf(transformedShareType); val.IsValid() && !tpgresource.IsEmptyValue(val) {
+		transformed["shareType"] = transformedShareType
+	}
+
+	transformedProjects, err := expandComputeFutureReservationShareSettingsProjects(original["projects"], d, config)
+	if err != nil {
+		return nil, err
+	} else if val := reflect.ValueOf(transformedProjects); val.IsValid() && !tpgresource.IsEmptyValue(val) {
+		transformed["projects"] = transformedProjects
+	}
+
+	return transformed, nil
+}
+
+func expandComputeFutureReservationShareSettingsShareType(v interface{}, d tpgresource.TerraformResourceData, config *transport_tpg.Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandComputeFutureReservationShareSettingsProjects(v interface{}, d tpgresource.TerraformResourceData, config *transport_tpg.Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandComputeFutureReservationSpecificSkuProperties(v interface{}, d tpgresource.TerraformResourceData, config *transport_tpg.Config) (interface{}, error) {
+	l := v.([]interface{})
+	if len(l) == 0 || l[0] == nil {
+		return nil, nil
+	}
+	raw := l[0]
+	original := raw.(map[string]interface{})
+	transformed := make(map[string]interface{})
+
+	transformedTotalCount, err := expandComputeFutureReservationSpecificSkuPropertiesTotalCount(original["total_count"], d, config)
+	if err != nil {
+		return nil, err
+	} else if val := reflect.ValueOf(transformedTotalCount); val.IsValid() && !tpgresource.IsEmptyValue(val) {
+		transformed["totalCount"] = transformedTotalCount
+	}
+
+	transformedInstanceProperties, err := expandComputeFutureReservationSpecificSkuPropertiesInstanceProperties(original["instance_properties"], d, config)
+	if err != nil {
+		return nil, err
+	} else if val := reflect.ValueOf(transformedInstanceProperties); val.IsValid() && !tpgresource.IsEmptyValue(val) {
+		transformed["instanceProperties"] = transformedInstanceProperties
+	}
+
+	transformedSourceInstanceTemplate, err := expandComputeFutureReservationSpecificSkuPropertiesSourceInstanceTemplate(original["source_instance_template"], d, config)
+	if err != nil {
+		return nil, err
+	} else if val := reflect.ValueOf(transformedSourceInstanceTemplate); val.IsValid() && !tpgresource.IsEmptyValue(val) {
+		transformed["sourceInstanceTemplate"] = transformedSourceInstanceTemplate
+	}
+
+	return transformed, nil
+}
+
+func expandComputeFutureReservationSpecificSkuPropertiesTotalCount(v interface{}, d tpgresource.TerraformResourceData, config *transport_tpg.Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandComputeFutureReservationSpecificSkuPropertiesInstanceProperties(v interface{}, d tpgresource.TerraformResourceData, config *transport_tpg.Config) (interface{}, error) {
+	l := v.([]interface{})
+	if len(l) == 0 || l[0] == nil {
+		return nil, nil
+	}
+	raw := l[0]
+	original := raw.(map[string]interface{})
+	transformed := make(map[string]interface{})
+
+	transformedMachineType, err := expandComputeFutureReservationSpecificSkuPropertiesInstancePropertiesMachineType(original["machine_type"], d, config)
+	if err != nil {
+		return nil, err
+	} else if val := reflect.ValueOf(transformedMachineType); val.IsValid() && !tpgresource.IsEmptyValue(val) {
+		transformed["machineType"] = transformedMachineType
+	}
+
+	transformedMinCpuPlatform, err := expandComputeFutureReservationSpecificSkuPropertiesInstancePropertiesMinCpuPlatform(original["min_cpu_platform"], d, config)
+	if err != nil {
+		return nil, err
+	} else if val := reflect.ValueOf(transformedMinCpuPlatform); val.IsValid() && !tpgresource.IsEmptyValue(val) {
+		transformed["minCpuPlatform"] = transformedMinCpuPlatform
+	}
+
+	transformedGuestAccelerators, err := expandComputeFutureReservationSpecificSkuPropertiesInstancePropertiesGuestAccelerators(original["guest_accelerators"], d, config)
+	if err != nil {
+		return nil, err
+	} else if val := reflect.ValueOf(transformedGuestAccelerators); val.IsValid() && !tpgresource.IsEmptyValue(val) {
+		transformed["guestAccelerators"] = transformedGuestAccelerators
+	}
+
+	transformedLocalSsds, err := expandComputeFutureReservationSpecificSkuPropertiesInstancePropertiesLocalSsds(original["local_ssds"], d, config)
+	if err != nil {
+		return nil, err
+	} else if val := reflect.ValueOf(transformedLocalSsds); val.IsValid() && !tpgresource.IsEmptyValue(val) {
+		transformed["localSsds"] = transformedLocalSsds
+	}
+
+	return transformed, nil
+}
+
+func expandComputeFutureReservationSpecificSkuPropertiesInstancePropertiesMachineType(v interface{}, d tpgresource.TerraformResourceData, config *transport_tpg.Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandComputeFutureReservationSpecificSkuPropertiesInstancePropertiesMinCpuPlatform(v interface{}, d tpgresource.TerraformResourceData, config *transport_tpg.Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandComputeFutureReservationSpecificSkuPropertiesInstancePropertiesGuestAccelerators(v interface{}, d tpgresource.TerraformResourceData, config *transport_tpg.Config) (interface{}, error) {
+	l := v.([]interface{})
+	req := make([]interface{}, 0, len(l))
+	for _, raw := range l {
+		if raw == nil {
+			continue
+		}
+		original := raw.(map[string]interface{})
+		transformed := make(map[string]interface{})
+
+		transformedAcceleratorType, err := expandComputeFutureReservationSpecificSkuPropertiesInstancePropertiesGuestAcceleratorsAcceleratorType(original["accelerator_type"], d, config)
+		if err != nil {
+			return nil, err
+		} else if val := reflect.ValueOf(transformedAcceleratorType); val.IsValid() && !tpgresource.IsEmptyValue(val) {
+			transformed["acceleratorType"] = transformedAcceleratorType
+		}
+
+		transformedAcceleratorCount, err := expandComputeFutureReservationSpecificSkuPropertiesInstancePropertiesGuestAcceleratorsAcceleratorCount(original["accelerator_count"], d, config)
+		if err != nil {
+			return nil, err
+		} else if val := reflect.ValueOf(transformedAcceleratorCount); val.IsValid() && !tpgresource.IsEmptyValue(val) {
+			transformed["acceleratorCount"] = transformedAcceleratorCount
+		}
+
+		req = append(req, transformed)
+	}
+	return req, nil
+}
+
+func expandComputeFutureReservationSpecificSkuPropertiesInstancePropertiesGuestAcceleratorsAcceleratorType(v interface{}, d tpgresource.TerraformResourceData, config *transport_tpg.Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandComputeFutureReservationSpecificSkuPropertiesInstancePropertiesGuestAcceleratorsAcceleratorCount(v interface{}, d tpgresource.TerraformResourceData, config *transport_tpg.Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandComputeFutureReservationSpecificSkuPropertiesInstancePropertiesLocalSsds(v interface{}, d tpgresource.TerraformResourceData, config *transport_tpg.Config) (interface{}, error) {
+	l := v.([]interface{})
+	req := make([]interface{}, 0, len(l))
+	for _, raw := range l {
+		if raw == nil {
+			continue
+		}
+		original := raw.(map[string]interface{})
+		transformed := make(map[string]interface{})
+
+		transformedInterface, err := expandComputeFutureReservationSpecificSkuPropertiesInstancePropertiesLocalSsdsInterface(original["interface"], d, config)
+		if err != nil {
+			return nil, err
+		} else if val := reflect.ValueOf(transformedInterface); val.IsValid() && !tpgresource.IsEmptyValue(val) {
+			transformed["interface"] = transformedInterface
+		}
+
+		transformedDiskSizeGb, err := expandComputeFutureReservationSpecificSkuPropertiesInstancePropertiesLocalSsdsDiskSizeGb(original["disk_size_gb"], d, config)
+		if err != nil {
+			return nil, err
+		} else if val := reflect.ValueOf(transformedDiskSizeGb); val.IsValid() && !tpgresource.IsEmptyValue(val) {
+			transformed["diskSizeGb"] = transformedDiskSizeGb
+		}
+
+		req = append(req, transformed)
+	}
+	return req, nil
+}
+
+func expandComputeFutureReservationSpecificSkuPropertiesInstancePropertiesLocalSsdsInterface(v interface{}, d tpgresource.TerraformResourceData, config *transport_tpg.Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandComputeFutureReservationSpecificSkuPropertiesInstancePropertiesLocalSsdsDiskSizeGb(v interface{}, d tpgresource.TerraformResourceData, config *transport_tpg.Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandComputeFutureReservationSpecificSkuPropertiesSourceInstanceTemplate(v interface{}, d tpgresource.TerraformResourceData, config *transport_tpg.Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandComputeFutureReservationTimeWindow(v interface{}, d tpgresource.TerraformResourceData, config *transport_tpg.Config) (interface{}, error) {
+	l := v.([]interface{})
+	if len(l) == 0 || l[0] == nil {
+		return nil, nil
+	}
+	raw := l[0]
+	original := raw.(map[string]interface{})
+	transformed := make(map[string]interface{})
+
+	transformedStartTime, err := expandComputeFutureReservationTimeWindowStartTime(original["start_time"], d, config)
+	if err != nil {
+		return nil, err
+	} else if val := reflect.ValueOf(transformedStartTime); val.IsValid() && !tpgresource.IsEmptyValue(val) {
+		transformed["startTime"] = transformedStartTime
+	}
+
+	transformedEndTime, err := expandComputeFutureReservationTimeWindowEndTime(original["end_time"], d, config)
+	if err != nil {
+		return nil, err
+	} else if val := reflect.ValueOf(transformedEndTime); val.IsValid() && !tpgresource.IsEmptyValue(val) {
+		transformed["endTime"] = transformedEndTime
+	}
+
+	transformedDuration, err := expandComputeFutureReservationTimeWindowDuration(original["duration"], d, config)
+	if err != nil {
+		return nil, err
+	} else if val := reflect.ValueOf(transformedDuration); val.IsValid() && !tpgresource.IsEmptyValue(val) {
+		transformed["duration"] = transformedDuration
+	}
+
+	return transformed, nil
+}
+
+func expandComputeFutureReservationTimeWindowStartTime(v interface{}, d tpgresource.TerraformResourceData, config *transport_tpg.Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandComputeFutureReservationTimeWindowEndTime(v interface{}, d tpgresource.TerraformResourceData, config *transport_tpg.Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandComputeFutureReservationTimeWindowDuration(v interface{}, d tpgresource.TerraformResourceData, config *transport_tpg.Config) (interface{}, error) {
+	l := v.([]interface{})
+	if len(l) == 0 || l[0] == nil {
+		return nil, nil
+	}
+	raw := l[0]
+	original := raw.(map[string]interface{})
+	transformed := make(map[string]interface{})
+
+	transformedSeconds, err := expandComputeFutureReservationTimeWindowDurationSeconds(original["seconds"], d, config)
+	if err != nil {
+		return nil, err
+	} else if val := reflect.ValueOf(transformedSeconds); val.IsValid() && !tpgresource.IsEmptyValue(val) {
+		transformed["seconds"] = transformedSeconds
+	}
+
+	transformedNanos, err := expandComputeFutureReservationTimeWindowDurationNanos(original["nanos"], d, config)
+	if err != nil {
+		return nil, err
+	} else if val := reflect.ValueOf(transformedNanos); val.IsValid() && !tpgresource.IsEmptyValue(val) {
+		transformed["nanos"] = transformedNanos
+	}
+
+	return transformed, nil
+}
+
+func expandComputeFutureReservationTimeWindowDurationSeconds(v interface{}, d tpgresource.TerraformResourceData, config *transport_tpg.Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandComputeFutureReservationTimeWindowDurationNanos(v interface{}, d tpgresource.TerraformResourceData, config *transport_tpg.Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandComputeFutureReservationAutoCreatedReservationsDeleteTime(v interface{}, d tpgresource.TerraformResourceData, config *transport_tpg.Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandComputeFutureReservationAutoDeleteAutoCreatedReservations(v interface{}, d tpgresource.TerraformResourceData, config *transport_tpg.Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandComputeFutureReservationZone(v interface{}, d tpgresource.TerraformResourceData, config *transport_tpg.Config) (interface{}, error) {
+	f, err := tpgresource.ParseGlobalFieldValue("zones", v.(string), "project", d, config, true)
+	if err != nil {
+		return nil, fmt.Errorf("Invalid value for zone: %s", err)
+	}
+	return f.RelativeLink(), nil
+}
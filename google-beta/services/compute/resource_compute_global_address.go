@@ -51,6 +51,7 @@ func ResourceComputeGlobalAddress() *schema.Resource {
 		CustomizeDiff: customdiff.All(
 			tpgresource.SetLabelsDiff,
 			tpgresource.DefaultProviderProject,
+			computeAddressProjectChangeDiff,
 		),
 
 		Schema: map[string]*schema.Schema{
@@ -134,9 +135,10 @@ This field is not applicable to addresses with addressType=INTERNAL
 when purpose=PRIVATE_SERVICE_CONNECT`,
 			},
 			"purpose": {
-				Type:     schema.TypeString,
-				Optional: true,
-				ForceNew: true,
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidateEnum([]string{"VPC_PEERING", "PRIVATE_SERVICE_CONNECT", ""}),
 				Description: `The purpose of the resource. Possible values include:
 
 * VPC_PEERING - for peer networks
@@ -171,7 +173,6 @@ internally during updates.`,
 				Type:     schema.TypeString,
 				Optional: true,
 				Computed: true,
-				ForceNew: true,
 			},
 			"self_link": {
 				Type:     schema.TypeString,
@@ -473,6 +474,23 @@ func resourceComputeGlobalAddressUpdate(d *schema.ResourceData, meta interface{}
 
 	d.Partial(true)
 
+	if d.HasChange("project") {
+		oldProject, _ := d.GetChange("project")
+		sourceUrl, err := tpgresource.ReplaceVars(d, config, fmt.Sprintf("{{ComputeBasePath}}projects/%s/global/addresses/{{name}}", oldProject.(string)))
+		if err != nil {
+			return err
+		}
+		destination, err := tpgresource.ReplaceVars(d, config, "projects/{{project}}/global/addresses/{{name}}")
+		if err != nil {
+			return err
+		}
+
+		if err := computeAddressMove(config, userAgent, oldProject.(string), sourceUrl, destination, d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return err
+		}
+		d.SetId(destination)
+	}
+
 	if d.HasChange("label_fingerprint") || d.HasChange("effective_labels") {
 		obj := make(map[string]interface{})
 
@@ -494,20 +512,17 @@ func resourceComputeGlobalAddressUpdate(d *schema.ResourceData, meta interface{}
 			return err
 		}
 
+		selfUrl, err := tpgresource.ReplaceVars(d, config, "{{ComputeBasePath}}projects/{{project}}/global/addresses/{{name}}")
+		if err != nil {
+			return err
+		}
+
 		// err == nil indicates that the billing_project value was found
 		if bp, err := tpgresource.GetBillingProject(d, config); err == nil {
 			billingProject = bp
 		}
 
-		res, err := transport_tpg.SendRequest(transport_tpg.SendRequestOptions{
-			Config:    config,
-			Method:    "POST",
-			Project:   billingProject,
-			RawURL:    url,
-			UserAgent: userAgent,
-			Body:      obj,
-			Timeout:   d.Timeout(schema.TimeoutUpdate),
-		})
+		res, err := computeAddressSetLabels(config, userAgent, billingProject, url, selfUrl, obj, d.Timeout(schema.TimeoutUpdate))
 		if err != nil {
 			return fmt.Errorf("Error updating GlobalAddress %q: %s", d.Id(), err)
 		} else {
@@ -61,22 +61,47 @@ func validatePortSpec(diff *schema.ResourceDiff, blockName string) error {
 	return nil
 }
 
+// gRPC health checks have no default port, so one must be set unless it's
+// taken from a named port or the serving port.
+func validateGrpcPortSpec(diff *schema.ResourceDiff) error {
+	if err := validatePortSpec(diff, "grpc_health_check"); err != nil {
+		return err
+	}
+
+	block := diff.Get("grpc_health_check.0").(map[string]interface{})
+	portSpec := block["port_specification"]
+	portName := block["port_name"]
+	port := block["port"]
+
+	hasPort := (port != nil && port != 0)
+	noName := (portName == nil || portName == "")
+
+	if !hasPort && diff.NewValueKnown("grpc_health_check.0.port") && (portSpec == "USE_FIXED_PORT" || (portSpec == "" && noName)) {
+		return fmt.Errorf("Error in grpc_health_check: Must specify port when using USE_FIXED_PORT as port_specification or when neither port_name nor port_specification are set.")
+	}
+
+	return nil
+}
+
 func healthCheckCustomizeDiff(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
-	if diff.Get("http_health_check") != nil {
+	if _, ok := diff.GetOk("http_health_check"); ok {
 		return validatePortSpec(diff, "http_health_check")
 	}
-	if diff.Get("https_health_check") != nil {
+	if _, ok := diff.GetOk("https_health_check"); ok {
 		return validatePortSpec(diff, "https_health_check")
 	}
-	if diff.Get("http2_health_check") != nil {
+	if _, ok := diff.GetOk("http2_health_check"); ok {
 		return validatePortSpec(diff, "http2_health_check")
 	}
-	if diff.Get("tcp_health_check") != nil {
+	if _, ok := diff.GetOk("tcp_health_check"); ok {
 		return validatePortSpec(diff, "tcp_health_check")
 	}
-	if diff.Get("ssl_health_check") != nil {
+	if _, ok := diff.GetOk("ssl_health_check"); ok {
 		return validatePortSpec(diff, "ssl_health_check")
 	}
+	if _, ok := diff.GetOk("grpc_health_check"); ok {
+		return validateGrpcPortSpec(diff)
+	}
 
 	return nil
 }
@@ -57,6 +57,7 @@ var (
 		"scheduling.0.provisioning_model",
 		"scheduling.0.instance_termination_action",
 		"scheduling.0.max_run_duration",
+		"scheduling.0.termination_time",
 		"scheduling.0.maintenance_interval",
 		"scheduling.0.local_ssd_recovery_timeout",
 	}
@@ -100,6 +101,45 @@ func forceNewIfNetworkIPNotUpdatableFunc(d tpgresource.TerraformResourceDiff) er
 	return nil
 }
 
+// aliasIpRangesRemovalRequired returns whether the current alias IP ranges of
+// a network interface need to be removed before the desired ones can be set,
+// which is the case unless every current range is kept as is.
+func aliasIpRangesRemovalRequired(current, desired []*compute.AliasIpRange) bool {
+	kept := make(map[string]bool, len(desired))
+	for _, r := range desired {
+		kept[r.IpCidrRange+"/"+r.SubnetworkRangeName] = true
+	}
+	for _, r := range current {
+		if !kept[r.IpCidrRange+"/"+r.SubnetworkRangeName] {
+			return true
+		}
+	}
+	return false
+}
+
+func validateNetworkPerformanceConfigNicType(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	// separate func to allow unit testing
+	return validateNetworkPerformanceConfigNicTypeFunc(d)
+}
+
+// Tier_1 networking is only available to instances using gVNIC, so reject
+// configs that explicitly select another vNIC type instead of failing at create.
+func validateNetworkPerformanceConfigNicTypeFunc(d tpgresource.TerraformResourceDiff) error {
+	if tier, _ := d.Get("network_performance_config.0.total_egress_bandwidth_tier").(string); tier != "TIER_1" {
+		return nil
+	}
+
+	count, _ := d.Get("network_interface.#").(int)
+	for i := 0; i < count; i++ {
+		key := fmt.Sprintf("network_interface.%d.nic_type", i)
+		if nicType, _ := d.Get(key).(string); nicType != "" && nicType != "GVNIC" {
+			return fmt.Errorf("%s must be GVNIC when network_performance_config.0.total_egress_bandwidth_tier is TIER_1, got %s", key, nicType)
+		}
+	}
+
+	return nil
+}
+
 // User may specify AUTOMATIC using any case; the API will accept it and return an empty string.
 func ComputeInstanceMinCpuPlatformEmptyOrAutomaticDiffSuppress(k, old, new string, d *schema.ResourceData) bool {
 	old = strings.ToLower(old)
@@ -317,7 +357,7 @@ func ResourceComputeInstance() *schema.Resource {
 							Type:             schema.TypeString,
 							Optional:         true,
 							Computed:         true,
-							DiffSuppressFunc: tpgresource.CompareSelfLinkOrResourceName,
+							DiffSuppressFunc: tpgresource.CompareResourceReferences,
 							Description:      `The name or self_link of the network attached to this interface.`,
 						},
 
@@ -325,7 +365,7 @@ func ResourceComputeInstance() *schema.Resource {
 							Type:             schema.TypeString,
 							Optional:         true,
 							Computed:         true,
-							DiffSuppressFunc: tpgresource.CompareSelfLinkOrResourceName,
+							DiffSuppressFunc: tpgresource.CompareResourceReferences,
 							Description:      `The name or self_link of the subnetwork attached to this interface.`,
 						},
 
@@ -686,6 +726,13 @@ func ResourceComputeInstance() *schema.Resource {
 				Description: `Metadata key/value pairs made available within the instance.`,
 			},
 
+			"effective_metadata": {
+				Type:        schema.TypeMap,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: `The combination of metadata configured directly on the resource and default metadata configured on the provider.`,
+			},
+
 			"metadata_startup_script": {
 				Type:        schema.TypeString,
 				Optional:    true,
@@ -776,10 +823,19 @@ func ResourceComputeInstance() *schema.Resource {
 							AtLeastOneOf: schedulingKeys,
 							Description:  `Specifies the action GCE should take when SPOT VM is preempted.`,
 						},
+						"termination_time": {
+							Type:             schema.TypeString,
+							Optional:         true,
+							ForceNew:         true,
+							ValidateFunc:     validation.IsRFC3339Time,
+							DiffSuppressFunc: tpgresource.TimestampDiffSuppress(time.RFC3339),
+							AtLeastOneOf:     schedulingKeys,
+							Description:      `Specifies the timestamp, when the instance will be terminated, in RFC3339 text format. If specified, the instance termination action will be performed at the termination time.`,
+						},
 						"max_run_duration": {
 							Type:        schema.TypeList,
 							Optional:    true,
-							Description: `The timeout for new network connections to hosts.`,
+							Description: `The duration of time the instance can run before it is terminated with its instance_termination_action.`,
 							MaxItems:    1,
 							ForceNew:    true,
 							Elem: &schema.Resource{
@@ -952,13 +1008,13 @@ be from 0 to 999,999,999 inclusive.`,
 						"enable_nested_virtualization": {
 							Type:         schema.TypeBool,
 							Optional:     true,
-							AtLeastOneOf: []string{"advanced_machine_features.0.enable_nested_virtualization", "advanced_machine_features.0.threads_per_core"},
+							AtLeastOneOf: []string{"advanced_machine_features.0.enable_nested_virtualization", "advanced_machine_features.0.threads_per_core", "advanced_machine_features.0.visible_core_count"},
 							Description:  `Whether to enable nested virtualization or not.`,
 						},
 						"threads_per_core": {
 							Type:         schema.TypeInt,
 							Optional:     true,
-							AtLeastOneOf: []string{"advanced_machine_features.0.enable_nested_virtualization", "advanced_machine_features.0.threads_per_core"},
+							AtLeastOneOf: []string{"advanced_machine_features.0.enable_nested_virtualization", "advanced_machine_features.0.threads_per_core", "advanced_machine_features.0.visible_core_count"},
 							Description:  `The number of threads per physical core. To disable simultaneous multithreading (SMT) set this to 1. If unset, the maximum number of threads supported per core by the underlying processor is assumed.`,
 						},
 						"visible_core_count": {
@@ -1020,6 +1076,14 @@ be from 0 to 999,999,999 inclusive.`,
 				Description: `The list of tags attached to the instance.`,
 			},
 
+			"effective_tags": {
+				Type:        schema.TypeSet,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Set:         schema.HashString,
+				Description: `The combination of tags configured directly on the resource and default tags configured on the provider.`,
+			},
+
 			"zone": {
 				Type:        schema.TypeString,
 				Optional:    true,
@@ -1137,7 +1201,10 @@ be from 0 to 999,999,999 inclusive.`,
 			),
 			desiredStatusDiff,
 			forceNewIfNetworkIPNotUpdatable,
+			validateNetworkPerformanceConfigNicType,
 			tpgresource.SetLabelsDiff,
+			setEffectiveMetadataDiff,
+			setEffectiveTagsDiff,
 		),
 		UseJSONNumber: true,
 	}
@@ -1431,6 +1498,11 @@ func resourceComputeInstanceRead(d *schema.ResourceData, meta interface{}) error
 		delete(md, "startup-script")
 	}
 
+	if err = d.Set("effective_metadata", md); err != nil {
+		return fmt.Errorf("Error setting effective_metadata: %s", err)
+	}
+
+	md = removeDefaultMetadata(md, d.Get("metadata").(map[string]interface{}), config.DefaultMetadata)
 	if err = d.Set("metadata", md); err != nil {
 		return fmt.Errorf("Error setting metadata: %s", err)
 	}
@@ -1476,7 +1548,11 @@ func resourceComputeInstanceRead(d *schema.ResourceData, meta interface{}) error
 		if err := d.Set("tags_fingerprint", instance.Tags.Fingerprint); err != nil {
 			return fmt.Errorf("Error setting tags_fingerprint: %s", err)
 		}
-		if err := d.Set("tags", tpgresource.ConvertStringArrToInterface(instance.Tags.Items)); err != nil {
+		if err := d.Set("effective_tags", tpgresource.ConvertStringArrToInterface(instance.Tags.Items)); err != nil {
+			return fmt.Errorf("Error setting effective_tags: %s", err)
+		}
+		tags := removeDefaultTags(instance.Tags.Items, d.Get("tags").(*schema.Set), config.DefaultTags)
+		if err := d.Set("tags", tpgresource.ConvertStringArrToInterface(tags)); err != nil {
 			return fmt.Errorf("Error setting tags: %s", err)
 		}
 	}
@@ -1735,7 +1811,7 @@ func resourceComputeInstanceUpdate(d *schema.ResourceData, meta interface{}) err
 		}
 	}
 
-	if d.HasChange("metadata") {
+	if d.HasChange("effective_metadata") {
 		metadata, err := resourceInstanceMetadata(d)
 		if err != nil {
 			return fmt.Errorf("Error parsing metadata: %s", err)
@@ -1758,9 +1834,10 @@ func resourceComputeInstanceUpdate(d *schema.ResourceData, meta interface{}) err
 
 				metadataV1.Fingerprint = instance.Metadata.Fingerprint
 
+				// Return the API error as is so that fingerprint mismatches are recognised
 				op, err := config.NewComputeClient(userAgent).Instances.SetMetadata(project, zone, instance.Name, metadataV1).Do()
 				if err != nil {
-					return fmt.Errorf("Error updating metadata: %s", err)
+					return err
 				}
 
 				opErr := ComputeOperationWaitTime(config, op, project, "metadata to update", userAgent, d.Timeout(schema.TimeoutUpdate))
@@ -1770,14 +1847,16 @@ func resourceComputeInstanceUpdate(d *schema.ResourceData, meta interface{}) err
 
 				return nil
 			},
+			Timeout:              d.Timeout(schema.TimeoutUpdate),
+			ErrorRetryPredicates: []transport_tpg.RetryErrorPredicateFunc{transport_tpg.IsFingerprintError},
 		})
 
 		if err != nil {
-			return err
+			return fmt.Errorf("Error updating metadata: %s", err)
 		}
 	}
 
-	if d.HasChange("tags") {
+	if d.HasChange("effective_tags") {
 		tags := resourceInstanceTags(d)
 		tagsV1 := &compute.Tags{}
 		if err := tpgresource.Convert(tags, tagsV1); err != nil {
@@ -1998,10 +2077,23 @@ func resourceComputeInstanceUpdate(d *schema.ResourceData, meta interface{}) err
 			instNetworkInterface = instance.NetworkInterfaces[i]
 		}
 
+		// Each update changes the fingerprint of the network interface, so it
+		// has to be read again before the next one.
+		refreshNetworkInterface := func() error {
+			var err error
+			instance, err = config.NewComputeClient(userAgent).Instances.Get(project, zone, instance.Name).Do()
+			if err != nil {
+				return err
+			}
+			instNetworkInterface = instance.NetworkInterfaces[i]
+			return nil
+		}
+
 		if !updateDuringStop && d.HasChange(prefix+".alias_ip_range") {
 			// Alias IP ranges cannot be updated; they must be removed and then added
-			// unless you are changing subnetwork/network
-			if len(instNetworkInterface.AliasIpRanges) > 0 {
+			// unless you are changing subnetwork/network. Ranges that are only being
+			// added are applied directly, so the existing ones stay reachable.
+			if aliasIpRangesRemovalRequired(instNetworkInterface.AliasIpRanges, networkInterface.AliasIpRanges) {
 				ni := &compute.NetworkInterface{
 					Fingerprint:     instNetworkInterface.Fingerprint,
 					ForceSendFields: []string{"AliasIpRanges"},
@@ -2014,12 +2106,9 @@ func resourceComputeInstanceUpdate(d *schema.ResourceData, meta interface{}) err
 				if opErr != nil {
 					return opErr
 				}
-				// re-read fingerprint
-				instance, err = config.NewComputeClient(userAgent).Instances.Get(project, zone, instance.Name).Do()
-				if err != nil {
+				if err := refreshNetworkInterface(); err != nil {
 					return err
 				}
-				instNetworkInterface = instance.NetworkInterfaces[i]
 			}
 
 			networkInterfacePatchObj := &compute.NetworkInterface{
@@ -2035,6 +2124,9 @@ func resourceComputeInstanceUpdate(d *schema.ResourceData, meta interface{}) err
 			if opErr != nil {
 				return opErr
 			}
+			if err := refreshNetworkInterface(); err != nil {
+				return err
+			}
 		}
 
 		if !updateDuringStop && d.HasChange(prefix+".stack_type") {
@@ -2052,6 +2144,9 @@ func resourceComputeInstanceUpdate(d *schema.ResourceData, meta interface{}) err
 			if opErr != nil {
 				return opErr
 			}
+			if err := refreshNetworkInterface(); err != nil {
+				return err
+			}
 		}
 
 		if !updateDuringStop && d.HasChange(prefix+".ipv6_address") {
@@ -2069,12 +2164,15 @@ func resourceComputeInstanceUpdate(d *schema.ResourceData, meta interface{}) err
 			if opErr != nil {
 				return opErr
 			}
+			if err := refreshNetworkInterface(); err != nil {
+				return err
+			}
 		}
 
 		if !updateDuringStop && d.HasChange(prefix+".internal_ipv6_prefix_length") {
 
 			networkInterfacePatchObj := &compute.NetworkInterface{
-				InternalIpv6PrefixLength: d.Get(prefix + ".internal_ipv6_prefix_length").(int64),
+				InternalIpv6PrefixLength: int64(d.Get(prefix + ".internal_ipv6_prefix_length").(int)),
 				Fingerprint:              instNetworkInterface.Fingerprint,
 			}
 			updateCall := config.NewComputeClient(userAgent).Instances.UpdateNetworkInterface(project, zone, instance.Name, networkName, networkInterfacePatchObj).Do
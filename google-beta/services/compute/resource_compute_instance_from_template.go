@@ -188,6 +188,15 @@ func resourceComputeInstanceFromTemplateCreate(d *schema.ResourceData, meta inte
 		}
 	}
 
+	// Merge the provider's default metadata and tags over the template's
+	// values, rather than replacing them, if they aren't set on the resource.
+	if len(config.DefaultMetadata) > 0 && d.GetRawConfig().GetAttr("metadata").IsNull() {
+		instance.Metadata = mergeDefaultMetadataOverTemplate(it.Properties.Metadata, config.DefaultMetadata)
+	}
+	if len(config.DefaultTags) > 0 && d.GetRawConfig().GetAttr("tags").IsNull() {
+		instance.Tags = mergeDefaultTagsOverTemplate(it.Properties.Tags, config.DefaultTags)
+	}
+
 	// when we make the original call to expandComputeInstance expandScheduling is called, which sets default values.
 	// However, we want the values to be read from the template instead.
 	if _, hasSchedule := d.GetOk("scheduling"); !hasSchedule {
@@ -28,6 +28,8 @@ import (
 
 	"github.com/hashicorp/terraform-provider-google-beta/google-beta/tpgresource"
 	transport_tpg "github.com/hashicorp/terraform-provider-google-beta/google-beta/transport"
+
+	"google.golang.org/api/googleapi"
 )
 
 func ResourceComputeInstanceGroupNamedPort() *schema.Resource {
@@ -130,10 +132,6 @@ func resourceComputeInstanceGroupNamedPortCreate(d *schema.ResourceData, meta in
 
 	log.Printf("[DEBUG] Creating new InstanceGroupNamedPort: %#v", obj)
 
-	obj, err = resourceComputeInstanceGroupNamedPortPatchCreateEncoder(d, meta, obj)
-	if err != nil {
-		return err
-	}
 	billingProject := ""
 
 	project, err := tpgresource.GetProject(d, config)
@@ -147,14 +145,8 @@ func resourceComputeInstanceGroupNamedPortCreate(d *schema.ResourceData, meta in
 		billingProject = bp
 	}
 
-	res, err := transport_tpg.SendRequest(transport_tpg.SendRequestOptions{
-		Config:    config,
-		Method:    "POST",
-		Project:   billingProject,
-		RawURL:    url,
-		UserAgent: userAgent,
-		Body:      obj,
-		Timeout:   d.Timeout(schema.TimeoutCreate),
+	res, err := setInstanceGroupNamedPorts(config, url, billingProject, userAgent, d.Timeout(schema.TimeoutCreate), func() (map[string]interface{}, error) {
+		return resourceComputeInstanceGroupNamedPortPatchCreateEncoder(d, meta, obj)
 	})
 	if err != nil {
 		return fmt.Errorf("Error creating InstanceGroupNamedPort: %s", err)
@@ -281,25 +273,14 @@ func resourceComputeInstanceGroupNamedPortDelete(d *schema.ResourceData, meta in
 
 	var obj map[string]interface{}
 
-	obj, err = resourceComputeInstanceGroupNamedPortPatchDeleteEncoder(d, meta, obj)
-	if err != nil {
-		return transport_tpg.HandleNotFoundError(err, d, "InstanceGroupNamedPort")
-	}
-
 	// err == nil indicates that the billing_project value was found
 	if bp, err := tpgresource.GetBillingProject(d, config); err == nil {
 		billingProject = bp
 	}
 
 	log.Printf("[DEBUG] Deleting InstanceGroupNamedPort %q", d.Id())
-	res, err := transport_tpg.SendRequest(transport_tpg.SendRequestOptions{
-		Config:    config,
-		Method:    "POST",
-		Project:   billingProject,
-		RawURL:    url,
-		UserAgent: userAgent,
-		Body:      obj,
-		Timeout:   d.Timeout(schema.TimeoutDelete),
+	res, err := setInstanceGroupNamedPorts(config, url, billingProject, userAgent, d.Timeout(schema.TimeoutDelete), func() (map[string]interface{}, error) {
+		return resourceComputeInstanceGroupNamedPortPatchDeleteEncoder(d, meta, obj)
 	})
 	if err != nil {
 		return transport_tpg.HandleNotFoundError(err, d, "InstanceGroupNamedPort")
@@ -453,7 +434,7 @@ func resourceComputeInstanceGroupNamedPortFindNestedObjectInList(d *schema.Resou
 // PatchCreateEncoder handles creating request data to PATCH parent resource
 // with list including new object.
 func resourceComputeInstanceGroupNamedPortPatchCreateEncoder(d *schema.ResourceData, meta interface{}, obj map[string]interface{}) (map[string]interface{}, error) {
-	currItems, err := resourceComputeInstanceGroupNamedPortListForPatch(d, meta)
+	currItems, fingerprint, err := resourceComputeInstanceGroupNamedPortListForPatch(d, meta)
 	if err != nil {
 		return nil, err
 	}
@@ -470,7 +451,8 @@ func resourceComputeInstanceGroupNamedPortPatchCreateEncoder(d *schema.ResourceD
 
 	// Return list with the resource to create appended
 	res := map[string]interface{}{
-		"namedPorts": append(currItems, obj),
+		"namedPorts":  append(currItems, obj),
+		"fingerprint": fingerprint,
 	}
 
 	return res, nil
@@ -479,7 +461,7 @@ func resourceComputeInstanceGroupNamedPortPatchCreateEncoder(d *schema.ResourceD
 // PatchDeleteEncoder handles creating request data to PATCH parent resource
 // with list excluding object to delete.
 func resourceComputeInstanceGroupNamedPortPatchDeleteEncoder(d *schema.ResourceData, meta interface{}, obj map[string]interface{}) (map[string]interface{}, error) {
-	currItems, err := resourceComputeInstanceGroupNamedPortListForPatch(d, meta)
+	currItems, fingerprint, err := resourceComputeInstanceGroupNamedPortListForPatch(d, meta)
 	if err != nil {
 		return nil, err
 	}
@@ -495,28 +477,30 @@ func resourceComputeInstanceGroupNamedPortPatchDeleteEncoder(d *schema.ResourceD
 
 	updatedItems := append(currItems[:idx], currItems[idx+1:]...)
 	res := map[string]interface{}{
-		"namedPorts": updatedItems,
+		"namedPorts":  updatedItems,
+		"fingerprint": fingerprint,
 	}
 
 	return res, nil
 }
 
 // ListForPatch handles making API request to get parent resource and
-// extracting list of objects.
-func resourceComputeInstanceGroupNamedPortListForPatch(d *schema.ResourceData, meta interface{}) ([]interface{}, error) {
+// extracting list of objects, along with the fingerprint of the parent
+// resource to send with the updated list.
+func resourceComputeInstanceGroupNamedPortListForPatch(d *schema.ResourceData, meta interface{}) ([]interface{}, string, error) {
 	config := meta.(*transport_tpg.Config)
 	url, err := tpgresource.ReplaceVars(d, config, "{{ComputeBasePath}}projects/{{project}}/zones/{{zone}}/instanceGroups/{{group}}")
 	if err != nil {
-		return nil, err
+		return nil, "", err
 	}
 	project, err := tpgresource.GetProject(d, config)
 	if err != nil {
-		return nil, err
+		return nil, "", err
 	}
 
 	userAgent, err := tpgresource.GenerateUserAgentString(d, config.UserAgent)
 	if err != nil {
-		return nil, err
+		return nil, "", err
 	}
 
 	res, err := transport_tpg.SendRequest(transport_tpg.SendRequestOptions{
@@ -527,9 +511,11 @@ func resourceComputeInstanceGroupNamedPortListForPatch(d *schema.ResourceData, m
 		UserAgent: userAgent,
 	})
 	if err != nil {
-		return nil, err
+		return nil, "", err
 	}
 
+	fingerprint, _ := res["fingerprint"].(string)
+
 	var v interface{}
 	var ok bool
 
@@ -537,9 +523,43 @@ func resourceComputeInstanceGroupNamedPortListForPatch(d *schema.ResourceData, m
 	if ok && v != nil {
 		ls, lsOk := v.([]interface{})
 		if !lsOk {
-			return nil, fmt.Errorf(`expected list for nested field "namedPorts"`)
+			return nil, "", fmt.Errorf(`expected list for nested field "namedPorts"`)
 		}
-		return ls, nil
+		return ls, fingerprint, nil
 	}
-	return nil, nil
+	return nil, fingerprint, nil
+}
+
+// setInstanceGroupNamedPorts sends the named ports returned by encode to the
+// setNamedPorts url. If the named ports of the instance group changed since
+// encode read them, for example because GKE updated the named ports of a
+// group it manages, they are read and sent again.
+func setInstanceGroupNamedPorts(config *transport_tpg.Config, url, billingProject, userAgent string, timeout time.Duration, encode func() (map[string]interface{}, error)) (map[string]interface{}, error) {
+	for attempt := 1; ; attempt++ {
+		obj, err := encode()
+		if err != nil {
+			return nil, err
+		}
+
+		res, err := transport_tpg.SendRequest(transport_tpg.SendRequestOptions{
+			Config:    config,
+			Method:    "POST",
+			Project:   billingProject,
+			RawURL:    url,
+			UserAgent: userAgent,
+			Body:      obj,
+			Timeout:   timeout,
+		})
+		if err == nil || !isInstanceGroupFingerprintError(err) || attempt >= transport_tpg.METADATA_FINGERPRINT_RETRIES {
+			return res, err
+		}
+		log.Printf("[DEBUG] Named ports of the instance group changed since they were read, retrying: %s", err)
+	}
+}
+
+// isInstanceGroupFingerprintError returns whether err is caused by the
+// fingerprint sent to setNamedPorts no longer matching the instance group.
+func isInstanceGroupFingerprintError(err error) bool {
+	gerr, ok := err.(*googleapi.Error)
+	return ok && gerr.Code == 412
 }
@@ -0,0 +1,140 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+package compute
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"google.golang.org/api/googleapi"
+
+	transport_tpg "github.com/hashicorp/terraform-provider-google-beta/google-beta/transport"
+)
+
+// fakeInstanceGroup serves the instance group GET and setNamedPorts calls
+// used by google_compute_instance_group_named_port. After the first GET it
+// adds a named port of its own, like GKE does for groups it manages, so the
+// fingerprint read by the provider no longer matches.
+type fakeInstanceGroup struct {
+	mu          sync.Mutex
+	fingerprint int
+	namedPorts  []interface{}
+	gets        int
+	sets        int
+}
+
+func (f *fakeInstanceGroup) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	switch {
+	case r.Method == "GET" && r.URL.Path == "/projects/my-project/zones/us-central1-a/instanceGroups/my-group":
+		f.gets++
+		_ = json.NewEncoder(w).Encode(map[string]interface{}{
+			"fingerprint": fmt.Sprintf("fp%d", f.fingerprint),
+			"namedPorts":  f.namedPorts,
+		})
+		if f.gets == 1 {
+			f.namedPorts = append(f.namedPorts, map[string]interface{}{"name": "gke", "port": float64(10256)})
+			f.fingerprint++
+		}
+	case r.Method == "POST" && r.URL.Path == "/projects/my-project/zones/us-central1-a/instanceGroups/my-group/setNamedPorts":
+		f.sets++
+		var body map[string]interface{}
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		if body["fingerprint"] != fmt.Sprintf("fp%d", f.fingerprint) {
+			w.WriteHeader(http.StatusPreconditionFailed)
+			_ = json.NewEncoder(w).Encode(map[string]interface{}{
+				"error": map[string]interface{}{
+					"code":    http.StatusPreconditionFailed,
+					"message": "Supplied fingerprint does not match current fingerprint.",
+				},
+			})
+			return
+		}
+		f.namedPorts, _ = body["namedPorts"].([]interface{})
+		f.fingerprint++
+		_ = json.NewEncoder(w).Encode(map[string]interface{}{"name": "operation-1"})
+	default:
+		http.NotFound(w, r)
+	}
+}
+
+func TestSetInstanceGroupNamedPorts_fingerprintMismatch(t *testing.T) {
+	t.Parallel()
+
+	group := &fakeInstanceGroup{}
+	server := httptest.NewServer(group)
+	defer server.Close()
+
+	config := &transport_tpg.Config{
+		Client:          server.Client(),
+		ComputeBasePath: server.URL + "/",
+	}
+
+	d := ResourceComputeInstanceGroupNamedPort().TestResourceData()
+	for k, v := range map[string]interface{}{
+		"project": "my-project",
+		"zone":    "us-central1-a",
+		"group":   "my-group",
+		"name":    "http",
+		"port":    8080,
+	} {
+		if err := d.Set(k, v); err != nil {
+			t.Fatalf("error setting %s: %s", k, err)
+		}
+	}
+
+	url := server.URL + "/projects/my-project/zones/us-central1-a/instanceGroups/my-group/setNamedPorts"
+	obj := map[string]interface{}{"name": "http", "port": 8080}
+	res, err := setInstanceGroupNamedPorts(config, url, "my-project", "", time.Minute, func() (map[string]interface{}, error) {
+		return resourceComputeInstanceGroupNamedPortPatchCreateEncoder(d, config, obj)
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if res["name"] != "operation-1" {
+		t.Errorf("expected the setNamedPorts operation to be returned, got %#v", res)
+	}
+
+	if group.gets != 2 {
+		t.Errorf("expected the instance group to be read again after the fingerprint mismatch, got %d reads", group.gets)
+	}
+	if group.sets != 2 {
+		t.Errorf("expected setNamedPorts to be retried once, got %d calls", group.sets)
+	}
+
+	names := make(map[string]bool)
+	for _, p := range group.namedPorts {
+		names[p.(map[string]interface{})["name"].(string)] = true
+	}
+	if !names["gke"] || !names["http"] || len(names) != 2 {
+		t.Errorf("expected the named ports added since the first read to be kept, got %#v", group.namedPorts)
+	}
+}
+
+func TestIsInstanceGroupFingerprintError(t *testing.T) {
+	t.Parallel()
+
+	cases := map[string]struct {
+		err      error
+		expected bool
+	}{
+		"precondition failed": {&googleapi.Error{Code: 412}, true},
+		"not found":           {&googleapi.Error{Code: 404}, false},
+		"not an api error":    {fmt.Errorf("boom"), false},
+	}
+	for tn, tc := range cases {
+		if got := isInstanceGroupFingerprintError(tc.err); got != tc.expected {
+			t.Errorf("%s: expected %t, got %t", tn, tc.expected, got)
+		}
+	}
+}
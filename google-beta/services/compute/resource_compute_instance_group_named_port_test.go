@@ -0,0 +1,107 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+package compute_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	"github.com/hashicorp/terraform-provider-google-beta/google-beta/acctest"
+)
+
+func TestAccComputeInstanceGroupNamedPort_update(t *testing.T) {
+	acctest.SkipIfVcr(t)
+	t.Parallel()
+
+	context := map[string]interface{}{
+		"random_suffix": acctest.RandString(t, 10),
+		"http_port":     8080,
+		"https_port":    4443,
+	}
+	updated := map[string]interface{}{
+		"random_suffix": context["random_suffix"],
+		"http_port":     8081,
+		"https_port":    4444,
+	}
+
+	acctest.VcrTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.AccTestPreCheck(t) },
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories(t),
+		CheckDestroy:             testAccCheckComputeInstanceGroupNamedPortDestroyProducer(t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccComputeInstanceGroupNamedPort_gke(context),
+			},
+			{
+				ResourceName:            "google_compute_instance_group_named_port.http",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"group", "zone"},
+			},
+			{
+				// Both named ports are replaced at the same time on the same
+				// group, so one of the setNamedPorts calls hits a stale
+				// fingerprint and has to read the group again.
+				Config: testAccComputeInstanceGroupNamedPort_gke(updated),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("google_compute_instance_group_named_port.http", "port", "8081"),
+					resource.TestCheckResourceAttr("google_compute_instance_group_named_port.https", "port", "4444"),
+				),
+			},
+			{
+				ResourceName:            "google_compute_instance_group_named_port.https",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"group", "zone"},
+			},
+		},
+	})
+}
+
+func testAccComputeInstanceGroupNamedPort_gke(context map[string]interface{}) string {
+	return acctest.Nprintf(`
+resource "google_compute_instance_group_named_port" "http" {
+  group = google_container_cluster.cluster.node_pool[0].instance_group_urls[0]
+  zone  = "us-central1-a"
+
+  name = "http"
+  port = %{http_port}
+}
+
+resource "google_compute_instance_group_named_port" "https" {
+  group = google_container_cluster.cluster.node_pool[0].instance_group_urls[0]
+  zone  = "us-central1-a"
+
+  name = "https"
+  port = %{https_port}
+}
+
+resource "google_compute_network" "network" {
+  name                    = "tf-test-named-port-%{random_suffix}"
+  auto_create_subnetworks = false
+}
+
+resource "google_compute_subnetwork" "subnetwork" {
+  name          = "tf-test-named-port-%{random_suffix}"
+  region        = "us-central1"
+  network       = google_compute_network.network.name
+  ip_cidr_range = "10.0.36.0/24"
+}
+
+resource "google_container_cluster" "cluster" {
+  name               = "tf-test-named-port-%{random_suffix}"
+  location           = "us-central1-a"
+  initial_node_count = 1
+
+  network    = google_compute_network.network.name
+  subnetwork = google_compute_subnetwork.subnetwork.name
+
+  ip_allocation_policy {
+    cluster_ipv4_cidr_block  = "/19"
+    services_ipv4_cidr_block = "/22"
+  }
+  deletion_protection = false
+}
+`, context)
+}
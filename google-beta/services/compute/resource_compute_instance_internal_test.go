@@ -3,9 +3,18 @@
 package compute
 
 import (
+	"context"
+	"fmt"
+	"reflect"
 	"testing"
 
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	compute "google.golang.org/api/compute/v0.beta"
+
 	"github.com/hashicorp/terraform-provider-google-beta/google-beta/tpgresource"
+	transport_tpg "github.com/hashicorp/terraform-provider-google-beta/google-beta/transport"
 )
 
 func TestComputeInstance_networkIPCustomizedDiff(t *testing.T) {
@@ -135,3 +144,354 @@ func TestComputeInstance_networkIPCustomizedDiff(t *testing.T) {
 		}
 	}
 }
+
+func TestComputeInstance_networkPerformanceConfigNicType(t *testing.T) {
+	t.Parallel()
+
+	cases := map[string]struct {
+		Tier        string
+		NicTypes    []string
+		ExpectError bool
+	}{
+		"default tier with virtio": {
+			Tier:     "DEFAULT",
+			NicTypes: []string{"VIRTIO_NET"},
+		},
+		"tier 1 with gvnic": {
+			Tier:     "TIER_1",
+			NicTypes: []string{"GVNIC", "GVNIC"},
+		},
+		"tier 1 with unset nic type": {
+			Tier:     "TIER_1",
+			NicTypes: []string{""},
+		},
+		"tier 1 with virtio": {
+			Tier:        "TIER_1",
+			NicTypes:    []string{"GVNIC", "VIRTIO_NET"},
+			ExpectError: true,
+		},
+	}
+
+	for tn, tc := range cases {
+		after := map[string]interface{}{
+			"network_performance_config.0.total_egress_bandwidth_tier": tc.Tier,
+			"network_interface.#": len(tc.NicTypes),
+		}
+		for i, nicType := range tc.NicTypes {
+			after[fmt.Sprintf("network_interface.%d.nic_type", i)] = nicType
+		}
+		d := &tpgresource.ResourceDiffMock{
+			After: after,
+		}
+
+		err := validateNetworkPerformanceConfigNicTypeFunc(d)
+		if tc.ExpectError && err == nil {
+			t.Errorf("%s: expected an error", tn)
+		}
+		if !tc.ExpectError && err != nil {
+			t.Errorf("%s: unexpected error: %s", tn, err)
+		}
+	}
+}
+
+func TestComputeInstance_aliasIpRangesRemovalRequired(t *testing.T) {
+	t.Parallel()
+
+	a := &compute.AliasIpRange{IpCidrRange: "10.0.0.0/24", SubnetworkRangeName: "range-a"}
+	b := &compute.AliasIpRange{IpCidrRange: "10.0.1.0/24", SubnetworkRangeName: "range-b"}
+	aMoved := &compute.AliasIpRange{IpCidrRange: "10.0.2.0/24", SubnetworkRangeName: "range-a"}
+
+	cases := map[string]struct {
+		Current, Desired []*compute.AliasIpRange
+		Expected         bool
+	}{
+		"no current ranges": {
+			Desired:  []*compute.AliasIpRange{a},
+			Expected: false,
+		},
+		"range added": {
+			Current:  []*compute.AliasIpRange{a},
+			Desired:  []*compute.AliasIpRange{a, b},
+			Expected: false,
+		},
+		"range removed": {
+			Current:  []*compute.AliasIpRange{a, b},
+			Desired:  []*compute.AliasIpRange{b},
+			Expected: true,
+		},
+		"range changed": {
+			Current:  []*compute.AliasIpRange{a},
+			Desired:  []*compute.AliasIpRange{aMoved},
+			Expected: true,
+		},
+		"all ranges removed": {
+			Current:  []*compute.AliasIpRange{a},
+			Expected: true,
+		},
+	}
+
+	for tn, tc := range cases {
+		if got := aliasIpRangesRemovalRequired(tc.Current, tc.Desired); got != tc.Expected {
+			t.Errorf("%s: expected %t, got %t", tn, tc.Expected, got)
+		}
+	}
+}
+
+func TestComputeInstance_mergeDefaultTags(t *testing.T) {
+	t.Parallel()
+
+	cases := map[string]struct {
+		Defaults []string
+		Tags     []interface{}
+		Expected []string
+	}{
+		"no defaults": {
+			Tags:     []interface{}{"web"},
+			Expected: []string{"web"},
+		},
+		"defaults only": {
+			Defaults: []string{"allow-ssh"},
+			Expected: []string{"allow-ssh"},
+		},
+		"defaults and tags": {
+			Defaults: []string{"allow-ssh"},
+			Tags:     []interface{}{"web"},
+			Expected: []string{"allow-ssh", "web"},
+		},
+		"duplicate tag": {
+			Defaults: []string{"allow-ssh"},
+			Tags:     []interface{}{"allow-ssh", "web"},
+			Expected: []string{"allow-ssh", "web"},
+		},
+	}
+
+	for tn, tc := range cases {
+		got := schema.NewSet(schema.HashString, mergeDefaultTags(tc.Defaults, tc.Tags))
+		expected := schema.NewSet(schema.HashString, tpgresource.ConvertStringArrToInterface(tc.Expected))
+		if !got.Equal(expected) {
+			t.Errorf("%s: expected %v, got %v", tn, expected.List(), got.List())
+		}
+	}
+}
+
+func TestComputeInstance_removeDefaultTags(t *testing.T) {
+	t.Parallel()
+
+	cases := map[string]struct {
+		Tags       []string
+		Configured []interface{}
+		Defaults   []string
+		Expected   []string
+	}{
+		"no defaults": {
+			Tags:     []string{"web", "allow-ssh"},
+			Expected: []string{"web", "allow-ssh"},
+		},
+		"default removed": {
+			Tags:       []string{"web", "allow-ssh"},
+			Configured: []interface{}{"web"},
+			Defaults:   []string{"allow-ssh"},
+			Expected:   []string{"web"},
+		},
+		"default also configured": {
+			Tags:       []string{"web", "allow-ssh"},
+			Configured: []interface{}{"web", "allow-ssh"},
+			Defaults:   []string{"allow-ssh"},
+			Expected:   []string{"web", "allow-ssh"},
+		},
+	}
+
+	for tn, tc := range cases {
+		got := removeDefaultTags(tc.Tags, schema.NewSet(schema.HashString, tc.Configured), tc.Defaults)
+		if !reflect.DeepEqual(got, tc.Expected) {
+			t.Errorf("%s: expected %v, got %v", tn, tc.Expected, got)
+		}
+	}
+}
+
+func TestComputeInstance_defaultMetadata(t *testing.T) {
+	t.Parallel()
+
+	defaults := map[string]string{
+		"enable-oslogin":         "TRUE",
+		"block-project-ssh-keys": "TRUE",
+	}
+
+	merged := mergeDefaultMetadata(defaults, map[string]interface{}{
+		"enable-oslogin": "FALSE",
+		"foo":            "bar",
+	})
+	expectedMerged := map[string]interface{}{
+		"enable-oslogin":         "FALSE",
+		"block-project-ssh-keys": "TRUE",
+		"foo":                    "bar",
+	}
+	if !reflect.DeepEqual(merged, expectedMerged) {
+		t.Errorf("merge: expected %v, got %v", expectedMerged, merged)
+	}
+
+	md := map[string]string{
+		"enable-oslogin":         "FALSE",
+		"block-project-ssh-keys": "TRUE",
+		"foo":                    "bar",
+	}
+	removed := removeDefaultMetadata(md, map[string]interface{}{
+		"enable-oslogin": "FALSE",
+		"foo":            "bar",
+	}, defaults)
+	expectedRemoved := map[string]string{
+		"enable-oslogin": "FALSE",
+		"foo":            "bar",
+	}
+	if !reflect.DeepEqual(removed, expectedRemoved) {
+		t.Errorf("remove: expected %v, got %v", expectedRemoved, removed)
+	}
+}
+
+func TestComputeInstance_mergeDefaultsOverTemplate(t *testing.T) {
+	t.Parallel()
+
+	oslogin := "FALSE"
+	startup := "echo hi"
+	md := mergeDefaultMetadataOverTemplate(&compute.Metadata{
+		Items: []*compute.MetadataItems{
+			{Key: "enable-oslogin", Value: &oslogin},
+			{Key: "startup-script", Value: &startup},
+		},
+	}, map[string]string{
+		"enable-oslogin":         "TRUE",
+		"block-project-ssh-keys": "TRUE",
+	})
+	expectedMetadata := map[string]string{
+		"block-project-ssh-keys": "TRUE",
+		"enable-oslogin":         "TRUE",
+		"startup-script":         "echo hi",
+	}
+	if got := flattenMetadataBeta(md); !reflect.DeepEqual(got, expectedMetadata) {
+		t.Errorf("metadata: expected %v, got %v", expectedMetadata, got)
+	}
+
+	tags := mergeDefaultTagsOverTemplate(&compute.Tags{Items: []string{"web"}}, []string{"allow-ssh"})
+	got := schema.NewSet(schema.HashString, tpgresource.ConvertStringArrToInterface(tags.Items))
+	expected := schema.NewSet(schema.HashString, []interface{}{"allow-ssh", "web"})
+	if !got.Equal(expected) {
+		t.Errorf("tags: expected %v, got %v", expected.List(), got.List())
+	}
+
+	if md := mergeDefaultMetadataOverTemplate(nil, map[string]string{"foo": "bar"}); len(md.Items) != 1 {
+		t.Errorf("expected the defaults to be used without template metadata, got %v", flattenMetadataBeta(md))
+	}
+}
+
+func TestComputeInstance_effectiveMetadataFromTemplate(t *testing.T) {
+	t.Parallel()
+
+	r := &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"source_instance_template": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"metadata": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"effective_metadata": {
+				Type:     schema.TypeMap,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		},
+		CustomizeDiff: setEffectiveMetadataDiff,
+	}
+	config := &transport_tpg.Config{
+		DefaultMetadata: map[string]string{"enable-oslogin": "TRUE"},
+	}
+	objType := cty.Object(map[string]cty.Type{
+		"source_instance_template": cty.String,
+		"metadata":                 cty.Map(cty.String),
+		"effective_metadata":       cty.Map(cty.String),
+	})
+
+	cases := map[string]struct {
+		Id              string
+		Attributes      map[string]string
+		Config          map[string]interface{}
+		RawMetadata     cty.Value
+		ExpectComputed  bool
+		ExpectEffective map[string]string
+	}{
+		"create without metadata": {
+			Config:         map[string]interface{}{"source_instance_template": "tpl"},
+			RawMetadata:    cty.NullVal(cty.Map(cty.String)),
+			ExpectComputed: true,
+		},
+		"update without metadata": {
+			Id: "instance",
+			Attributes: map[string]string{
+				"source_instance_template":          "tpl",
+				"metadata.%":                        "1",
+				"metadata.from-template":            "yes",
+				"effective_metadata.%":              "2",
+				"effective_metadata.from-template":  "yes",
+				"effective_metadata.enable-oslogin": "TRUE",
+			},
+			Config:      map[string]interface{}{"source_instance_template": "tpl"},
+			RawMetadata: cty.NullVal(cty.Map(cty.String)),
+		},
+		"create with metadata": {
+			Config: map[string]interface{}{
+				"source_instance_template": "tpl",
+				"metadata":                 map[string]interface{}{"foo": "bar"},
+			},
+			RawMetadata: cty.MapVal(map[string]cty.Value{"foo": cty.StringVal("bar")}),
+			ExpectEffective: map[string]string{
+				"effective_metadata.%":              "2",
+				"effective_metadata.foo":            "bar",
+				"effective_metadata.enable-oslogin": "TRUE",
+			},
+		},
+	}
+
+	for tn, tc := range cases {
+		raw := cty.ObjectVal(map[string]cty.Value{
+			"source_instance_template": cty.StringVal("tpl"),
+			"metadata":                 tc.RawMetadata,
+			"effective_metadata":       cty.NullVal(cty.Map(cty.String)),
+		})
+		state := &terraform.InstanceState{
+			ID:         tc.Id,
+			Attributes: tc.Attributes,
+			RawConfig:  raw,
+			RawPlan:    raw,
+			RawState:   cty.NullVal(objType),
+		}
+		diff, err := r.Diff(context.Background(), state, terraform.NewResourceConfigRaw(tc.Config), config)
+		if err != nil {
+			t.Fatalf("%s: unexpected error: %s", tn, err)
+		}
+
+		var attrs map[string]*terraform.ResourceAttrDiff
+		if diff != nil {
+			attrs = diff.Attributes
+		}
+		computed := attrs["effective_metadata.%"] != nil && attrs["effective_metadata.%"].NewComputed
+		if computed != tc.ExpectComputed {
+			t.Errorf("%s: expected effective_metadata computed to be %t, got diff %v", tn, tc.ExpectComputed, attrs)
+		}
+		if tc.ExpectEffective == nil && !tc.ExpectComputed {
+			for k := range attrs {
+				if len(k) > len("effective_metadata") && k[:len("effective_metadata")] == "effective_metadata" {
+					t.Errorf("%s: expected no effective_metadata diff, got %v", tn, attrs[k])
+				}
+			}
+		}
+		for k, v := range tc.ExpectEffective {
+			if attrs[k] == nil || attrs[k].New != v {
+				t.Errorf("%s: expected %s = %q, got %v", tn, k, v, attrs[k])
+			}
+		}
+	}
+}
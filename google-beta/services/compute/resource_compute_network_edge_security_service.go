@@ -72,9 +72,10 @@ func ResourceComputeNetworkEdgeSecurityService() *schema.Resource {
 				Description:      `The region of the gateway security policy.`,
 			},
 			"security_policy": {
-				Type:        schema.TypeString,
-				Optional:    true,
-				Description: `The resource URL for the network edge security service associated with this network edge security service.`,
+				Type:     schema.TypeString,
+				Optional: true,
+				Description: `The resource URL for the network edge security policy associated with this network edge security service.
+The policy must be a regional security policy of type CLOUD_ARMOR_NETWORK.`,
 			},
 			"creation_timestamp": {
 				Type:        schema.TypeString,
@@ -54,6 +54,8 @@ func ResourceComputeNetworkFirewallPolicyRule() *schema.Resource {
 		CustomizeDiff: customdiff.All(
 			tpgresource.DefaultProviderProject,
 			tpgresource.DefaultProviderRegion,
+			validateFirewallPolicyRuleMatch,
+			validateNetworkFirewallPolicyRuleTargets,
 		),
 
 		Schema: map[string]*schema.Schema{
@@ -0,0 +1,102 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+package compute
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-google-beta/google-beta/tpgresource"
+)
+
+func TestComputeNetworkFirewallPolicyRule_validateMatch(t *testing.T) {
+	t.Parallel()
+
+	cases := map[string]struct {
+		After       map[string]interface{}
+		ExpectError bool
+	}{
+		"ingress with source address groups": {
+			After: map[string]interface{}{
+				"direction":                  "INGRESS",
+				"match.0.src_address_groups": []interface{}{"projects/p/locations/global/addressGroups/a"},
+			},
+		},
+		"egress with destination address groups": {
+			After: map[string]interface{}{
+				"direction":                   "EGRESS",
+				"match.0.dest_address_groups": []interface{}{"projects/p/locations/global/addressGroups/a"},
+			},
+		},
+		"ingress with destination address groups": {
+			After: map[string]interface{}{
+				"direction":                   "INGRESS",
+				"match.0.dest_address_groups": []interface{}{"projects/p/locations/global/addressGroups/a"},
+			},
+			ExpectError: true,
+		},
+		"egress with source fqdns": {
+			After: map[string]interface{}{
+				"direction":         "EGRESS",
+				"match.0.src_fqdns": []interface{}{"example.com"},
+			},
+			ExpectError: true,
+		},
+		"unknown direction": {
+			After: map[string]interface{}{
+				"match.0.dest_fqdns": []interface{}{"example.com"},
+			},
+		},
+	}
+
+	for tn, tc := range cases {
+		d := &tpgresource.ResourceDiffMock{
+			After: tc.After,
+		}
+		err := validateFirewallPolicyRuleMatchFunc(d)
+		if tc.ExpectError && err == nil {
+			t.Errorf("%s: expected an error, got none", tn)
+		}
+		if !tc.ExpectError && err != nil {
+			t.Errorf("%s: unexpected error: %s", tn, err)
+		}
+	}
+}
+
+func TestComputeNetworkFirewallPolicyRule_validateTargets(t *testing.T) {
+	t.Parallel()
+
+	cases := map[string]struct {
+		SecureTags      []interface{}
+		ServiceAccounts []interface{}
+		ExpectError     bool
+	}{
+		"none": {},
+		"secure tags": {
+			SecureTags: []interface{}{map[string]interface{}{"name": "tagValues/123"}},
+		},
+		"service accounts": {
+			ServiceAccounts: []interface{}{"sa@p.iam.gserviceaccount.com"},
+		},
+		"both": {
+			SecureTags:      []interface{}{map[string]interface{}{"name": "tagValues/123"}},
+			ServiceAccounts: []interface{}{"sa@p.iam.gserviceaccount.com"},
+			ExpectError:     true,
+		},
+	}
+
+	for tn, tc := range cases {
+		d := &tpgresource.ResourceDiffMock{
+			After: map[string]interface{}{
+				"target_secure_tags":      tc.SecureTags,
+				"target_service_accounts": tc.ServiceAccounts,
+			},
+		}
+		err := validateNetworkFirewallPolicyRuleTargetsFunc(d)
+		if tc.ExpectError && err == nil {
+			t.Errorf("%s: expected an error, got none", tn)
+		}
+		if !tc.ExpectError && err != nil {
+			t.Errorf("%s: unexpected error: %s", tn, err)
+		}
+	}
+}
@@ -55,9 +55,10 @@ func ResourceComputeOrganizationSecurityPolicy() *schema.Resource {
 				Description: `A textual name of the security policy.`,
 			},
 			"parent": {
-				Type:     schema.TypeString,
-				Required: true,
-				ForceNew: true,
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidateRegexp(`^(organizations|folders)/[0-9]+$`),
 				Description: `The parent of this OrganizationSecurityPolicy in the Cloud Resource Hierarchy.
 Format: organizations/{organization_id} or folders/{folder_id}`,
 			},
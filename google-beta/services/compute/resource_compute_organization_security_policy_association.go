@@ -27,6 +27,7 @@ import (
 
 	"github.com/hashicorp/terraform-provider-google-beta/google-beta/tpgresource"
 	transport_tpg "github.com/hashicorp/terraform-provider-google-beta/google-beta/transport"
+	"github.com/hashicorp/terraform-provider-google-beta/google-beta/verify"
 )
 
 func ResourceComputeOrganizationSecurityPolicyAssociation() *schema.Resource {
@@ -46,10 +47,12 @@ func ResourceComputeOrganizationSecurityPolicyAssociation() *schema.Resource {
 
 		Schema: map[string]*schema.Schema{
 			"attachment_id": {
-				Type:        schema.TypeString,
-				Required:    true,
-				ForceNew:    true,
-				Description: `The resource that the security policy is attached to.`,
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidateRegexp(`^(organizations|folders)/[0-9]+$`),
+				Description: `The resource that the security policy is attached to.
+Format: organizations/{organization_id} or folders/{folder_id}`,
 			},
 			"name": {
 				Type:        schema.TypeString,
@@ -58,10 +61,12 @@ func ResourceComputeOrganizationSecurityPolicyAssociation() *schema.Resource {
 				Description: `The name for an association.`,
 			},
 			"policy_id": {
-				Type:        schema.TypeString,
-				Required:    true,
-				ForceNew:    true,
-				Description: `The security policy ID of the association.`,
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidateRegexp(`^locations/global/securityPolicies/[^/]+$`),
+				Description: `The security policy ID of the association, i.e. the id of a google_compute_organization_security_policy.
+Format: locations/global/securityPolicies/{policy_id}`,
 			},
 			"display_name": {
 				Type:        schema.TypeString,
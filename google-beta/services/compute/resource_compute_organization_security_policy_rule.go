@@ -140,10 +140,12 @@ the only supported type is "FIREWALL". Default value: "FIREWALL" Possible values
 				},
 			},
 			"policy_id": {
-				Type:        schema.TypeString,
-				Required:    true,
-				ForceNew:    true,
-				Description: `The ID of the OrganizationSecurityPolicy this rule applies to.`,
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidateRegexp(`^locations/global/securityPolicies/[^/]+$`),
+				Description: `The ID of the OrganizationSecurityPolicy this rule applies to, i.e. the id of a google_compute_organization_security_policy.
+Format: locations/global/securityPolicies/{policy_id}`,
 			},
 			"priority": {
 				Type:     schema.TypeInt,
@@ -84,6 +84,13 @@ func testAccComputePublicDelegatedPrefix_publicDelegatedPrefixesBasicTest(t *tes
 			{
 				Config: testAccComputePublicDelegatedPrefix_publicDelegatedPrefixesBasicExample(context),
 			},
+			{
+				// The parent prefix only lists the sub prefix once it's refreshed
+				Config: testAccComputePublicDelegatedPrefix_publicDelegatedPrefixesBasicExample(context),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("google_compute_public_delegated_prefix.prefix", "public_delegated_sub_prefixs.0.ip_cidr_range", "127.127.0.0/26"),
+				),
+			},
 			{
 				ResourceName:            "google_compute_public_delegated_prefix.prefix",
 				ImportState:             true,
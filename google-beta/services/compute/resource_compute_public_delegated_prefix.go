@@ -92,6 +92,56 @@ except the last character, which cannot be a dash.`,
 				ForceNew:    true,
 				Description: `If true, the prefix will be live migrated.`,
 			},
+			"public_delegated_sub_prefixs": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: `The list of sub public delegated prefixes that exist for this public delegated prefix.`,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"delegatee_project": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: `Name of the project scoping this PublicDelegatedSubPrefix.`,
+						},
+						"description": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: `An optional description of this resource.`,
+						},
+						"ip_cidr_range": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: `The IP address range, in CIDR format, represented by this sub public delegated prefix.`,
+						},
+						"is_address": {
+							Type:        schema.TypeBool,
+							Computed:    true,
+							Description: `Whether the sub prefix is delegated to create Address resources in the delegatee project.`,
+						},
+						"name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: `The name of the sub public delegated prefix.`,
+						},
+						"region": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: `The region of the sub public delegated prefix if it is regional.`,
+						},
+						"status": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: `The status of the sub public delegated prefix.`,
+						},
+					},
+				},
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+				Description: `The status of the public delegated prefix, for example INITIALIZING,
+READY_TO_ANNOUNCE, ANNOUNCED or DELETING.`,
+			},
 			"project": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -255,6 +305,12 @@ func resourceComputePublicDelegatedPrefixRead(d *schema.ResourceData, meta inter
 	if err := d.Set("ip_cidr_range", flattenComputePublicDelegatedPrefixIpCidrRange(res["ipCidrRange"], d, config)); err != nil {
 		return fmt.Errorf("Error reading PublicDelegatedPrefix: %s", err)
 	}
+	if err := d.Set("public_delegated_sub_prefixs", flattenComputePublicDelegatedPrefixPublicDelegatedSubPrefixs(res["publicDelegatedSubPrefixs"], d, config)); err != nil {
+		return fmt.Errorf("Error reading PublicDelegatedPrefix: %s", err)
+	}
+	if err := d.Set("status", flattenComputePublicDelegatedPrefixStatus(res["status"], d, config)); err != nil {
+		return fmt.Errorf("Error reading PublicDelegatedPrefix: %s", err)
+	}
 	if err := d.Set("self_link", tpgresource.ConvertSelfLinkToV1(res["selfLink"].(string))); err != nil {
 		return fmt.Errorf("Error reading PublicDelegatedPrefix: %s", err)
 	}
@@ -356,6 +412,63 @@ func flattenComputePublicDelegatedPrefixIpCidrRange(v interface{}, d *schema.Res
 	return v
 }
 
+func flattenComputePublicDelegatedPrefixPublicDelegatedSubPrefixs(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
+	if v == nil {
+		return v
+	}
+	l := v.([]interface{})
+	transformed := make([]interface{}, 0, len(l))
+	for _, raw := range l {
+		original := raw.(map[string]interface{})
+		if len(original) < 1 {
+			// Do not include empty json objects coming back from the api
+			continue
+		}
+		transformed = append(transformed, map[string]interface{}{
+			"delegatee_project": flattenComputePublicDelegatedPrefixPublicDelegatedSubPrefixsDelegateeProject(original["delegateeProject"], d, config),
+			"description":       flattenComputePublicDelegatedPrefixPublicDelegatedSubPrefixsDescription(original["description"], d, config),
+			"ip_cidr_range":     flattenComputePublicDelegatedPrefixPublicDelegatedSubPrefixsIpCidrRange(original["ipCidrRange"], d, config),
+			"is_address":        flattenComputePublicDelegatedPrefixPublicDelegatedSubPrefixsIsAddress(original["isAddress"], d, config),
+			"name":              flattenComputePublicDelegatedPrefixPublicDelegatedSubPrefixsName(original["name"], d, config),
+			"region":            flattenComputePublicDelegatedPrefixPublicDelegatedSubPrefixsRegion(original["region"], d, config),
+			"status":            flattenComputePublicDelegatedPrefixPublicDelegatedSubPrefixsStatus(original["status"], d, config),
+		})
+	}
+	return transformed
+}
+
+func flattenComputePublicDelegatedPrefixPublicDelegatedSubPrefixsDelegateeProject(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
+	return v
+}
+
+func flattenComputePublicDelegatedPrefixPublicDelegatedSubPrefixsDescription(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
+	return v
+}
+
+func flattenComputePublicDelegatedPrefixPublicDelegatedSubPrefixsIpCidrRange(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
+	return v
+}
+
+func flattenComputePublicDelegatedPrefixPublicDelegatedSubPrefixsIsAddress(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
+	return v
+}
+
+func flattenComputePublicDelegatedPrefixPublicDelegatedSubPrefixsName(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
+	return v
+}
+
+func flattenComputePublicDelegatedPrefixPublicDelegatedSubPrefixsRegion(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
+	return v
+}
+
+func flattenComputePublicDelegatedPrefixPublicDelegatedSubPrefixsStatus(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
+	return v
+}
+
+func flattenComputePublicDelegatedPrefixStatus(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
+	return v
+}
+
 func expandComputePublicDelegatedPrefixDescription(v interface{}, d tpgresource.TerraformResourceData, config *transport_tpg.Config) (interface{}, error) {
 	return v, nil
 }
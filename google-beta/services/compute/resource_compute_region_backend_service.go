@@ -123,6 +123,23 @@ func customDiffRegionBackendService(_ context.Context, d *schema.ResourceDiff, m
 	}
 }
 
+func validateRegionBackendServiceSessionAffinity(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	// separate func to allow unit testing
+	return validateRegionBackendServiceSessionAffinityFunc(d)
+}
+
+// CLIENT_IP_NO_DESTINATION affinity is only supported by internal passthrough
+// Network Load Balancers.
+func validateRegionBackendServiceSessionAffinityFunc(d tpgresource.TerraformResourceDiff) error {
+	if affinity, _ := d.Get("session_affinity").(string); affinity != "CLIENT_IP_NO_DESTINATION" {
+		return nil
+	}
+	if scheme, _ := d.Get("load_balancing_scheme").(string); scheme != "INTERNAL" {
+		return fmt.Errorf("session_affinity CLIENT_IP_NO_DESTINATION can only be used with load_balancing_scheme INTERNAL, got %s", scheme)
+	}
+	return nil
+}
+
 func ResourceComputeRegionBackendService() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceComputeRegionBackendServiceCreate,
@@ -144,6 +161,7 @@ func ResourceComputeRegionBackendService() *schema.Resource {
 		MigrateState:  tpgresource.MigrateStateNoop,
 		CustomizeDiff: customdiff.All(
 			customDiffRegionBackendService,
+			validateRegionBackendServiceSessionAffinity,
 			tpgresource.DefaultProviderProject,
 		),
 
@@ -975,7 +993,8 @@ If it is not provided, the provider region is used.`,
 				Optional:     true,
 				ValidateFunc: verify.ValidateEnum([]string{"NONE", "CLIENT_IP", "CLIENT_IP_PORT_PROTO", "CLIENT_IP_PROTO", "GENERATED_COOKIE", "HEADER_FIELD", "HTTP_COOKIE", "CLIENT_IP_NO_DESTINATION", ""}),
 				Description: `Type of session affinity to use. The default is NONE. Session affinity is
-not applicable if the protocol is UDP. Possible values: ["NONE", "CLIENT_IP", "CLIENT_IP_PORT_PROTO", "CLIENT_IP_PROTO", "GENERATED_COOKIE", "HEADER_FIELD", "HTTP_COOKIE", "CLIENT_IP_NO_DESTINATION"]`,
+not applicable if the protocol is UDP. CLIENT_IP_NO_DESTINATION can only be used
+when load_balancing_scheme is INTERNAL. Possible values: ["NONE", "CLIENT_IP", "CLIENT_IP_PORT_PROTO", "CLIENT_IP_PROTO", "GENERATED_COOKIE", "HEADER_FIELD", "HTTP_COOKIE", "CLIENT_IP_NO_DESTINATION"]`,
 			},
 			"subsetting": {
 				Type:        schema.TypeList,
@@ -0,0 +1,55 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+package compute
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-google-beta/google-beta/tpgresource"
+)
+
+func TestComputeRegionBackendService_validateSessionAffinity(t *testing.T) {
+	t.Parallel()
+
+	cases := map[string]struct {
+		SessionAffinity     string
+		LoadBalancingScheme string
+		ExpectError         bool
+	}{
+		"client ip with internal managed": {
+			SessionAffinity:     "CLIENT_IP",
+			LoadBalancingScheme: "INTERNAL_MANAGED",
+		},
+		"client ip no destination with internal": {
+			SessionAffinity:     "CLIENT_IP_NO_DESTINATION",
+			LoadBalancingScheme: "INTERNAL",
+		},
+		"client ip no destination with external": {
+			SessionAffinity:     "CLIENT_IP_NO_DESTINATION",
+			LoadBalancingScheme: "EXTERNAL",
+			ExpectError:         true,
+		},
+		"client ip no destination with internal managed": {
+			SessionAffinity:     "CLIENT_IP_NO_DESTINATION",
+			LoadBalancingScheme: "INTERNAL_MANAGED",
+			ExpectError:         true,
+		},
+	}
+
+	for tn, tc := range cases {
+		d := &tpgresource.ResourceDiffMock{
+			After: map[string]interface{}{
+				"session_affinity":      tc.SessionAffinity,
+				"load_balancing_scheme": tc.LoadBalancingScheme,
+			},
+		}
+
+		err := validateRegionBackendServiceSessionAffinityFunc(d)
+		if tc.ExpectError && err == nil {
+			t.Errorf("%s: expected an error", tn)
+		}
+		if !tc.ExpectError && err != nil {
+			t.Errorf("%s: unexpected error: %s", tn, err)
+		}
+	}
+}
@@ -18,6 +18,7 @@
 package compute
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"reflect"
@@ -31,10 +32,81 @@ import (
 	"github.com/hashicorp/terraform-provider-google-beta/google-beta/verify"
 )
 
+var regionCommitmentResourceTypes = []string{"VCPU", "MEMORY", "LOCAL_SSD", "ACCELERATOR"}
+
+func validateRegionCommitmentPlan(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	// separate func to allow unit testing
+	return validateRegionCommitmentPlanFunc(d)
+}
+
+// Commitments can't be changed or cancelled once purchased, so catch
+// mismatched categories and resources at plan time rather than at create.
+func validateRegionCommitmentPlanFunc(d tpgresource.TerraformResourceDiff) error {
+	category, _ := d.Get("category").(string)
+	licenseCount, _ := d.Get("license_resource.#").(int)
+	resourceCount, _ := d.Get("resources.#").(int)
+
+	if category == "LICENSE" {
+		if licenseCount == 0 {
+			return fmt.Errorf("license_resource must be set when category is LICENSE")
+		}
+		if resourceCount > 0 {
+			return fmt.Errorf("resources cannot be set when category is LICENSE")
+		}
+		if commitmentType, _ := d.Get("type").(string); commitmentType != "" {
+			return fmt.Errorf("type cannot be set when category is LICENSE, got %s", commitmentType)
+		}
+		return nil
+	}
+
+	if licenseCount > 0 {
+		return fmt.Errorf("license_resource can only be set when category is LICENSE")
+	}
+
+	types := make(map[string]bool)
+	for i := 0; i < resourceCount; i++ {
+		resourceType, _ := d.Get(fmt.Sprintf("resources.%d.type", i)).(string)
+		acceleratorType, _ := d.Get(fmt.Sprintf("resources.%d.accelerator_type", i)).(string)
+		if resourceType != "" && !tpgresource.StringInSlice(regionCommitmentResourceTypes, resourceType) {
+			return fmt.Errorf("resources.%d.type must be one of %v, got %s", i, regionCommitmentResourceTypes, resourceType)
+		}
+		if resourceType == "ACCELERATOR" && acceleratorType == "" {
+			return fmt.Errorf("resources.%d.accelerator_type must be set when type is ACCELERATOR", i)
+		}
+		if resourceType != "ACCELERATOR" && acceleratorType != "" {
+			return fmt.Errorf("resources.%d.accelerator_type can only be set when type is ACCELERATOR", i)
+		}
+		types[resourceType] = true
+	}
+	if types["VCPU"] != types["MEMORY"] {
+		return fmt.Errorf("VCPU and MEMORY resources must be committed together")
+	}
+
+	return nil
+}
+
+func regionCommitmentPurchaseConfirmation(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	// separate func to allow unit testing
+	return regionCommitmentPurchaseConfirmationFunc(d.Id() == "", d)
+}
+
+// Creating a commitment purchases it for the whole term, and it can't be
+// deleted afterwards. Require the purchase to be confirmed explicitly.
+func regionCommitmentPurchaseConfirmationFunc(isNew bool, d tpgresource.TerraformResourceDiff) error {
+	if !isNew {
+		return nil
+	}
+	if confirmed, _ := d.Get("confirm_irreversible_purchase").(bool); !confirmed {
+		return fmt.Errorf("creating a commitment is an irreversible purchase for the length of its plan; set confirm_irreversible_purchase to true to create it")
+	}
+	return nil
+}
+
 func ResourceComputeRegionCommitment() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceComputeRegionCommitmentCreate,
 		Read:   resourceComputeRegionCommitmentRead,
+		Update: resourceComputeRegionCommitmentUpdate,
 		Delete: resourceComputeRegionCommitmentDelete,
 
 		Importer: &schema.ResourceImporter{
@@ -48,6 +120,8 @@ func ResourceComputeRegionCommitment() *schema.Resource {
 
 		CustomizeDiff: customdiff.All(
 			tpgresource.DefaultProviderProject,
+			validateRegionCommitmentPlan,
+			regionCommitmentPurchaseConfirmation,
 		),
 
 		Schema: map[string]*schema.Schema{
@@ -209,6 +283,13 @@ The type could be one of the following value: 'MEMORY_OPTIMIZED', 'ACCELERATOR_O
 				Computed:    true,
 				Description: `A human-readable explanation of the status.`,
 			},
+			"confirm_irreversible_purchase": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+				Description: `Commitments are purchased for the full length of their plan and
+can't be cancelled or deleted. This must be set to true to create a commitment.`,
+			},
 			"project": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -377,6 +458,12 @@ func resourceComputeRegionCommitmentRead(d *schema.ResourceData, meta interface{
 		return transport_tpg.HandleNotFoundError(err, d, fmt.Sprintf("ComputeRegionCommitment %q", d.Id()))
 	}
 
+	// Explicitly set virtual fields to default values if unset
+	if _, ok := d.GetOkExists("confirm_irreversible_purchase"); !ok {
+		if err := d.Set("confirm_irreversible_purchase", false); err != nil {
+			return fmt.Errorf("Error setting confirm_irreversible_purchase: %s", err)
+		}
+	}
 	if err := d.Set("project", project); err != nil {
 		return fmt.Errorf("Error reading RegionCommitment: %s", err)
 	}
@@ -433,6 +520,11 @@ func resourceComputeRegionCommitmentRead(d *schema.ResourceData, meta interface{
 	return nil
 }
 
+func resourceComputeRegionCommitmentUpdate(d *schema.ResourceData, meta interface{}) error {
+	// Only the virtual field "confirm_irreversible_purchase" is mutable
+	return resourceComputeRegionCommitmentRead(d, meta)
+}
+
 func resourceComputeRegionCommitmentDelete(d *schema.ResourceData, meta interface{}) error {
 	log.Printf("[WARNING] Compute RegionCommitment resources"+
 		" cannot be deleted from Google Cloud. The resource %s will be removed from Terraform"+
@@ -460,6 +552,11 @@ func resourceComputeRegionCommitmentImport(d *schema.ResourceData, meta interfac
 	}
 	d.SetId(id)
 
+	// Explicitly set virtual fields to default values on import
+	if err := d.Set("confirm_irreversible_purchase", false); err != nil {
+		return nil, fmt.Errorf("Error setting confirm_irreversible_purchase: %s", err)
+	}
+
 	return []*schema.ResourceData{d}, nil
 }
 
@@ -0,0 +1,137 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+package compute
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-google-beta/google-beta/tpgresource"
+)
+
+func TestComputeRegionCommitment_validatePlan(t *testing.T) {
+	t.Parallel()
+
+	type commitmentResource struct {
+		Type, AcceleratorType string
+	}
+
+	cases := map[string]struct {
+		Category     string
+		Type         string
+		LicenseCount int
+		Resources    []commitmentResource
+		ExpectError  bool
+	}{
+		"vcpu and memory": {
+			Resources: []commitmentResource{{Type: "VCPU"}, {Type: "MEMORY"}},
+		},
+		"machine with accelerator and local ssd": {
+			Category:  "MACHINE",
+			Type:      "ACCELERATOR_OPTIMIZED",
+			Resources: []commitmentResource{{Type: "VCPU"}, {Type: "MEMORY"}, {Type: "ACCELERATOR", AcceleratorType: "nvidia-tesla-a100"}, {Type: "LOCAL_SSD"}},
+		},
+		"license": {
+			Category:     "LICENSE",
+			LicenseCount: 1,
+		},
+		"vcpu without memory": {
+			Resources:   []commitmentResource{{Type: "VCPU"}},
+			ExpectError: true,
+		},
+		"accelerator without accelerator type": {
+			Resources:   []commitmentResource{{Type: "ACCELERATOR"}},
+			ExpectError: true,
+		},
+		"accelerator type on local ssd": {
+			Resources:   []commitmentResource{{Type: "LOCAL_SSD", AcceleratorType: "nvidia-tesla-a100"}},
+			ExpectError: true,
+		},
+		"unknown resource type": {
+			Resources:   []commitmentResource{{Type: "GPU"}},
+			ExpectError: true,
+		},
+		"license without license resource": {
+			Category:    "LICENSE",
+			ExpectError: true,
+		},
+		"license with resources": {
+			Category:     "LICENSE",
+			LicenseCount: 1,
+			Resources:    []commitmentResource{{Type: "VCPU"}, {Type: "MEMORY"}},
+			ExpectError:  true,
+		},
+		"license with type": {
+			Category:     "LICENSE",
+			Type:         "GENERAL_PURPOSE_N2",
+			LicenseCount: 1,
+			ExpectError:  true,
+		},
+		"machine with license resource": {
+			Category:     "MACHINE",
+			LicenseCount: 1,
+			Resources:    []commitmentResource{{Type: "VCPU"}, {Type: "MEMORY"}},
+			ExpectError:  true,
+		},
+	}
+
+	for tn, tc := range cases {
+		after := map[string]interface{}{
+			"category":           tc.Category,
+			"type":               tc.Type,
+			"license_resource.#": tc.LicenseCount,
+			"resources.#":        len(tc.Resources),
+		}
+		for i, r := range tc.Resources {
+			after[fmt.Sprintf("resources.%d.type", i)] = r.Type
+			after[fmt.Sprintf("resources.%d.accelerator_type", i)] = r.AcceleratorType
+		}
+		d := &tpgresource.ResourceDiffMock{
+			After: after,
+		}
+
+		err := validateRegionCommitmentPlanFunc(d)
+		if tc.ExpectError && err == nil {
+			t.Errorf("%s: expected an error", tn)
+		}
+		if !tc.ExpectError && err != nil {
+			t.Errorf("%s: unexpected error: %s", tn, err)
+		}
+	}
+}
+
+func TestComputeRegionCommitment_purchaseConfirmation(t *testing.T) {
+	t.Parallel()
+
+	cases := map[string]struct {
+		IsNew       bool
+		Confirmed   bool
+		ExpectError bool
+	}{
+		"new and confirmed": {
+			IsNew:     true,
+			Confirmed: true,
+		},
+		"new and unconfirmed": {
+			IsNew:       true,
+			ExpectError: true,
+		},
+		"existing and unconfirmed": {},
+	}
+
+	for tn, tc := range cases {
+		d := &tpgresource.ResourceDiffMock{
+			After: map[string]interface{}{
+				"confirm_irreversible_purchase": tc.Confirmed,
+			},
+		}
+
+		err := regionCommitmentPurchaseConfirmationFunc(tc.IsNew, d)
+		if tc.ExpectError && err == nil {
+			t.Errorf("%s: expected an error", tn)
+		}
+		if !tc.ExpectError && err != nil {
+			t.Errorf("%s: unexpected error: %s", tn, err)
+		}
+	}
+}
@@ -89,6 +89,32 @@ func TestAccComputeRegionHealthCheck_http_port_spec(t *testing.T) {
 	})
 }
 
+func TestAccComputeRegionHealthCheck_grpc_port_spec(t *testing.T) {
+	t.Parallel()
+
+	hckName := fmt.Sprintf("tf-test-%s", acctest.RandString(t, 10))
+
+	acctest.VcrTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.AccTestPreCheck(t) },
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories(t),
+		CheckDestroy:             testAccCheckComputeRegionHealthCheckDestroyProducer(t),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccComputeRegionHealthCheck_grpc_no_port(hckName),
+				ExpectError: regexp.MustCompile("Error in grpc_health_check: Must specify port when using USE_FIXED_PORT as port_specification"),
+			},
+			{
+				Config: testAccComputeRegionHealthCheck_grpc_logged(hckName),
+			},
+			{
+				ResourceName:      "google_compute_region_health_check.foobar",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
 func TestAccComputeRegionHealthCheck_https_serving_port(t *testing.T) {
 	t.Parallel()
 
@@ -384,3 +410,39 @@ resource "google_compute_region_health_check" "foobar" {
 }
 `, hckName)
 }
+
+func testAccComputeRegionHealthCheck_grpc_no_port(hckName string) string {
+	return fmt.Sprintf(`
+resource "google_compute_region_health_check" "foobar" {
+  check_interval_sec  = 3
+  description         = "Resource created for Terraform acceptance testing"
+  healthy_threshold   = 3
+  name                = "health-test-%s"
+  timeout_sec         = 2
+  unhealthy_threshold = 3
+  grpc_health_check {
+    grpc_service_name = "testservice"
+  }
+}
+`, hckName)
+}
+
+func testAccComputeRegionHealthCheck_grpc_logged(hckName string) string {
+	return fmt.Sprintf(`
+resource "google_compute_region_health_check" "foobar" {
+  check_interval_sec  = 3
+  description         = "Resource created for Terraform acceptance testing"
+  healthy_threshold   = 3
+  name                = "health-test-%s"
+  timeout_sec         = 2
+  unhealthy_threshold = 3
+  grpc_health_check {
+    port              = 8080
+    grpc_service_name = "testservice"
+  }
+  log_config {
+    enable = true
+  }
+}
+`, hckName)
+}
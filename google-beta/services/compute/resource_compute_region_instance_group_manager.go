@@ -3,6 +3,7 @@
 package compute
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"strings"
@@ -37,6 +38,7 @@ func ResourceComputeRegionInstanceGroupManager() *schema.Resource {
 		CustomizeDiff: customdiff.All(
 			tpgresource.DefaultProviderProject,
 			tpgresource.DefaultProviderRegion,
+			validateRegionInstanceGroupManagerTargetShape,
 		),
 
 		Schema: map[string]*schema.Schema{
@@ -246,10 +248,11 @@ func ResourceComputeRegionInstanceGroupManager() *schema.Resource {
 			},
 
 			"distribution_policy_target_shape": {
-				Type:        schema.TypeString,
-				Optional:    true,
-				Computed:    true,
-				Description: `The shape to which the group converges either proactively or on resize events (depending on the value set in updatePolicy.instanceRedistributionType).`,
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringInSlice([]string{"EVEN", "BALANCED", "ANY", "ANY_SINGLE_ZONE"}, false),
+				Description:  `The shape to which the group converges either proactively or on resize events (depending on the value set in updatePolicy.instanceRedistributionType). Valid values are: "EVEN", "BALANCED", "ANY", "ANY_SINGLE_ZONE". Shapes other than EVEN require update_policy.0.instance_redistribution_type to be NONE.`,
 			},
 
 			"instance_lifecycle_policy": {
@@ -1036,6 +1039,26 @@ func flattenRegionUpdatePolicy(updatePolicy *compute.InstanceGroupManagerUpdateP
 	return results
 }
 
+func validateRegionInstanceGroupManagerTargetShape(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	// separate func to allow unit testing
+	return validateRegionInstanceGroupManagerTargetShapeFunc(d)
+}
+
+// Only the EVEN shape can be maintained by proactive redistribution, the other
+// shapes are rejected by the API unless redistribution is disabled.
+func validateRegionInstanceGroupManagerTargetShapeFunc(d tpgresource.TerraformResourceDiff) error {
+	shape, _ := d.Get("distribution_policy_target_shape").(string)
+	if shape == "" || shape == "EVEN" {
+		return nil
+	}
+
+	redistribution, _ := d.Get("update_policy.0.instance_redistribution_type").(string)
+	if redistribution != "NONE" {
+		return fmt.Errorf("distribution_policy_target_shape %s requires update_policy.0.instance_redistribution_type to be NONE", shape)
+	}
+	return nil
+}
+
 func expandDistributionPolicy(d *schema.ResourceData) *compute.DistributionPolicy {
 	dpz := d.Get("distribution_policy_zones").(*schema.Set)
 	dpts := d.Get("distribution_policy_target_shape").(string)
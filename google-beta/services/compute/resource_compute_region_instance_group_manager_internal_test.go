@@ -0,0 +1,59 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+package compute
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-google-beta/google-beta/tpgresource"
+)
+
+func TestComputeRegionInstanceGroupManager_validateTargetShape(t *testing.T) {
+	t.Parallel()
+
+	cases := map[string]struct {
+		TargetShape    string
+		Redistribution string
+		ExpectError    bool
+	}{
+		"unset": {},
+		"even with proactive redistribution": {
+			TargetShape:    "EVEN",
+			Redistribution: "PROACTIVE",
+		},
+		"balanced without redistribution": {
+			TargetShape:    "BALANCED",
+			Redistribution: "NONE",
+		},
+		"any single zone without redistribution": {
+			TargetShape:    "ANY_SINGLE_ZONE",
+			Redistribution: "NONE",
+		},
+		"balanced with proactive redistribution": {
+			TargetShape:    "BALANCED",
+			Redistribution: "PROACTIVE",
+			ExpectError:    true,
+		},
+		"any with default redistribution": {
+			TargetShape: "ANY",
+			ExpectError: true,
+		},
+	}
+
+	for tn, tc := range cases {
+		d := &tpgresource.ResourceDiffMock{
+			After: map[string]interface{}{
+				"distribution_policy_target_shape":             tc.TargetShape,
+				"update_policy.0.instance_redistribution_type": tc.Redistribution,
+			},
+		}
+
+		err := validateRegionInstanceGroupManagerTargetShapeFunc(d)
+		if tc.ExpectError && err == nil {
+			t.Errorf("%s: expected an error", tn)
+		}
+		if !tc.ExpectError && err != nil {
+			t.Errorf("%s: unexpected error: %s", tn, err)
+		}
+	}
+}
@@ -37,6 +37,8 @@ func ResourceComputeRegionInstanceTemplate() *schema.Resource {
 			resourceComputeInstanceTemplateScratchDiskCustomizeDiff,
 			resourceComputeInstanceTemplateBootDiskCustomizeDiff,
 			tpgresource.SetLabelsDiff,
+			setEffectiveMetadataDiff,
+			setEffectiveTagsDiff,
 		),
 
 		Timeouts: &schema.ResourceTimeout{
@@ -165,11 +167,12 @@ func ResourceComputeRegionInstanceTemplate() *schema.Resource {
 						},
 
 						"source_image": {
-							Type:        schema.TypeString,
-							Optional:    true,
-							Computed:    true,
-							ForceNew:    true,
-							Description: `The image from which to initialize this disk. This can be one of: the image's self_link, projects/{project}/global/images/{image}, projects/{project}/global/images/family/{family}, global/images/{image}, global/images/family/{family}, family/{family}, {project}/{family}, {project}/{image}, {family}, or {image}. ~> Note: Either source or source_image is required when creating a new instance except for when creating a local SSD.`,
+							Type:             schema.TypeString,
+							Optional:         true,
+							Computed:         true,
+							ForceNew:         true,
+							DiffSuppressFunc: tpgresource.CompareResourceReferences,
+							Description:      `The image from which to initialize this disk. This can be one of: the image's self_link, projects/{project}/global/images/{image}, projects/{project}/global/images/family/{family}, global/images/{image}, global/images/family/{family}, family/{family}, {project}/{family}, {project}/{image}, {family}, or {image}. ~> Note: Either source or source_image is required when creating a new instance except for when creating a local SSD.`,
 						},
 						"source_image_encryption_key": {
 							Type:     schema.TypeList,
@@ -348,6 +351,14 @@ Google Cloud KMS.`,
 				Description: `Metadata key/value pairs to make available from within instances created from this template.`,
 			},
 
+			"effective_metadata": {
+				Type:        schema.TypeMap,
+				Computed:    true,
+				ForceNew:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: `The combination of metadata configured directly on the resource and default metadata configured on the provider.`,
+			},
+
 			"metadata_startup_script": {
 				Type:        schema.TypeString,
 				Optional:    true,
@@ -393,7 +404,7 @@ Google Cloud KMS.`,
 							Optional:         true,
 							ForceNew:         true,
 							Computed:         true,
-							DiffSuppressFunc: tpgresource.CompareSelfLinkOrResourceName,
+							DiffSuppressFunc: tpgresource.CompareResourceReferences,
 							Description:      `The name or self_link of the network to attach this interface to. Use network attribute for Legacy or Auto subnetted networks and subnetwork for custom subnetted networks.`,
 						},
 
@@ -402,7 +413,7 @@ Google Cloud KMS.`,
 							Optional:         true,
 							ForceNew:         true,
 							Computed:         true,
-							DiffSuppressFunc: tpgresource.CompareSelfLinkOrResourceName,
+							DiffSuppressFunc: tpgresource.CompareResourceReferences,
 							Description:      `The name of the subnetwork to attach this interface to. The subnetwork must exist in the same region this instance will be created in. Either network or subnetwork must be provided.`,
 						},
 
@@ -658,10 +669,19 @@ Google Cloud KMS.`,
 							AtLeastOneOf: schedulingInstTemplateKeys,
 							Description:  `Specifies the action GCE should take when SPOT VM is preempted.`,
 						},
+						"termination_time": {
+							Type:             schema.TypeString,
+							Optional:         true,
+							ForceNew:         true,
+							ValidateFunc:     validation.IsRFC3339Time,
+							DiffSuppressFunc: tpgresource.TimestampDiffSuppress(time.RFC3339),
+							AtLeastOneOf:     schedulingInstTemplateKeys,
+							Description:      `Specifies the timestamp, when the instance will be terminated, in RFC3339 text format. If specified, the instance termination action will be performed at the termination time.`,
+						},
 						"max_run_duration": {
 							Type:        schema.TypeList,
 							Optional:    true,
-							Description: `The timeout for new network connections to hosts.`,
+							Description: `The duration of time the instance can run before it is terminated with its instance_termination_action.`,
 							MaxItems:    1,
 							ForceNew:    true,
 							Elem: &schema.Resource{
@@ -907,6 +927,15 @@ be from 0 to 999,999,999 inclusive.`,
 				Description: `Tags to attach to the instance.`,
 			},
 
+			"effective_tags": {
+				Type:        schema.TypeSet,
+				Computed:    true,
+				ForceNew:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Set:         schema.HashString,
+				Description: `The combination of tags configured directly on the resource and default tags configured on the provider.`,
+			},
+
 			"tags_fingerprint": {
 				Type:        schema.TypeString,
 				Computed:    true,
@@ -1190,6 +1219,11 @@ func resourceComputeRegionInstanceTemplateRead(d *schema.ResourceData, meta inte
 			delete(_md, "startup-script")
 		}
 
+		if err = d.Set("effective_metadata", _md); err != nil {
+			return fmt.Errorf("Error setting effective_metadata: %s", err)
+		}
+
+		_md = removeDefaultMetadata(_md, d.Get("metadata").(map[string]interface{}), config.DefaultMetadata)
 		if err = d.Set("metadata", _md); err != nil {
 			return fmt.Errorf("Error setting metadata: %s", err)
 		}
@@ -1276,10 +1310,17 @@ func resourceComputeRegionInstanceTemplateRead(d *schema.ResourceData, meta inte
 		}
 	}
 	if instanceProperties.Tags != nil {
-		if err = d.Set("tags", instanceProperties.Tags.Items); err != nil {
+		if err = d.Set("effective_tags", instanceProperties.Tags.Items); err != nil {
+			return fmt.Errorf("Error setting effective_tags: %s", err)
+		}
+		tags := removeDefaultTags(instanceProperties.Tags.Items, d.Get("tags").(*schema.Set), config.DefaultTags)
+		if err = d.Set("tags", tags); err != nil {
 			return fmt.Errorf("Error setting tags: %s", err)
 		}
 	} else {
+		if err = d.Set("effective_tags", nil); err != nil {
+			return fmt.Errorf("Error setting empty effective_tags: %s", err)
+		}
 		if err = d.Set("tags", nil); err != nil {
 			return fmt.Errorf("Error setting empty tags: %s", err)
 		}
@@ -0,0 +1,73 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+package compute
+
+import (
+	"context"
+	"log"
+
+	"github.com/hashicorp/terraform-provider-google-beta/google-beta/sweeper"
+)
+
+// This will sweep Compute Region Instance Templates
+func init() {
+	sweeper.AddTestSweepers("ComputeRegionInstanceTemplate", testSweepComputeRegionInstanceTemplate)
+}
+
+// At the time of writing, the CI only passes us-central1 as the region
+func testSweepComputeRegionInstanceTemplate(region string) error {
+	resourceName := "ComputeRegionInstanceTemplate"
+	log.Printf("[INFO][SWEEPER_LOG] Starting sweeper for %s", resourceName)
+
+	config, err := sweeper.SharedConfigForRegion(region)
+	if err != nil {
+		log.Printf("[INFO][SWEEPER_LOG] error getting shared config for region: %s", err)
+		return err
+	}
+
+	err = config.LoadAndValidate(context.Background())
+	if err != nil {
+		log.Printf("[INFO][SWEEPER_LOG] error loading: %s", err)
+		return err
+	}
+
+	instanceTemplates, err := config.NewComputeClient(config.UserAgent).RegionInstanceTemplates.List(config.Project, region).Do()
+	if err != nil {
+		log.Printf("[INFO][SWEEPER_LOG] Error in response from request region instance templates LIST: %s", err)
+		return nil
+	}
+
+	numTemplates := len(instanceTemplates.Items)
+	if numTemplates == 0 {
+		log.Printf("[INFO][SWEEPER_LOG] Nothing found in response.")
+		return nil
+	}
+
+	log.Printf("[INFO][SWEEPER_LOG] Found %d items in %s list response.", numTemplates, resourceName)
+	// Count items that weren't sweeped.
+	nonPrefixCount := 0
+	var names []string
+	for _, instanceTemplate := range instanceTemplates.Items {
+		// Increment count and skip if resource is not sweepable.
+		if !sweeper.IsSweepableTestResource(instanceTemplate.Name) {
+			nonPrefixCount++
+			continue
+		}
+		names = append(names, instanceTemplate.Name)
+	}
+
+	// Don't wait on operations as we may have a lot to delete
+	deleted, err := sweeper.DeleteInParallel(resourceName, names, func(name string) error {
+		_, err := config.NewComputeClient(config.UserAgent).RegionInstanceTemplates.Delete(config.Project, region, name).Do()
+		return err
+	})
+	if err != nil {
+		log.Printf("[INFO][SWEEPER_LOG] Deleted %d of %d %s resources: %s", deleted, len(names), resourceName, err)
+	}
+
+	if nonPrefixCount > 0 {
+		log.Printf("[INFO][SWEEPER_LOG] %d items without tf-test prefix remain.", nonPrefixCount)
+	}
+
+	return nil
+}
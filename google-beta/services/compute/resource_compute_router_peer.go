@@ -1365,19 +1365,17 @@ func resourceComputeRouterBgpPeerPatchUpdateEncoder(d *schema.ResourceData, meta
 	}
 
 	var md5AuthenticationKey map[string]interface{}
-	var deletedKeyName interface{}
-	var wasPresent bool
-	val, ok := obj["md5AuthenticationKey"]
-	if ok {
+	if val, ok := obj["md5AuthenticationKey"]; ok {
 		md5AuthenticationKey = val.(map[string]interface{})
 		//remove key from this map as it not needed here
 		delete(obj, "md5AuthenticationKey")
-	} else {
-		//check if key used to be present
-		deletedKeyName, wasPresent = item["md5AuthenticationKeyName"]
-		if wasPresent {
-			delete(item, "md5AuthenticationKeyName")
-		}
+	}
+
+	// The key previously referenced by this peer is replaced by the new one, or
+	// removed from the parent router if the peer no longer uses a key
+	previousKeyName := item["md5AuthenticationKeyName"]
+	if md5AuthenticationKey == nil {
+		delete(item, "md5AuthenticationKeyName")
 	}
 
 	//merging the bgpRouterPeer objects
@@ -1386,28 +1384,9 @@ func resourceComputeRouterBgpPeerPatchUpdateEncoder(d *schema.ResourceData, meta
 	}
 	log.Printf("[DEBUG] UpdateEncoder - sending new object to be updated %#v", item)
 
-	//merging the md5AuthenticationKeys objects
-	isKeyNew := true
 	log.Printf("[DEBUG] UpdateEncoder - currentMd5AuthenticationKeys %#v", md5AuthenticationKeys)
-	for i, val := range md5AuthenticationKeys {
-		key := val.(map[string]interface{})
-		if key["name"] == md5AuthenticationKey["name"] {
-			key = md5AuthenticationKey
-			md5AuthenticationKeys[i] = key
-			isKeyNew = false
-		}
-
-		if key["name"] == deletedKeyName {
-			//if the key was deleted, then remove it from the parent router object as well
-			md5AuthenticationKeys = append(md5AuthenticationKeys[:i], md5AuthenticationKeys[i+1:]...)
-			log.Printf("[DEBUG] deleting unused key from parent object ,md5AuthenticationKeys - %+v", md5AuthenticationKeys)
-		}
-
-	}
+	md5AuthenticationKeys = replaceRouterMd5AuthenticationKey(md5AuthenticationKeys, previousKeyName, md5AuthenticationKey)
 	bgpPeerItems[idx] = item
-	if isKeyNew {
-		md5AuthenticationKeys = append(md5AuthenticationKeys, md5AuthenticationKey)
-	}
 
 	res := map[string]interface{}{
 		"bgpPeers":              bgpPeerItems,
@@ -1435,13 +1414,7 @@ func resourceComputeRouterBgpPeerPatchDeleteEncoder(d *schema.ResourceData, meta
 	}
 
 	//if the removed bgp peer has some md5AuthKey associated with it, then remove the key from the router parent object as well
-	keyName := item["md5AuthenticationKeyName"]
-	for i, val := range md5AuthenticationKeys {
-		key := val.(map[string]interface{})
-		if key["name"] == keyName {
-			md5AuthenticationKeys = append(md5AuthenticationKeys[:i], md5AuthenticationKeys[i+1:]...)
-		}
-	}
+	md5AuthenticationKeys = replaceRouterMd5AuthenticationKey(md5AuthenticationKeys, item["md5AuthenticationKeyName"], nil)
 
 	updatedItems := append(currItems[:idx], currItems[idx+1:]...)
 	res := map[string]interface{}{
@@ -1452,6 +1425,30 @@ func resourceComputeRouterBgpPeerPatchDeleteEncoder(d *schema.ResourceData, meta
 	return res, nil
 }
 
+// replaceRouterMd5AuthenticationKey returns the md5 authentication keys of a
+// router without the key named previousName, and with newKey in place of any
+// key of the same name. newKey may be nil to only remove the previous key.
+func replaceRouterMd5AuthenticationKey(keys []interface{}, previousName interface{}, newKey map[string]interface{}) []interface{} {
+	result := make([]interface{}, 0, len(keys)+1)
+	for _, val := range keys {
+		key, ok := val.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		if previousName != nil && key["name"] == previousName {
+			continue
+		}
+		if newKey != nil && key["name"] == newKey["name"] {
+			continue
+		}
+		result = append(result, key)
+	}
+	if newKey != nil {
+		result = append(result, newKey)
+	}
+	return result
+}
+
 // ListForPatch handles making API request to get parent resource and
 // extracting list of objects.
 func resourceComputeRouterBgpPeerListForPatch(d *schema.ResourceData, meta interface{}) ([]interface{}, []interface{}, error) {
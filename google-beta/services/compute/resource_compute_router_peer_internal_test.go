@@ -0,0 +1,56 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+package compute
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestReplaceRouterMd5AuthenticationKey(t *testing.T) {
+	keyA := map[string]interface{}{"name": "a", "key": "secret-a"}
+	keyB := map[string]interface{}{"name": "b", "key": "secret-b"}
+	keyBRotated := map[string]interface{}{"name": "b", "key": "rotated-b"}
+	keyC := map[string]interface{}{"name": "c", "key": "secret-c"}
+
+	cases := map[string]struct {
+		Keys         []interface{}
+		PreviousName interface{}
+		NewKey       map[string]interface{}
+		Expected     []interface{}
+	}{
+		"add key": {
+			Keys:     []interface{}{keyA},
+			NewKey:   keyB,
+			Expected: []interface{}{keyA, keyB},
+		},
+		"rotate key": {
+			Keys:         []interface{}{keyA, keyB},
+			PreviousName: "b",
+			NewKey:       keyBRotated,
+			Expected:     []interface{}{keyA, keyBRotated},
+		},
+		"rename key": {
+			Keys:         []interface{}{keyA, keyB},
+			PreviousName: "b",
+			NewKey:       keyC,
+			Expected:     []interface{}{keyA, keyC},
+		},
+		"remove key": {
+			Keys:         []interface{}{keyA, keyB, keyC},
+			PreviousName: "b",
+			Expected:     []interface{}{keyA, keyC},
+		},
+		"no key": {
+			Keys:     []interface{}{keyA},
+			Expected: []interface{}{keyA},
+		},
+	}
+
+	for tn, tc := range cases {
+		got := replaceRouterMd5AuthenticationKey(tc.Keys, tc.PreviousName, tc.NewKey)
+		if !reflect.DeepEqual(got, tc.Expected) {
+			t.Errorf("%s: expected %v, got %v", tn, tc.Expected, got)
+		}
+	}
+}
@@ -51,6 +51,7 @@ func ResourceComputeSnapshot() *schema.Resource {
 
 		CustomizeDiff: customdiff.All(
 			tpgresource.SetLabelsDiff,
+			tpgresource.SetEffectiveKmsKeyDiff("snapshot_encryption_key.0.kms_key_self_link", computeSnapshotLocationFromDiff),
 			tpgresource.DefaultProviderProject,
 		),
 
@@ -212,6 +213,12 @@ RFC 4648 base64 to either encrypt or decrypt this resource.`,
 				Description: `All of labels (key/value pairs) present on the resource in GCP, including the labels configured through Terraform, other clients and services.`,
 				Elem:        &schema.Schema{Type: schema.TypeString},
 			},
+			"effective_kms_key": {
+				Type:     schema.TypeString,
+				Computed: true,
+				Description: `The KMS key used to encrypt the snapshot, either configured in 'snapshot_encryption_key' or
+inherited from the provider-level 'default_kms_key' for the snapshot's location.`,
+			},
 			"label_fingerprint": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -325,6 +332,11 @@ func resourceComputeSnapshotCreate(d *schema.ResourceData, meta interface{}) err
 		return err
 	} else if v, ok := d.GetOkExists("snapshot_encryption_key"); !tpgresource.IsEmptyValue(reflect.ValueOf(snapshotEncryptionKeyProp)) && (ok || !reflect.DeepEqual(v, snapshotEncryptionKeyProp)) {
 		obj["snapshotEncryptionKey"] = snapshotEncryptionKeyProp
+	} else if v, ok := d.GetOk("effective_kms_key"); ok {
+		// Encrypt the snapshot with the provider-level default_kms_key for its location
+		obj["snapshotEncryptionKey"] = map[string]interface{}{
+			"kmsKeyName": v.(string),
+		}
 	}
 	sourceDiskEncryptionKeyProp, err := expandComputeSnapshotSourceDiskEncryptionKey(d.Get("source_disk_encryption_key"), d, config)
 	if err != nil {
@@ -485,6 +497,9 @@ func resourceComputeSnapshotRead(d *schema.ResourceData, meta interface{}) error
 	if err := d.Set("snapshot_encryption_key", flattenComputeSnapshotSnapshotEncryptionKey(res["snapshotEncryptionKey"], d, config)); err != nil {
 		return fmt.Errorf("Error reading Snapshot: %s", err)
 	}
+	if err := d.Set("effective_kms_key", flattenComputeSnapshotEffectiveKmsKey(res["snapshotEncryptionKey"], d, config)); err != nil {
+		return fmt.Errorf("Error reading Snapshot: %s", err)
+	}
 	if err := d.Set("self_link", tpgresource.ConvertSelfLinkToV1(res["selfLink"].(string))); err != nil {
 		return fmt.Errorf("Error reading Snapshot: %s", err)
 	}
@@ -767,6 +782,10 @@ func flattenComputeSnapshotSnapshotEncryptionKey(v interface{}, d *schema.Resour
 	if len(original) == 0 {
 		return nil
 	}
+	// A key inherited from the provider-level default_kms_key is only tracked in effective_kms_key
+	if kmsKeyName, ok := original["kmsKeyName"].(string); ok && tpgresource.IsDefaultKmsKeyInherited(d, "snapshot_encryption_key", kmsKeyName) {
+		return nil
+	}
 	transformed := make(map[string]interface{})
 	transformed["raw_key"] =
 		flattenComputeSnapshotSnapshotEncryptionKeyRawKey(original["rawKey"], d, config)
@@ -794,6 +813,15 @@ func flattenComputeSnapshotSnapshotEncryptionKeyKmsKeyServiceAccount(v interface
 	return v
 }
 
+func flattenComputeSnapshotEffectiveKmsKey(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
+	if v == nil {
+		return ""
+	}
+	original := v.(map[string]interface{})
+	kmsKeyName, _ := original["kmsKeyName"].(string)
+	return kmsKeyName
+}
+
 func expandComputeSnapshotChainName(v interface{}, d tpgresource.TerraformResourceData, config *transport_tpg.Config) (interface{}, error) {
 	return v, nil
 }
@@ -974,3 +1002,23 @@ func resourceComputeSnapshotDecoder(d *schema.ResourceData, meta interface{}, re
 
 	return res, nil
 }
+
+// computeSnapshotLocationFromDiff returns the location used to look up the
+// provider-level default_kms_key for a snapshot: its first storage location if
+// set, or else its zone. No location is returned if neither is known yet.
+func computeSnapshotLocationFromDiff(d *schema.ResourceDiff, config *transport_tpg.Config) (string, error) {
+	if !d.NewValueKnown("storage_locations") {
+		return "", nil
+	}
+	if v, ok := d.GetOk("storage_locations"); ok && len(v.([]interface{})) > 0 {
+		return v.([]interface{})[0].(string), nil
+	}
+	if !d.NewValueKnown("zone") {
+		return "", nil
+	}
+	zone, err := tpgresource.GetZoneFromDiff(d, config)
+	if err != nil {
+		return "", nil
+	}
+	return zone, nil
+}
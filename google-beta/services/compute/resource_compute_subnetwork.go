@@ -54,6 +54,43 @@ func IsShrinkageIpCidr(_ context.Context, old, new, _ interface{}) bool {
 	return true
 }
 
+// ipv6_access_type is immutable once set, so changing it to another access type recreates the subnetwork.
+func IsIpv6AccessTypeChange(_ context.Context, old, new, _ interface{}) bool {
+	return old.(string) != "" && new.(string) != "" && old.(string) != new.(string)
+}
+
+func subnetworkOptionsCustomizeDiff(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
+	// separate func to allow unit testing
+	return validateSubnetworkOptions(diff)
+}
+
+// subnetworkResourceDiff is implemented by *schema.ResourceDiff.
+type subnetworkResourceDiff interface {
+	tpgresource.TerraformResourceDiff
+	NewValueKnown(string) bool
+}
+
+// Catch options that are only accepted for some stack types and purposes at
+// plan time instead of at create. Values that aren't known until apply are
+// not checked.
+func validateSubnetworkOptions(d subnetworkResourceDiff) error {
+	if d.NewValueKnown("stack_type") && d.NewValueKnown("ipv6_access_type") {
+		stackType, _ := d.Get("stack_type").(string)
+		if v, _ := d.Get("ipv6_access_type").(string); v != "" && stackType != "IPV4_IPV6" {
+			return fmt.Errorf("ipv6_access_type can only be set when stack_type is IPV4_IPV6, got %q", stackType)
+		}
+	}
+
+	if d.NewValueKnown("purpose") {
+		purpose, _ := d.Get("purpose").(string)
+		if v, _ := d.Get("role").(string); v != "" && !tpgresource.StringInSlice([]string{"REGIONAL_MANAGED_PROXY", "GLOBAL_MANAGED_PROXY", "INTERNAL_HTTPS_LOAD_BALANCER"}, purpose) {
+			return fmt.Errorf("role can only be set when purpose is REGIONAL_MANAGED_PROXY, GLOBAL_MANAGED_PROXY or INTERNAL_HTTPS_LOAD_BALANCER, got %q", purpose)
+		}
+	}
+
+	return nil
+}
+
 func ResourceComputeSubnetwork() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceComputeSubnetworkCreate,
@@ -74,6 +111,8 @@ func ResourceComputeSubnetwork() *schema.Resource {
 		CustomizeDiff: customdiff.All(
 			resourceComputeSubnetworkSecondaryIpRangeSetStyleDiff,
 			customdiff.ForceNewIfChange("ip_cidr_range", IsShrinkageIpCidr),
+			customdiff.ForceNewIfChange("ipv6_access_type", IsIpv6AccessTypeChange),
+			subnetworkOptionsCustomizeDiff,
 			tpgresource.DefaultProviderProject,
 		),
 
@@ -138,7 +177,8 @@ creation time.`,
 				ValidateFunc: verify.ValidateEnum([]string{"EXTERNAL", "INTERNAL", ""}),
 				Description: `The access type of IPv6 address this subnet holds. It's immutable and can only be specified during creation
 or the first time the subnet is updated into IPV4_IPV6 dual stack. If the ipv6_type is EXTERNAL then this subnet
-cannot enable direct path. Possible values: ["EXTERNAL", "INTERNAL"]`,
+cannot enable direct path. It can only be set when stack_type is IPV4_IPV6, and changing it to another
+access type recreates the subnetwork. Possible values: ["EXTERNAL", "INTERNAL"]`,
 			},
 			"log_config": {
 				Type:     schema.TypeList,
@@ -243,7 +283,8 @@ If unspecified, the purpose defaults to 'PRIVATE_RFC_1918'.`,
 				Optional:     true,
 				ValidateFunc: verify.ValidateEnum([]string{"ACTIVE", "BACKUP", ""}),
 				Description: `The role of subnetwork.
-Currently, this field is only used when 'purpose' is 'REGIONAL_MANAGED_PROXY'.
+Currently, this field is only used when 'purpose' is 'REGIONAL_MANAGED_PROXY' or 'GLOBAL_MANAGED_PROXY', and setting it
+with any other purpose is an error.
 The value can be set to 'ACTIVE' or 'BACKUP'.
 An 'ACTIVE' subnetwork is one that is currently being used for Envoy-based load balancers in a region.
 A 'BACKUP' subnetwork is one that is ready to be promoted to 'ACTIVE' or is currently draining. Possible values: ["ACTIVE", "BACKUP"]`,
@@ -0,0 +1,96 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+package compute
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-google-beta/google-beta/tpgresource"
+)
+
+func TestIsIpv6AccessTypeChange(t *testing.T) {
+	cases := map[string]struct {
+		Old, New string
+		Expected bool
+	}{
+		"unchanged":           {Old: "EXTERNAL", New: "EXTERNAL", Expected: false},
+		"enabling ipv6":       {Old: "", New: "INTERNAL", Expected: false},
+		"removed from config": {Old: "EXTERNAL", New: "", Expected: false},
+		"changed":             {Old: "EXTERNAL", New: "INTERNAL", Expected: true},
+	}
+
+	for tn, tc := range cases {
+		if got := IsIpv6AccessTypeChange(context.Background(), tc.Old, tc.New, nil); got != tc.Expected {
+			t.Errorf("%s: expected %v, got %v", tn, tc.Expected, got)
+		}
+	}
+}
+
+// subnetworkResourceDiffMock is a ResourceDiffMock whose keys in Unknown
+// aren't known until apply.
+type subnetworkResourceDiffMock struct {
+	*tpgresource.ResourceDiffMock
+	Unknown map[string]bool
+}
+
+func (d *subnetworkResourceDiffMock) NewValueKnown(key string) bool {
+	return !d.Unknown[key]
+}
+
+func TestValidateSubnetworkOptions(t *testing.T) {
+	cases := map[string]struct {
+		After       map[string]interface{}
+		Unknown     map[string]bool
+		ExpectError bool
+	}{
+		"ipv4 only": {
+			After: map[string]interface{}{"stack_type": "IPV4_ONLY"},
+		},
+		"dual stack": {
+			After: map[string]interface{}{"stack_type": "IPV4_IPV6", "ipv6_access_type": "INTERNAL"},
+		},
+		"ipv6 access type without dual stack": {
+			After:       map[string]interface{}{"stack_type": "IPV4_ONLY", "ipv6_access_type": "EXTERNAL"},
+			ExpectError: true,
+		},
+		"proxy-only subnet": {
+			After: map[string]interface{}{"purpose": "REGIONAL_MANAGED_PROXY", "role": "ACTIVE"},
+		},
+		"global proxy-only subnet": {
+			After: map[string]interface{}{"purpose": "GLOBAL_MANAGED_PROXY", "role": "BACKUP"},
+		},
+		"legacy proxy-only subnet": {
+			After: map[string]interface{}{"purpose": "INTERNAL_HTTPS_LOAD_BALANCER", "role": "ACTIVE"},
+		},
+		"role without proxy purpose": {
+			After:       map[string]interface{}{"purpose": "PRIVATE_RFC_1918", "role": "ACTIVE"},
+			ExpectError: true,
+		},
+		"ipv6 access type with unknown stack type": {
+			After:   map[string]interface{}{"ipv6_access_type": "EXTERNAL"},
+			Unknown: map[string]bool{"stack_type": true},
+		},
+		"role with unknown purpose": {
+			After:   map[string]interface{}{"role": "ACTIVE"},
+			Unknown: map[string]bool{"purpose": true},
+		},
+	}
+
+	for tn, tc := range cases {
+		d := &subnetworkResourceDiffMock{
+			ResourceDiffMock: &tpgresource.ResourceDiffMock{
+				After: tc.After,
+			},
+			Unknown: tc.Unknown,
+		}
+
+		err := validateSubnetworkOptions(d)
+		if tc.ExpectError && err == nil {
+			t.Errorf("%s: expected an error", tn)
+		}
+		if !tc.ExpectError && err != nil {
+			t.Errorf("%s: unexpected error: %s", tn, err)
+		}
+	}
+}
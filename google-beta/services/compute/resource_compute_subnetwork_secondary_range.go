@@ -0,0 +1,254 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+package compute
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/hashicorp/terraform-provider-google-beta/google-beta/tpgresource"
+	transport_tpg "github.com/hashicorp/terraform-provider-google-beta/google-beta/transport"
+	"github.com/hashicorp/terraform-provider-google-beta/google-beta/verify"
+
+	compute "google.golang.org/api/compute/v0.beta"
+)
+
+func ResourceComputeSubnetworkSecondaryRange() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceComputeSubnetworkSecondaryRangeCreate,
+		Read:   resourceComputeSubnetworkSecondaryRangeRead,
+		Delete: resourceComputeSubnetworkSecondaryRangeDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceComputeSubnetworkSecondaryRangeImport,
+		},
+
+		CustomizeDiff: customdiff.All(
+			tpgresource.DefaultProviderProject,
+		),
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(20 * time.Minute),
+			Delete: schema.DefaultTimeout(20 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"subnetwork": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				DiffSuppressFunc: tpgresource.CompareSelfLinkOrResourceName,
+				Description:      `The name or self link of the subnetwork to add the secondary range to.`,
+			},
+			"range_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidateGCEName,
+				Description: `The name associated with this secondary range, used when adding an alias IP range to a VM
+instance. The name must be unique within the subnetwork.`,
+			},
+			"ip_cidr_range": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidateIpCidrRange,
+				Description: `The range of IP addresses belonging to this secondary range. Ranges must be unique and
+non-overlapping with all primary and secondary IP ranges within a network. Only IPv4 is supported.`,
+			},
+			"region": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         true,
+				ForceNew:         true,
+				DiffSuppressFunc: tpgresource.CompareSelfLinkOrResourceName,
+				Description:      `The region of the subnetwork. If it is not provided, the provider region is used.`,
+			},
+			"project": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+				Description: `The ID of the project in which the resource belongs. If it is not provided, the provider project is used.`,
+			},
+		},
+		UseJSONNumber: true,
+	}
+}
+
+func resourceComputeSubnetworkSecondaryRangeCreate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*transport_tpg.Config)
+
+	subnetwork, err := tpgresource.ParseSubnetworkFieldValue(d.Get("subnetwork").(string), d, config)
+	if err != nil {
+		return err
+	}
+
+	secondaryRange := &compute.SubnetworkSecondaryRange{
+		RangeName:   d.Get("range_name").(string),
+		IpCidrRange: d.Get("ip_cidr_range").(string),
+	}
+	err = updateComputeSubnetworkSecondaryRanges(d, config, subnetwork, d.Timeout(schema.TimeoutCreate), func(ranges []*compute.SubnetworkSecondaryRange) ([]*compute.SubnetworkSecondaryRange, error) {
+		return addSubnetworkSecondaryRange(ranges, secondaryRange)
+	})
+	if err != nil {
+		return fmt.Errorf("Error adding secondary range %q to subnetwork %s: %s", secondaryRange.RangeName, subnetwork.RelativeLink(), err)
+	}
+
+	d.SetId(fmt.Sprintf("%s/secondaryRanges/%s", subnetwork.RelativeLink(), secondaryRange.RangeName))
+
+	return resourceComputeSubnetworkSecondaryRangeRead(d, meta)
+}
+
+func resourceComputeSubnetworkSecondaryRangeRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*transport_tpg.Config)
+	userAgent, err := tpgresource.GenerateUserAgentString(d, config.UserAgent)
+	if err != nil {
+		return err
+	}
+
+	subnetwork, err := tpgresource.ParseSubnetworkFieldValue(d.Get("subnetwork").(string), d, config)
+	if err != nil {
+		return err
+	}
+
+	res, err := config.NewComputeClient(userAgent).Subnetworks.Get(subnetwork.Project, subnetwork.Region, subnetwork.Name).Do()
+	if err != nil {
+		return transport_tpg.HandleNotFoundError(err, d, fmt.Sprintf("Subnetwork %s", subnetwork.RelativeLink()))
+	}
+
+	rangeName := d.Get("range_name").(string)
+	secondaryRange := findSubnetworkSecondaryRange(res.SecondaryIpRanges, rangeName)
+	if secondaryRange == nil {
+		log.Printf("[WARN] Removing secondary range %q from state because it's gone from subnetwork %s", rangeName, subnetwork.RelativeLink())
+		d.SetId("")
+		return nil
+	}
+
+	if err := d.Set("ip_cidr_range", secondaryRange.IpCidrRange); err != nil {
+		return fmt.Errorf("Error setting ip_cidr_range: %s", err)
+	}
+	if err := d.Set("region", subnetwork.Region); err != nil {
+		return fmt.Errorf("Error setting region: %s", err)
+	}
+	if err := d.Set("project", subnetwork.Project); err != nil {
+		return fmt.Errorf("Error setting project: %s", err)
+	}
+
+	return nil
+}
+
+func resourceComputeSubnetworkSecondaryRangeDelete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*transport_tpg.Config)
+
+	subnetwork, err := tpgresource.ParseSubnetworkFieldValue(d.Get("subnetwork").(string), d, config)
+	if err != nil {
+		return err
+	}
+
+	rangeName := d.Get("range_name").(string)
+	err = updateComputeSubnetworkSecondaryRanges(d, config, subnetwork, d.Timeout(schema.TimeoutDelete), func(ranges []*compute.SubnetworkSecondaryRange) ([]*compute.SubnetworkSecondaryRange, error) {
+		return removeSubnetworkSecondaryRange(ranges, rangeName), nil
+	})
+	if err != nil {
+		return transport_tpg.HandleNotFoundError(err, d, fmt.Sprintf("Subnetwork %s", subnetwork.RelativeLink()))
+	}
+
+	d.SetId("")
+	return nil
+}
+
+func resourceComputeSubnetworkSecondaryRangeImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	config := meta.(*transport_tpg.Config)
+	if err := tpgresource.ParseImportId([]string{
+		"^projects/(?P<project>[^/]+)/regions/(?P<region>[^/]+)/subnetworks/(?P<subnetwork>[^/]+)/secondaryRanges/(?P<range_name>[^/]+)$",
+		"^(?P<project>[^/]+)/(?P<region>[^/]+)/(?P<subnetwork>[^/]+)/(?P<range_name>[^/]+)$",
+		"^(?P<region>[^/]+)/(?P<subnetwork>[^/]+)/(?P<range_name>[^/]+)$",
+	}, d, config); err != nil {
+		return nil, err
+	}
+
+	id, err := tpgresource.ReplaceVars(d, config, "projects/{{project}}/regions/{{region}}/subnetworks/{{subnetwork}}/secondaryRanges/{{range_name}}")
+	if err != nil {
+		return nil, fmt.Errorf("Error constructing id: %s", err)
+	}
+	d.SetId(id)
+
+	return []*schema.ResourceData{d}, nil
+}
+
+// updateComputeSubnetworkSecondaryRanges replaces the secondary ranges of subnetwork with the result of
+// update, leaving the ranges it doesn't change untouched. Updates to the same subnetwork are serialized, and
+// retried if the subnetwork changed since it was read.
+func updateComputeSubnetworkSecondaryRanges(d *schema.ResourceData, config *transport_tpg.Config, subnetwork *tpgresource.RegionalFieldValue, timeout time.Duration, update func([]*compute.SubnetworkSecondaryRange) ([]*compute.SubnetworkSecondaryRange, error)) error {
+	userAgent, err := tpgresource.GenerateUserAgentString(d, config.UserAgent)
+	if err != nil {
+		return err
+	}
+
+	updateRanges := func() error {
+		lockName := subnetwork.RelativeLink()
+		transport_tpg.MutexStore.Lock(lockName)
+		defer transport_tpg.MutexStore.Unlock(lockName)
+
+		res, err := config.NewComputeClient(userAgent).Subnetworks.Get(subnetwork.Project, subnetwork.Region, subnetwork.Name).Do()
+		if err != nil {
+			return err
+		}
+
+		ranges, err := update(res.SecondaryIpRanges)
+		if err != nil {
+			return err
+		}
+		if len(ranges) == len(res.SecondaryIpRanges) {
+			// Nothing to add or remove
+			return nil
+		}
+
+		patch := &compute.Subnetwork{
+			Fingerprint:       res.Fingerprint,
+			SecondaryIpRanges: ranges,
+			// Removing the last secondary range requires sending an empty list
+			ForceSendFields: []string{"SecondaryIpRanges"},
+		}
+		op, err := config.NewComputeClient(userAgent).Subnetworks.Patch(subnetwork.Project, subnetwork.Region, subnetwork.Name, patch).Do()
+		if err != nil {
+			return err
+		}
+
+		return ComputeOperationWaitTime(config, op, subnetwork.Project, "Updating Subnetwork secondary ranges", userAgent, timeout)
+	}
+
+	return transport_tpg.MetadataRetryWrapper(updateRanges)
+}
+
+func findSubnetworkSecondaryRange(ranges []*compute.SubnetworkSecondaryRange, rangeName string) *compute.SubnetworkSecondaryRange {
+	for _, r := range ranges {
+		if r.RangeName == rangeName {
+			return r
+		}
+	}
+	return nil
+}
+
+// addSubnetworkSecondaryRange returns ranges with r added, failing if a range with the same name exists.
+func addSubnetworkSecondaryRange(ranges []*compute.SubnetworkSecondaryRange, r *compute.SubnetworkSecondaryRange) ([]*compute.SubnetworkSecondaryRange, error) {
+	if existing := findSubnetworkSecondaryRange(ranges, r.RangeName); existing != nil {
+		return nil, fmt.Errorf("a secondary range named %q already exists with IP range %s. Use `terraform import` to manage it with Terraform", r.RangeName, existing.IpCidrRange)
+	}
+	return append(ranges, r), nil
+}
+
+// removeSubnetworkSecondaryRange returns ranges without the range named rangeName.
+func removeSubnetworkSecondaryRange(ranges []*compute.SubnetworkSecondaryRange, rangeName string) []*compute.SubnetworkSecondaryRange {
+	result := make([]*compute.SubnetworkSecondaryRange, 0, len(ranges))
+	for _, r := range ranges {
+		if r.RangeName != rangeName {
+			result = append(result, r)
+		}
+	}
+	return result
+}
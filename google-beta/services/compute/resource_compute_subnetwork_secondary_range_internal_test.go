@@ -0,0 +1,60 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+package compute
+
+import (
+	"reflect"
+	"testing"
+
+	compute "google.golang.org/api/compute/v0.beta"
+)
+
+func TestAddSubnetworkSecondaryRange(t *testing.T) {
+	pods := &compute.SubnetworkSecondaryRange{RangeName: "pods", IpCidrRange: "10.1.0.0/16"}
+	services := &compute.SubnetworkSecondaryRange{RangeName: "services", IpCidrRange: "10.2.0.0/20"}
+
+	ranges, err := addSubnetworkSecondaryRange([]*compute.SubnetworkSecondaryRange{pods}, services)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if expected := []*compute.SubnetworkSecondaryRange{pods, services}; !reflect.DeepEqual(ranges, expected) {
+		t.Errorf("expected %v, got %v", expected, ranges)
+	}
+
+	if _, err := addSubnetworkSecondaryRange(ranges, &compute.SubnetworkSecondaryRange{RangeName: "pods", IpCidrRange: "10.3.0.0/16"}); err == nil {
+		t.Errorf("expected an error adding a range with an existing name")
+	}
+}
+
+func TestRemoveSubnetworkSecondaryRange(t *testing.T) {
+	pods := &compute.SubnetworkSecondaryRange{RangeName: "pods", IpCidrRange: "10.1.0.0/16"}
+	services := &compute.SubnetworkSecondaryRange{RangeName: "services", IpCidrRange: "10.2.0.0/20"}
+
+	cases := map[string]struct {
+		Ranges    []*compute.SubnetworkSecondaryRange
+		RangeName string
+		Expected  []*compute.SubnetworkSecondaryRange
+	}{
+		"removes only the named range": {
+			Ranges:    []*compute.SubnetworkSecondaryRange{pods, services},
+			RangeName: "pods",
+			Expected:  []*compute.SubnetworkSecondaryRange{services},
+		},
+		"removes the last range": {
+			Ranges:    []*compute.SubnetworkSecondaryRange{pods},
+			RangeName: "pods",
+			Expected:  []*compute.SubnetworkSecondaryRange{},
+		},
+		"range already gone": {
+			Ranges:    []*compute.SubnetworkSecondaryRange{services},
+			RangeName: "pods",
+			Expected:  []*compute.SubnetworkSecondaryRange{services},
+		},
+	}
+
+	for tn, tc := range cases {
+		if got := removeSubnetworkSecondaryRange(tc.Ranges, tc.RangeName); !reflect.DeepEqual(got, tc.Expected) {
+			t.Errorf("%s: expected %v, got %v", tn, tc.Expected, got)
+		}
+	}
+}
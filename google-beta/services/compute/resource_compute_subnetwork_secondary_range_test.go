@@ -0,0 +1,115 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+package compute_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-google-beta/google-beta/acctest"
+
+	compute "google.golang.org/api/compute/v0.beta"
+)
+
+func TestAccComputeSubnetworkSecondaryRange_basic(t *testing.T) {
+	t.Parallel()
+
+	var subnetwork compute.Subnetwork
+
+	cnName := fmt.Sprintf("tf-test-%s", acctest.RandString(t, 10))
+	subnetworkName := fmt.Sprintf("tf-test-%s", acctest.RandString(t, 10))
+
+	acctest.VcrTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.AccTestPreCheck(t) },
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories(t),
+		CheckDestroy:             testAccCheckComputeSubnetworkDestroyProducer(t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccComputeSubnetworkSecondaryRange_two(cnName, subnetworkName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckComputeSubnetworkExists(t, "google_compute_subnetwork.subnetwork", &subnetwork),
+					testAccCheckComputeSubnetworkHasSecondaryIpRange(&subnetwork, "tf-test-pods", "192.168.0.0/20"),
+					testAccCheckComputeSubnetworkHasSecondaryIpRange(&subnetwork, "tf-test-services", "192.168.16.0/24"),
+				),
+			},
+			{
+				ResourceName:      "google_compute_subnetwork_secondary_range.pods",
+				ImportState:       true,
+				ImportStateVerify: true,
+				// The subnetwork is configured by self link but imported by name
+				ImportStateVerifyIgnore: []string{"subnetwork"},
+			},
+			{
+				Config: testAccComputeSubnetworkSecondaryRange_one(cnName, subnetworkName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckComputeSubnetworkExists(t, "google_compute_subnetwork.subnetwork", &subnetwork),
+					testAccCheckComputeSubnetworkHasSecondaryIpRange(&subnetwork, "tf-test-services", "192.168.16.0/24"),
+					testAccCheckComputeSubnetworkHasNoSecondaryIpRange(&subnetwork, "tf-test-pods"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckComputeSubnetworkHasNoSecondaryIpRange(subnetwork *compute.Subnetwork, rangeName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		for _, r := range subnetwork.SecondaryIpRanges {
+			if r.RangeName == rangeName {
+				return fmt.Errorf("secondary range %s should have been removed", rangeName)
+			}
+		}
+		return nil
+	}
+}
+
+func testAccComputeSubnetworkSecondaryRange_two(cnName, subnetworkName string) string {
+	return fmt.Sprintf(`
+resource "google_compute_network" "custom-test" {
+  name                    = "%s"
+  auto_create_subnetworks = false
+}
+
+resource "google_compute_subnetwork" "subnetwork" {
+  name          = "%s"
+  ip_cidr_range = "10.2.0.0/16"
+  region        = "us-central1"
+  network       = google_compute_network.custom-test.self_link
+}
+
+resource "google_compute_subnetwork_secondary_range" "pods" {
+  subnetwork    = google_compute_subnetwork.subnetwork.self_link
+  range_name    = "tf-test-pods"
+  ip_cidr_range = "192.168.0.0/20"
+}
+
+resource "google_compute_subnetwork_secondary_range" "services" {
+  subnetwork    = google_compute_subnetwork.subnetwork.self_link
+  range_name    = "tf-test-services"
+  ip_cidr_range = "192.168.16.0/24"
+}
+`, cnName, subnetworkName)
+}
+
+func testAccComputeSubnetworkSecondaryRange_one(cnName, subnetworkName string) string {
+	return fmt.Sprintf(`
+resource "google_compute_network" "custom-test" {
+  name                    = "%s"
+  auto_create_subnetworks = false
+}
+
+resource "google_compute_subnetwork" "subnetwork" {
+  name          = "%s"
+  ip_cidr_range = "10.2.0.0/16"
+  region        = "us-central1"
+  network       = google_compute_network.custom-test.self_link
+}
+
+resource "google_compute_subnetwork_secondary_range" "services" {
+  subnetwork    = google_compute_subnetwork.subnetwork.self_link
+  range_name    = "tf-test-services"
+  ip_cidr_range = "192.168.16.0/24"
+}
+`, cnName, subnetworkName)
+}
@@ -8,6 +8,7 @@ import (
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-google-beta/google-beta/tpgresource"
 	transport_tpg "github.com/hashicorp/terraform-provider-google-beta/google-beta/transport"
 )
@@ -28,6 +29,11 @@ func DataSourceGoogleContainerEngineVersions() *schema.Resource {
 				Type:     schema.TypeString,
 				Optional: true,
 			},
+			"release_channel": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice([]string{"RAPID", "REGULAR", "STABLE"}, false),
+			},
 			"default_cluster_version": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -60,6 +66,11 @@ func DataSourceGoogleContainerEngineVersions() *schema.Resource {
 				Computed: true,
 				Elem:     &schema.Schema{Type: schema.TypeString},
 			},
+			"release_channel_valid_versions": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
 		},
 	}
 }
@@ -126,14 +137,20 @@ func dataSourceGoogleContainerEngineVersionsRead(d *schema.ResourceData, meta in
 		return fmt.Errorf("Error setting default_cluster_version: %s", err)
 	}
 
+	releaseChannel := d.Get("release_channel").(string)
 	releaseChannelDefaultVersion := map[string]string{}
 	releaseChannelLatestVersion := map[string]string{}
+	releaseChannelValidVersions := make([]string, 0)
 	for _, channelResp := range resp.Channels {
 		releaseChannelDefaultVersion[channelResp.Channel] = channelResp.DefaultVersion
 		for _, v := range channelResp.ValidVersions {
 			if strings.HasPrefix(v, d.Get("version_prefix").(string)) {
-				releaseChannelLatestVersion[channelResp.Channel] = v
-				break
+				if _, ok := releaseChannelLatestVersion[channelResp.Channel]; !ok {
+					releaseChannelLatestVersion[channelResp.Channel] = v
+				}
+				if channelResp.Channel == releaseChannel {
+					releaseChannelValidVersions = append(releaseChannelValidVersions, v)
+				}
 			}
 		}
 	}
@@ -144,6 +161,9 @@ func dataSourceGoogleContainerEngineVersionsRead(d *schema.ResourceData, meta in
 	if err := d.Set("release_channel_latest_version", releaseChannelLatestVersion); err != nil {
 		return fmt.Errorf("Error setting release_channel_latest_version: %s", err)
 	}
+	if err := d.Set("release_channel_valid_versions", releaseChannelValidVersions); err != nil {
+		return fmt.Errorf("Error setting release_channel_valid_versions: %s", err)
+	}
 
 	d.SetId(time.Now().UTC().String())
 	return nil
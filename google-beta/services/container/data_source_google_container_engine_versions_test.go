@@ -48,6 +48,24 @@ func TestAccContainerEngineVersions_filtered(t *testing.T) {
 	})
 }
 
+func TestAccContainerEngineVersions_releaseChannel(t *testing.T) {
+	t.Parallel()
+
+	acctest.VcrTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.AccTestPreCheck(t) },
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories(t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckGoogleContainerEngineVersions_releaseChannel,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.google_container_engine_versions.versions", "release_channel_valid_versions.0"),
+					resource.TestCheckResourceAttrPair("data.google_container_engine_versions.versions", "release_channel_valid_versions.0", "data.google_container_engine_versions.versions", "release_channel_latest_version.REGULAR"),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckGoogleContainerEngineVersionsMeta(n string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[n]
@@ -140,3 +158,10 @@ data "google_container_engine_versions" "versions" {
   version_prefix = "1.1."
 }
 `
+
+var testAccCheckGoogleContainerEngineVersions_releaseChannel = `
+data "google_container_engine_versions" "versions" {
+  location        = "us-central1"
+  release_channel = "REGULAR"
+}
+`
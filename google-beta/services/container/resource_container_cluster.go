@@ -444,6 +444,7 @@ func ResourceContainerCluster() *schema.Resource {
 							AtLeastOneOf: addonsConfigKeys,
 							MaxItems:     1,
 							Description:  `The status of the Istio addon.`,
+							Deprecated:   "The Istio on GKE addon is deprecated and is not available on GKE 1.22 and later. Migrate to Anthos Service Mesh and remove this attribute's configuration, as it will be removed in a future major release.",
 							Elem: &schema.Resource{
 								Schema: map[string]*schema.Schema{
 									"disabled": {
@@ -484,7 +485,7 @@ func ResourceContainerCluster() *schema.Resource {
 							Computed:     true,
 							AtLeastOneOf: addonsConfigKeys,
 							MaxItems:     1,
-							Description:  `The of the Config Connector addon.`,
+							Description:  `The status of the Config Connector addon. It is disabled by default. Set enabled = true to enable.`,
 							Elem: &schema.Resource{
 								Schema: map[string]*schema.Schema{
 									"enabled": {
@@ -920,9 +921,10 @@ func ResourceContainerCluster() *schema.Resource {
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"security_group": {
-							Type:        schema.TypeString,
-							Required:    true,
-							Description: `The name of the RBAC security group for use with Google security groups in Kubernetes RBAC. Group name must be in format gke-security-groups@yourdomain.com.`,
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: verify.ValidateRegexp(`^(gke-security-groups@.+)?$`),
+							Description:  `The name of the RBAC security group for use with Google security groups in Kubernetes RBAC. Group name must be in format gke-security-groups@yourdomain.com. Set to an empty string to disable Google Groups for RBAC.`,
 						},
 					},
 				},
@@ -1228,9 +1230,10 @@ func ResourceContainerCluster() *schema.Resource {
 										Description: `Whether or not the notification config is enabled`,
 									},
 									"topic": {
-										Type:        schema.TypeString,
-										Optional:    true,
-										Description: `The pubsub topic to push upgrade notifications to. Must be in the same project as the cluster. Must be in the format: projects/{project}/topics/{topic}.`,
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: verify.ValidateRegexp(`^projects/[^/]+/topics/[^/]+$`),
+										Description:  `The pubsub topic to push upgrade notifications to. Must be in the same project as the cluster. Must be in the format: projects/{project}/topics/{topic}.`,
 									},
 									"filter": {
 										Type:        schema.TypeList,
@@ -2834,7 +2837,11 @@ func resourceContainerClusterRead(d *schema.ResourceData, meta interface{}) erro
 		return err
 	}
 
-	if err := d.Set("resource_labels", cluster.ResourceLabels); err != nil {
+	resourceLabels := make(map[string]interface{})
+	for k, v := range cluster.ResourceLabels {
+		resourceLabels[k] = v
+	}
+	if err := d.Set("resource_labels", tpgresource.FilterIgnoredAnnotations(resourceLabels, d, "resource_labels", config)); err != nil {
 		return fmt.Errorf("Error setting resource_labels: %s", err)
 	}
 	if err := d.Set("label_fingerprint", cluster.LabelFingerprint); err != nil {
@@ -3875,14 +3882,24 @@ func resourceContainerClusterUpdate(d *schema.ResourceData, meta interface{}) er
 	}
 
 	if d.HasChange("resource_labels") {
-		resourceLabels := d.Get("resource_labels").(map[string]interface{})
-		labelFingerprint := d.Get("label_fingerprint").(string)
-		req := &container.SetLabelsRequest{
-			ResourceLabels:   tpgresource.ConvertStringMap(resourceLabels),
-			LabelFingerprint: labelFingerprint,
-		}
 		updateF := func() error {
 			name := containerClusterFullName(project, location, clusterName)
+
+			// The labels are set authoritatively, so keep the ones added by the server that
+			// are dropped on read.
+			clusterGetCall := config.NewContainerClient(userAgent).Projects.Locations.Clusters.Get(name)
+			if config.UserProjectOverride {
+				clusterGetCall.Header().Add("X-Goog-User-Project", project)
+			}
+			cluster, err := clusterGetCall.Do()
+			if err != nil {
+				return err
+			}
+
+			req := &container.SetLabelsRequest{
+				ResourceLabels:   tpgresource.MergeIgnoredAnnotations(d, "resource_labels", cluster.ResourceLabels, config),
+				LabelFingerprint: cluster.LabelFingerprint,
+			}
 			clusterSetResourceLabelsCall := config.NewContainerClient(userAgent).Projects.Locations.Clusters.SetResourceLabels(name, req)
 			if config.UserProjectOverride {
 				clusterSetResourceLabelsCall.Header().Add("X-Goog-User-Project", project)
@@ -5324,7 +5341,10 @@ func expandContainerClusterAuthenticatorGroupsConfig(configured interface{}) *co
 	}
 
 	config := l[0].(map[string]interface{})
-	result := &container.AuthenticatorGroupsConfig{}
+	result := &container.AuthenticatorGroupsConfig{
+		// Enabled must be sent when false to disable the feature on update
+		ForceSendFields: []string{"Enabled"},
+	}
 	if securityGroup, ok := config["security_group"]; ok {
 		if securityGroup == nil || securityGroup.(string) == "" {
 			result.Enabled = false
@@ -5409,7 +5429,7 @@ func expandWorkloadAltsConfig(configured interface{}) *container.WorkloadALTSCon
 }
 
 func flattenNotificationConfig(c *container.NotificationConfig) []map[string]interface{} {
-	if c == nil {
+	if c == nil || c.Pubsub == nil {
 		return nil
 	}
 
@@ -5971,7 +5991,9 @@ func flattenPodSecurityPolicyConfig(c *container.PodSecurityPolicyConfig) []map[
 }
 
 func flattenResourceUsageExportConfig(c *container.ResourceUsageExportConfig) []map[string]interface{} {
-	if c == nil {
+	// Export is disabled when there is no destination, and the API may then
+	// return an empty config
+	if c == nil || c.BigqueryDestination == nil {
 		return nil
 	}
 
@@ -3,6 +3,7 @@
 package container
 
 import (
+	"strings"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -290,3 +291,77 @@ func TestContainerCluster_NodeVersionCustomizeDiff(t *testing.T) {
 		}
 	}
 }
+
+func TestFlattenResourceUsageExportConfig(t *testing.T) {
+	t.Parallel()
+
+	if got := flattenResourceUsageExportConfig(&container.ResourceUsageExportConfig{}); got != nil {
+		t.Errorf("expected no config without a BigQuery destination, got %v", got)
+	}
+
+	got := flattenResourceUsageExportConfig(&container.ResourceUsageExportConfig{
+		EnableNetworkEgressMetering: true,
+		BigqueryDestination:         &container.BigQueryDestination{DatasetId: "usage"},
+	})
+	if len(got) != 1 {
+		t.Fatalf("expected one config, got %v", got)
+	}
+	if got[0]["enable_network_egress_metering"] != true || got[0]["enable_resource_consumption_metering"] != false {
+		t.Errorf("unexpected metering settings in %v", got[0])
+	}
+	if dest := got[0]["bigquery_destination"].([]map[string]interface{}); dest[0]["dataset_id"] != "usage" {
+		t.Errorf("expected dataset usage, got %v", dest)
+	}
+}
+
+func TestFlattenNotificationConfig(t *testing.T) {
+	t.Parallel()
+
+	if got := flattenNotificationConfig(&container.NotificationConfig{}); got != nil {
+		t.Errorf("expected no config without Pub/Sub settings, got %v", got)
+	}
+
+	got := flattenNotificationConfig(&container.NotificationConfig{
+		Pubsub: &container.PubSub{
+			Enabled: true,
+			Topic:   "projects/my-project/topics/upgrades",
+			Filter:  &container.Filter{EventType: []string{"UPGRADE_EVENT"}},
+		},
+	})
+	pubsub := got[0]["pubsub"].([]map[string]interface{})[0]
+	if pubsub["enabled"] != true || pubsub["topic"] != "projects/my-project/topics/upgrades" {
+		t.Errorf("unexpected Pub/Sub settings %v", pubsub)
+	}
+	if filter := pubsub["filter"].([]map[string]interface{}); len(filter) != 1 {
+		t.Errorf("expected one filter, got %v", filter)
+	}
+}
+
+func TestExpandContainerClusterAuthenticatorGroupsConfig(t *testing.T) {
+	t.Parallel()
+
+	cases := map[string]struct {
+		SecurityGroup   string
+		ExpectedEnabled bool
+	}{
+		"enabled":  {SecurityGroup: "gke-security-groups@example.com", ExpectedEnabled: true},
+		"disabled": {SecurityGroup: "", ExpectedEnabled: false},
+	}
+
+	for tn, tc := range cases {
+		got := expandContainerClusterAuthenticatorGroupsConfig([]interface{}{
+			map[string]interface{}{"security_group": tc.SecurityGroup},
+		})
+		if got.Enabled != tc.ExpectedEnabled || got.SecurityGroup != tc.SecurityGroup {
+			t.Errorf("%s: unexpected config %#v", tn, got)
+		}
+		// Disabling the feature on update requires sending enabled = false
+		b, err := got.MarshalJSON()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !strings.Contains(string(b), `"enabled"`) {
+			t.Errorf("%s: expected enabled to be sent, got %s", tn, b)
+		}
+	}
+}
@@ -37,18 +37,20 @@ func testSweepContainerClusters(region string) error {
 		return nil
 	}
 
+	ids := []string{}
 	for _, cluster := range found.Clusters {
 		if sweeper.IsSweepableTestResource(cluster.Name) {
-			log.Printf("Sweeping Container Cluster: %s", cluster.Name)
-			clusterURL := fmt.Sprintf("projects/%s/locations/%s/clusters/%s", config.Project, cluster.Location, cluster.Name)
-			_, err := config.NewContainerClient(config.UserAgent).Projects.Locations.Clusters.Delete(clusterURL).Do()
-
-			if err != nil {
-				log.Printf("Error, failed to delete cluster %s: %s", cluster.Name, err)
-				return nil
-			}
+			ids = append(ids, fmt.Sprintf("projects/%s/locations/%s/clusters/%s", config.Project, cluster.Location, cluster.Name))
 		}
 	}
 
+	deleted, err := sweeper.DeleteInParallel("ContainerCluster", ids, func(clusterURL string) error {
+		_, err := config.NewContainerClient(config.UserAgent).Projects.Locations.Clusters.Delete(clusterURL).Do()
+		return err
+	})
+	if err != nil {
+		log.Printf("[INFO][SWEEPER_LOG] Deleted %d of %d ContainerCluster resources: %s", deleted, len(ids), err)
+	}
+
 	return nil
 }
@@ -0,0 +1,94 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+package dataproc
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-provider-google-beta/google-beta/tpgresource"
+	transport_tpg "github.com/hashicorp/terraform-provider-google-beta/google-beta/transport"
+
+	"google.golang.org/api/dataproc/v1"
+)
+
+type DataprocBatchOperationWaiter struct {
+	Service       *dataproc.Service
+	Name          string
+	Status        string
+	pendingStates []string
+	targetStates  []string
+}
+
+func (w *DataprocBatchOperationWaiter) State() string {
+	if w == nil {
+		return "<nil>"
+	}
+	return w.Status
+}
+
+func (w *DataprocBatchOperationWaiter) Error() error {
+	// The "operation" is just the batch, which has no special error field that
+	// we want to expose.
+	return nil
+}
+
+func (w *DataprocBatchOperationWaiter) IsRetryable(error) bool {
+	return false
+}
+
+func (w *DataprocBatchOperationWaiter) SetOp(batch interface{}) error {
+	// The "operation" is just the batch. We only care about its state, which
+	// gets set in QueryOp, so this doesn't have to do anything.
+	return nil
+}
+
+func (w *DataprocBatchOperationWaiter) QueryOp() (interface{}, error) {
+	if w == nil {
+		return nil, fmt.Errorf("Cannot query operation, it's unset or nil.")
+	}
+	batch, err := w.Service.Projects.Locations.Batches.Get(w.Name).Do()
+	if batch != nil {
+		w.Status = batch.State
+	}
+	return batch, err
+}
+
+func (w *DataprocBatchOperationWaiter) OpName() string {
+	if w == nil {
+		return "<nil>"
+	}
+	return w.Name
+}
+
+func (w *DataprocBatchOperationWaiter) PendingStates() []string {
+	return w.pendingStates
+}
+
+func (w *DataprocBatchOperationWaiter) TargetStates() []string {
+	return w.targetStates
+}
+
+// DataprocBatchStartWait waits for a batch to leave the PENDING state. Batches
+// can run for a long time, so this doesn't wait for them to complete.
+func DataprocBatchStartWait(config *transport_tpg.Config, name, activity, userAgent string, timeout time.Duration) error {
+	w := &DataprocBatchOperationWaiter{
+		Service:       config.NewDataprocClient(userAgent),
+		Name:          name,
+		pendingStates: []string{"STATE_UNSPECIFIED", "PENDING"},
+		targetStates:  []string{"RUNNING", "CANCELLING", "CANCELLED", "SUCCEEDED", "FAILED"},
+	}
+	return tpgresource.OperationWait(w, activity, timeout, config.PollInterval)
+}
+
+// DataprocBatchTerminalWait waits for a batch to reach a terminal state, after
+// which it can be deleted.
+func DataprocBatchTerminalWait(config *transport_tpg.Config, name, activity, userAgent string, timeout time.Duration) error {
+	w := &DataprocBatchOperationWaiter{
+		Service:       config.NewDataprocClient(userAgent),
+		Name:          name,
+		pendingStates: []string{"STATE_UNSPECIFIED", "PENDING", "RUNNING", "CANCELLING"},
+		targetStates:  []string{"CANCELLED", "SUCCEEDED", "FAILED"},
+	}
+	return tpgresource.OperationWait(w, activity, timeout, config.PollInterval)
+}
@@ -0,0 +1,1077 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+package dataproc
+
+import (
+	"fmt"
+	"log"
+	"regexp"
+	"time"
+
+	"github.com/hashicorp/terraform-provider-google-beta/google-beta/tpgresource"
+	transport_tpg "github.com/hashicorp/terraform-provider-google-beta/google-beta/transport"
+	"github.com/hashicorp/terraform-provider-google-beta/google-beta/verify"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"google.golang.org/api/dataproc/v1"
+)
+
+var batchTypes = []string{"pyspark_batch", "spark_batch", "spark_r_batch", "spark_sql_batch"}
+
+func ResourceDataprocBatch() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceDataprocBatchCreate,
+		Read:   resourceDataprocBatchRead,
+		Update: resourceDataprocBatchUpdate,
+		Delete: resourceDataprocBatchDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: resourceDataprocBatchImport,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(20 * time.Minute),
+			Delete: schema.DefaultTimeout(20 * time.Minute),
+		},
+
+		CustomizeDiff: customdiff.All(
+			tpgresource.DefaultProviderProject,
+			tpgresource.SetLabelsDiff,
+		),
+
+		Schema: map[string]*schema.Schema{
+			"project": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+				Description: `The project in which the batch will be created. If it is not provided, the provider project is used.`,
+			},
+
+			"location": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+				Description: `The region in which the batch will run. If it is not provided, the provider region is used.`,
+			},
+
+			"batch_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidateRegexp(`^[a-z0-9][a-z0-9-]{2,61}[a-z0-9]$`),
+				Description:  `The ID to use for the batch, which will become the final component of the batch's resource name. Must be 4-63 characters long and contain only lowercase letters, digits and hyphens. If it is not provided, a unique ID is generated.`,
+			},
+
+			// If a batch is still running, trying to delete it will fail. Setting
+			// this flag to true however will force the deletion by first cancelling
+			// the batch and then deleting it
+			"force_delete": {
+				Type:        schema.TypeBool,
+				Default:     false,
+				Optional:    true,
+				Description: `By default, you can only delete batches that have finished running. Setting this to true, and calling destroy, will ensure that the batch is first cancelled before issuing the delete.`,
+			},
+
+			"labels": {
+				Type: schema.TypeMap,
+				Description: `The labels to associate with this batch.
+
+				**Note**: This field is non-authoritative, and will only manage the labels present in your configuration.
+				Please refer to the field 'effective_labels' for all of the labels present on the resource.`,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+
+			"terraform_labels": {
+				Type:        schema.TypeMap,
+				Computed:    true,
+				Description: `The combination of labels configured directly on the resource and default labels configured on the provider.`,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+
+			"effective_labels": {
+				Type:        schema.TypeMap,
+				Computed:    true,
+				ForceNew:    true,
+				Description: `All of labels (key/value pairs) present on the resource in GCP, including the labels configured through Terraform, other clients and services.`,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+
+			"runtime_config":     dataprocRuntimeConfigSchema(true),
+			"environment_config": dataprocEnvironmentConfigSchema(true, "ttl"),
+
+			"pyspark_batch":   pySparkBatchSchema,
+			"spark_batch":     sparkBatchSchema,
+			"spark_r_batch":   sparkRBatchSchema,
+			"spark_sql_batch": sparkSqlBatchSchema,
+
+			"name": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: `The resource name of the batch.`,
+			},
+
+			"uuid": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: `A batch UUID (Unique Universal Identifier). The service generates this value when it creates the batch.`,
+			},
+
+			"create_time": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: `The time when the batch was created.`,
+			},
+
+			"creator": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: `The email address of the user who created the batch.`,
+			},
+
+			"operation": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: `The resource name of the operation associated with this batch.`,
+			},
+
+			"state": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: `The state of the batch, such as RUNNING, SUCCEEDED or FAILED.`,
+			},
+
+			"state_message": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: `Batch state details, such as a failure description if the state is FAILED.`,
+			},
+
+			"state_time": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: `The time when the batch entered its current state.`,
+			},
+
+			"runtime_info": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: `Runtime information about batch execution.`,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"output_uri": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: `A URI pointing to the location of the stdout and stderr of the workload.`,
+						},
+						"diagnostic_output_uri": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: `A URI pointing to the location of the diagnostics tarball.`,
+						},
+						"endpoints": {
+							Type:        schema.TypeMap,
+							Computed:    true,
+							Description: `Map of remote access endpoints (such as web interfaces and APIs) to their URIs.`,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+		},
+		UseJSONNumber: true,
+	}
+}
+
+func resourceDataprocBatchUpdate(d *schema.ResourceData, meta interface{}) error {
+	// The only updatable values are 'force_delete' and the user facing labels,
+	// which are local only, therefore we don't need to make any GCP calls.
+
+	return resourceDataprocBatchRead(d, meta)
+}
+
+func resourceDataprocBatchCreate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*transport_tpg.Config)
+	userAgent, err := tpgresource.GenerateUserAgentString(d, config.UserAgent)
+	if err != nil {
+		return err
+	}
+
+	project, err := tpgresource.GetProject(d, config)
+	if err != nil {
+		return err
+	}
+
+	location, err := tpgresource.GetLocation(d, config)
+	if err != nil {
+		return err
+	}
+
+	batchId := d.Get("batch_id").(string)
+	if batchId == "" {
+		batchId = resource.UniqueId()
+	}
+
+	batch := &dataproc.Batch{}
+
+	if _, ok := d.GetOk("effective_labels"); ok {
+		batch.Labels = tpgresource.ExpandEffectiveLabels(d)
+	}
+
+	if v, ok := d.GetOk("runtime_config"); ok {
+		batch.RuntimeConfig = expandDataprocRuntimeConfig(tpgresource.ExtractFirstMapConfig(v.([]interface{})))
+	}
+
+	if v, ok := d.GetOk("environment_config"); ok {
+		batch.EnvironmentConfig = expandDataprocEnvironmentConfig(tpgresource.ExtractFirstMapConfig(v.([]interface{})))
+	}
+
+	if v, ok := d.GetOk("pyspark_batch"); ok {
+		batch.PysparkBatch = expandPySparkBatch(tpgresource.ExtractFirstMapConfig(v.([]interface{})))
+	}
+
+	if v, ok := d.GetOk("spark_batch"); ok {
+		batch.SparkBatch = expandSparkBatch(tpgresource.ExtractFirstMapConfig(v.([]interface{})))
+	}
+
+	if v, ok := d.GetOk("spark_r_batch"); ok {
+		batch.SparkRBatch = expandSparkRBatch(tpgresource.ExtractFirstMapConfig(v.([]interface{})))
+	}
+
+	if v, ok := d.GetOk("spark_sql_batch"); ok {
+		batch.SparkSqlBatch = expandSparkSqlBatch(tpgresource.ExtractFirstMapConfig(v.([]interface{})))
+	}
+
+	parent := fmt.Sprintf("projects/%s/locations/%s", project, location)
+	log.Printf("[DEBUG] Creating Dataproc batch %s in %s", batchId, parent)
+	_, err = config.NewDataprocClient(userAgent).Projects.Locations.Batches.Create(parent, batch).BatchId(batchId).Do()
+	if err != nil {
+		return fmt.Errorf("Error creating Dataproc batch: %s", err)
+	}
+
+	name := fmt.Sprintf("%s/batches/%s", parent, batchId)
+	d.SetId(name)
+
+	// Batches run to completion on their own, so only wait for the batch to
+	// be scheduled rather than for the workload to finish.
+	waitErr := DataprocBatchStartWait(config, name, "Creating Dataproc batch", userAgent, d.Timeout(schema.TimeoutCreate))
+	if waitErr != nil {
+		return waitErr
+	}
+
+	log.Printf("[INFO] Dataproc batch %s has been submitted", batchId)
+	return resourceDataprocBatchRead(d, meta)
+}
+
+func resourceDataprocBatchRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*transport_tpg.Config)
+	userAgent, err := tpgresource.GenerateUserAgentString(d, config.UserAgent)
+	if err != nil {
+		return err
+	}
+
+	batch, err := config.NewDataprocClient(userAgent).Projects.Locations.Batches.Get(d.Id()).Do()
+	if err != nil {
+		return transport_tpg.HandleNotFoundError(err, d, fmt.Sprintf("Dataproc Batch %q", d.Id()))
+	}
+
+	if err := d.Set("force_delete", d.Get("force_delete")); err != nil {
+		return fmt.Errorf("Error setting force_delete: %s", err)
+	}
+	project, location, batchId, err := parseDataprocBatchName(batch.Name)
+	if err != nil {
+		return err
+	}
+	if err := d.Set("project", project); err != nil {
+		return fmt.Errorf("Error setting project: %s", err)
+	}
+	if err := d.Set("location", location); err != nil {
+		return fmt.Errorf("Error setting location: %s", err)
+	}
+	if err := d.Set("batch_id", batchId); err != nil {
+		return fmt.Errorf("Error setting batch_id: %s", err)
+	}
+	if err := tpgresource.SetLabels(batch.Labels, d, "labels"); err != nil {
+		return fmt.Errorf("Error setting labels: %s", err)
+	}
+	if err := tpgresource.SetLabels(batch.Labels, d, "terraform_labels"); err != nil {
+		return fmt.Errorf("Error setting terraform_labels: %s", err)
+	}
+	if err := d.Set("effective_labels", batch.Labels); err != nil {
+		return fmt.Errorf("Error setting effective_labels: %s", err)
+	}
+	if err := d.Set("runtime_config", flattenDataprocRuntimeConfig(d, "runtime_config", batch.RuntimeConfig)); err != nil {
+		return fmt.Errorf("Error setting runtime_config: %s", err)
+	}
+	if err := d.Set("environment_config", flattenDataprocEnvironmentConfig(batch.EnvironmentConfig, "ttl")); err != nil {
+		return fmt.Errorf("Error setting environment_config: %s", err)
+	}
+	if batch.PysparkBatch != nil {
+		if err := d.Set("pyspark_batch", flattenPySparkBatch(batch.PysparkBatch)); err != nil {
+			return fmt.Errorf("Error setting pyspark_batch: %s", err)
+		}
+	}
+	if batch.SparkBatch != nil {
+		if err := d.Set("spark_batch", flattenSparkBatch(batch.SparkBatch)); err != nil {
+			return fmt.Errorf("Error setting spark_batch: %s", err)
+		}
+	}
+	if batch.SparkRBatch != nil {
+		if err := d.Set("spark_r_batch", flattenSparkRBatch(batch.SparkRBatch)); err != nil {
+			return fmt.Errorf("Error setting spark_r_batch: %s", err)
+		}
+	}
+	if batch.SparkSqlBatch != nil {
+		if err := d.Set("spark_sql_batch", flattenSparkSqlBatch(batch.SparkSqlBatch)); err != nil {
+			return fmt.Errorf("Error setting spark_sql_batch: %s", err)
+		}
+	}
+	if err := d.Set("name", batch.Name); err != nil {
+		return fmt.Errorf("Error setting name: %s", err)
+	}
+	if err := d.Set("uuid", batch.Uuid); err != nil {
+		return fmt.Errorf("Error setting uuid: %s", err)
+	}
+	if err := d.Set("create_time", batch.CreateTime); err != nil {
+		return fmt.Errorf("Error setting create_time: %s", err)
+	}
+	if err := d.Set("creator", batch.Creator); err != nil {
+		return fmt.Errorf("Error setting creator: %s", err)
+	}
+	if err := d.Set("operation", batch.Operation); err != nil {
+		return fmt.Errorf("Error setting operation: %s", err)
+	}
+	if err := d.Set("state", batch.State); err != nil {
+		return fmt.Errorf("Error setting state: %s", err)
+	}
+	if err := d.Set("state_message", batch.StateMessage); err != nil {
+		return fmt.Errorf("Error setting state_message: %s", err)
+	}
+	if err := d.Set("state_time", batch.StateTime); err != nil {
+		return fmt.Errorf("Error setting state_time: %s", err)
+	}
+	if err := d.Set("runtime_info", flattenBatchRuntimeInfo(batch.RuntimeInfo)); err != nil {
+		return fmt.Errorf("Error setting runtime_info: %s", err)
+	}
+
+	return nil
+}
+
+func resourceDataprocBatchDelete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*transport_tpg.Config)
+	userAgent, err := tpgresource.GenerateUserAgentString(d, config.UserAgent)
+	if err != nil {
+		return err
+	}
+
+	if d.Get("force_delete").(bool) {
+		log.Printf("[DEBUG] Attempting to first cancel Dataproc batch %s if it's still running ...", d.Id())
+
+		// ignore error if we get one - the batch may be finished already and not
+		// need to be cancelled. We do however wait for the batch to reach a
+		// terminal state, as only those can be deleted.
+		if op := d.Get("operation").(string); op != "" {
+			_, _ = config.NewDataprocClient(userAgent).Projects.Regions.Operations.Cancel(op).Do()
+		}
+
+		waitErr := DataprocBatchTerminalWait(config, d.Id(), "Cancelling Dataproc batch", userAgent, d.Timeout(schema.TimeoutDelete))
+		if waitErr != nil {
+			return waitErr
+		}
+	}
+
+	log.Printf("[DEBUG] Deleting Dataproc batch %s", d.Id())
+	_, err = config.NewDataprocClient(userAgent).Projects.Locations.Batches.Delete(d.Id()).Do()
+	if err != nil {
+		return transport_tpg.HandleNotFoundError(err, d, fmt.Sprintf("Dataproc Batch %q", d.Id()))
+	}
+
+	log.Printf("[INFO] Dataproc batch %s has been deleted", d.Id())
+	d.SetId("")
+
+	return nil
+}
+
+var dataprocBatchNameRegex = regexp.MustCompile("^projects/([^/]+)/locations/([^/]+)/batches/([^/]+)$")
+
+// parseDataprocBatchName returns the project, location and batch ID of a batch's
+// resource name, which is also its id.
+func parseDataprocBatchName(name string) (string, string, string, error) {
+	parts := dataprocBatchNameRegex.FindStringSubmatch(name)
+	if parts == nil {
+		return "", "", "", fmt.Errorf("Unexpected Dataproc batch name %q, expected projects/{{project}}/locations/{{location}}/batches/{{batch_id}}", name)
+	}
+	return parts[1], parts[2], parts[3], nil
+}
+
+func resourceDataprocBatchImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	config := meta.(*transport_tpg.Config)
+	if err := tpgresource.ParseImportId([]string{
+		"^projects/(?P<project>[^/]+)/locations/(?P<location>[^/]+)/batches/(?P<batch_id>[^/]+)$",
+		"^(?P<project>[^/]+)/(?P<location>[^/]+)/(?P<batch_id>[^/]+)$",
+		"^(?P<location>[^/]+)/(?P<batch_id>[^/]+)$",
+	}, d, config); err != nil {
+		return nil, err
+	}
+
+	// Replace import id for the resource id
+	id, err := tpgresource.ReplaceVars(d, config, "projects/{{project}}/locations/{{location}}/batches/{{batch_id}}")
+	if err != nil {
+		return nil, fmt.Errorf("Error constructing id: %s", err)
+	}
+	d.SetId(id)
+
+	// Explicitly set virtual fields to default values on import
+	if err := d.Set("force_delete", false); err != nil {
+		return nil, fmt.Errorf("Error setting force_delete: %s", err)
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
+
+// ---- Runtime and environment config, shared with session templates ----
+
+func dataprocRuntimeConfigSchema(forceNew bool) *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeList,
+		Optional:    true,
+		Computed:    true,
+		ForceNew:    forceNew,
+		MaxItems:    1,
+		Description: `Runtime configuration for the workload.`,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"version": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Computed:    true,
+					ForceNew:    forceNew,
+					Description: `Version of the serverless runtime. If it is not provided, the service picks the default runtime version.`,
+				},
+				"container_image": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					ForceNew:    forceNew,
+					Description: `Custom container image for the job runtime environment. If not specified, a default container image will be used.`,
+				},
+				"properties": {
+					Type:        schema.TypeMap,
+					Optional:    true,
+					ForceNew:    forceNew,
+					Description: `A mapping of property names to values, which are used to configure workload execution.`,
+					Elem:        &schema.Schema{Type: schema.TypeString},
+				},
+				"effective_properties": {
+					Type:        schema.TypeMap,
+					Computed:    true,
+					Description: `A mapping of property names to values, which are used to configure workload execution, including the properties set by the service.`,
+					Elem:        &schema.Schema{Type: schema.TypeString},
+				},
+			},
+		},
+	}
+}
+
+func dataprocEnvironmentConfigSchema(forceNew bool, ttlField string) *schema.Schema {
+	executionConfig := map[string]*schema.Schema{
+		"service_account": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Computed:    true,
+			ForceNew:    forceNew,
+			Description: `Service account used to execute the workload.`,
+		},
+		"network_tags": {
+			Type:        schema.TypeList,
+			Optional:    true,
+			ForceNew:    forceNew,
+			Description: `Tags used for network traffic control.`,
+			Elem:        &schema.Schema{Type: schema.TypeString},
+		},
+		"kms_key": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			ForceNew:    forceNew,
+			Description: `The Cloud KMS key to use for encryption.`,
+		},
+		"staging_bucket": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			ForceNew:    forceNew,
+			Description: `A Cloud Storage bucket used to stage workload dependencies, config files, and store workload output and other ephemeral data, such as Spark history files. If you do not specify a staging bucket, Cloud Dataproc will determine a Cloud Storage location according to the region where your workload is running, and then create and manage project-level, per-location staging and temporary buckets.`,
+		},
+		"network_uri": {
+			Type:             schema.TypeString,
+			Optional:         true,
+			ForceNew:         forceNew,
+			DiffSuppressFunc: tpgresource.CompareSelfLinkOrResourceName,
+			ConflictsWith:    []string{"environment_config.0.execution_config.0.subnetwork_uri"},
+			Description:      `Network configuration for workload execution.`,
+		},
+		"subnetwork_uri": {
+			Type:             schema.TypeString,
+			Optional:         true,
+			ForceNew:         forceNew,
+			DiffSuppressFunc: tpgresource.CompareSelfLinkOrResourceName,
+			ConflictsWith:    []string{"environment_config.0.execution_config.0.network_uri"},
+			Description:      `Subnetwork configuration for workload execution.`,
+		},
+	}
+	switch ttlField {
+	case "ttl":
+		executionConfig["ttl"] = &schema.Schema{
+			Type:         schema.TypeString,
+			Optional:     true,
+			Computed:     true,
+			ForceNew:     forceNew,
+			ValidateFunc: verify.ValidateDuration(),
+			Description:  `The duration after which the workload will be terminated, specified as a duration in seconds, e.g. "3600s". When the workload exceeds this duration, it will be unconditionally terminated without waiting for ongoing work to finish.`,
+		}
+	case "idle_ttl":
+		executionConfig["idle_ttl"] = &schema.Schema{
+			Type:         schema.TypeString,
+			Optional:     true,
+			Computed:     true,
+			ForceNew:     forceNew,
+			ValidateFunc: verify.ValidateDuration(),
+			Description:  `The duration to keep the session alive while it's idling, specified as a duration in seconds, e.g. "3600s". Exceeding this threshold causes the session to terminate.`,
+		}
+	}
+
+	return &schema.Schema{
+		Type:        schema.TypeList,
+		Optional:    true,
+		Computed:    true,
+		ForceNew:    forceNew,
+		MaxItems:    1,
+		Description: `Environment configuration for the workload execution.`,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"execution_config": {
+					Type:        schema.TypeList,
+					Optional:    true,
+					Computed:    true,
+					ForceNew:    forceNew,
+					MaxItems:    1,
+					Description: `Execution configuration for a workload.`,
+					Elem: &schema.Resource{
+						Schema: executionConfig,
+					},
+				},
+				"peripherals_config": {
+					Type:        schema.TypeList,
+					Optional:    true,
+					Computed:    true,
+					ForceNew:    forceNew,
+					MaxItems:    1,
+					Description: `Peripherals configuration that workload has access to.`,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"metastore_service": {
+								Type:        schema.TypeString,
+								Optional:    true,
+								ForceNew:    forceNew,
+								Description: `Resource name of an existing Dataproc Metastore service.`,
+							},
+							"spark_history_server_config": {
+								Type:        schema.TypeList,
+								Optional:    true,
+								ForceNew:    forceNew,
+								MaxItems:    1,
+								Description: `The Spark History Server configuration for the workload.`,
+								Elem: &schema.Resource{
+									Schema: map[string]*schema.Schema{
+										"dataproc_cluster": {
+											Type:        schema.TypeString,
+											Optional:    true,
+											ForceNew:    forceNew,
+											Description: `Resource name of an existing Dataproc Cluster to act as a Spark History Server for the workload.`,
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func expandDataprocRuntimeConfig(cfg map[string]interface{}) *dataproc.RuntimeConfig {
+	conf := &dataproc.RuntimeConfig{}
+	if v, ok := cfg["version"]; ok {
+		conf.Version = v.(string)
+	}
+	if v, ok := cfg["container_image"]; ok {
+		conf.ContainerImage = v.(string)
+	}
+	if v, ok := cfg["properties"]; ok {
+		conf.Properties = tpgresource.ConvertStringMap(v.(map[string]interface{}))
+	}
+	return conf
+}
+
+// The service adds its own properties to the runtime config, so only the
+// properties present in the configuration are set in properties, and all of
+// them are exposed in effective_properties.
+func flattenDataprocRuntimeConfig(d *schema.ResourceData, key string, conf *dataproc.RuntimeConfig) []map[string]interface{} {
+	if conf == nil {
+		return nil
+	}
+
+	properties := map[string]string{}
+	if v, ok := d.GetOk(key + ".0.properties"); ok {
+		for k := range v.(map[string]interface{}) {
+			if val, ok := conf.Properties[k]; ok {
+				properties[k] = val
+			}
+		}
+	}
+
+	return []map[string]interface{}{
+		{
+			"version":              conf.Version,
+			"container_image":      conf.ContainerImage,
+			"properties":           properties,
+			"effective_properties": conf.Properties,
+		},
+	}
+}
+
+func expandDataprocEnvironmentConfig(cfg map[string]interface{}) *dataproc.EnvironmentConfig {
+	conf := &dataproc.EnvironmentConfig{}
+	if v, ok := cfg["execution_config"]; ok {
+		ec := tpgresource.ExtractFirstMapConfig(v.([]interface{}))
+		if len(ec) > 0 {
+			conf.ExecutionConfig = &dataproc.ExecutionConfig{}
+			if v, ok := ec["service_account"]; ok {
+				conf.ExecutionConfig.ServiceAccount = v.(string)
+			}
+			if v, ok := ec["network_tags"]; ok {
+				conf.ExecutionConfig.NetworkTags = tpgresource.ConvertStringArr(v.([]interface{}))
+			}
+			if v, ok := ec["kms_key"]; ok {
+				conf.ExecutionConfig.KmsKey = v.(string)
+			}
+			if v, ok := ec["ttl"]; ok {
+				conf.ExecutionConfig.Ttl = v.(string)
+			}
+			if v, ok := ec["idle_ttl"]; ok {
+				conf.ExecutionConfig.IdleTtl = v.(string)
+			}
+			if v, ok := ec["staging_bucket"]; ok {
+				conf.ExecutionConfig.StagingBucket = v.(string)
+			}
+			if v, ok := ec["network_uri"]; ok {
+				conf.ExecutionConfig.NetworkUri = v.(string)
+			}
+			if v, ok := ec["subnetwork_uri"]; ok {
+				conf.ExecutionConfig.SubnetworkUri = v.(string)
+			}
+		}
+	}
+	if v, ok := cfg["peripherals_config"]; ok {
+		pc := tpgresource.ExtractFirstMapConfig(v.([]interface{}))
+		if len(pc) > 0 {
+			conf.PeripheralsConfig = &dataproc.PeripheralsConfig{}
+			if v, ok := pc["metastore_service"]; ok {
+				conf.PeripheralsConfig.MetastoreService = v.(string)
+			}
+			if v, ok := pc["spark_history_server_config"]; ok {
+				shs := tpgresource.ExtractFirstMapConfig(v.([]interface{}))
+				if len(shs) > 0 {
+					conf.PeripheralsConfig.SparkHistoryServerConfig = &dataproc.SparkHistoryServerConfig{
+						DataprocCluster: shs["dataproc_cluster"].(string),
+					}
+				}
+			}
+		}
+	}
+	return conf
+}
+
+func flattenDataprocEnvironmentConfig(conf *dataproc.EnvironmentConfig, ttlField string) []map[string]interface{} {
+	if conf == nil {
+		return nil
+	}
+
+	data := map[string]interface{}{}
+	if ec := conf.ExecutionConfig; ec != nil {
+		execution := map[string]interface{}{
+			"service_account": ec.ServiceAccount,
+			"network_tags":    ec.NetworkTags,
+			"kms_key":         ec.KmsKey,
+			"staging_bucket":  ec.StagingBucket,
+			"network_uri":     ec.NetworkUri,
+			"subnetwork_uri":  ec.SubnetworkUri,
+		}
+		switch ttlField {
+		case "ttl":
+			execution["ttl"] = ec.Ttl
+		case "idle_ttl":
+			execution["idle_ttl"] = ec.IdleTtl
+		}
+		data["execution_config"] = []map[string]interface{}{execution}
+	}
+	if pc := conf.PeripheralsConfig; pc != nil {
+		peripherals := map[string]interface{}{
+			"metastore_service": pc.MetastoreService,
+		}
+		if pc.SparkHistoryServerConfig != nil {
+			peripherals["spark_history_server_config"] = []map[string]interface{}{
+				{"dataproc_cluster": pc.SparkHistoryServerConfig.DataprocCluster},
+			}
+		}
+		data["peripherals_config"] = []map[string]interface{}{peripherals}
+	}
+	return []map[string]interface{}{data}
+}
+
+func flattenBatchRuntimeInfo(info *dataproc.RuntimeInfo) []map[string]interface{} {
+	if info == nil {
+		return nil
+	}
+	return []map[string]interface{}{
+		{
+			"output_uri":            info.OutputUri,
+			"diagnostic_output_uri": info.DiagnosticOutputUri,
+			"endpoints":             info.Endpoints,
+		},
+	}
+}
+
+// ---- PySpark Batch ----
+
+var pySparkBatchSchema = &schema.Schema{
+	Type:         schema.TypeList,
+	Optional:     true,
+	ForceNew:     true,
+	MaxItems:     1,
+	Description:  `PySpark batch config.`,
+	ExactlyOneOf: batchTypes,
+	Elem: &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"main_python_file_uri": {
+				Type:        schema.TypeString,
+				Description: "The HCFS URI of the main Python file to use as the Spark driver. Must be a .py file.",
+				Required:    true,
+				ForceNew:    true,
+			},
+
+			"args": {
+				Type:        schema.TypeList,
+				Description: "The arguments to pass to the driver. Do not include arguments that can be set as batch properties, such as --conf, since a collision can occur that causes an incorrect batch submission.",
+				Optional:    true,
+				ForceNew:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+
+			"python_file_uris": {
+				Type:        schema.TypeList,
+				Description: "HCFS file URIs of Python files to pass to the PySpark framework. Supported file types: .py, .egg, and .zip.",
+				Optional:    true,
+				ForceNew:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+
+			"jar_file_uris": {
+				Type:        schema.TypeList,
+				Description: "HCFS URIs of jar files to add to the classpath of the Spark driver and tasks.",
+				Optional:    true,
+				ForceNew:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+
+			"file_uris": {
+				Type:        schema.TypeList,
+				Description: "HCFS URIs of files to be placed in the working directory of each executor.",
+				Optional:    true,
+				ForceNew:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+
+			"archive_uris": {
+				Type:        schema.TypeList,
+				Description: "HCFS URIs of archives to be extracted into the working directory of each executor. Supported file types: .jar, .tar, .tar.gz, .tgz, and .zip.",
+				Optional:    true,
+				ForceNew:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	},
+}
+
+func flattenPySparkBatch(batch *dataproc.PySparkBatch) []map[string]interface{} {
+	return []map[string]interface{}{
+		{
+			"main_python_file_uri": batch.MainPythonFileUri,
+			"args":                 batch.Args,
+			"python_file_uris":     batch.PythonFileUris,
+			"jar_file_uris":        batch.JarFileUris,
+			"file_uris":            batch.FileUris,
+			"archive_uris":         batch.ArchiveUris,
+		},
+	}
+}
+
+func expandPySparkBatch(config map[string]interface{}) *dataproc.PySparkBatch {
+	batch := &dataproc.PySparkBatch{}
+	if v, ok := config["main_python_file_uri"]; ok {
+		batch.MainPythonFileUri = v.(string)
+	}
+	if v, ok := config["args"]; ok {
+		batch.Args = tpgresource.ConvertStringArr(v.([]interface{}))
+	}
+	if v, ok := config["python_file_uris"]; ok {
+		batch.PythonFileUris = tpgresource.ConvertStringArr(v.([]interface{}))
+	}
+	if v, ok := config["jar_file_uris"]; ok {
+		batch.JarFileUris = tpgresource.ConvertStringArr(v.([]interface{}))
+	}
+	if v, ok := config["file_uris"]; ok {
+		batch.FileUris = tpgresource.ConvertStringArr(v.([]interface{}))
+	}
+	if v, ok := config["archive_uris"]; ok {
+		batch.ArchiveUris = tpgresource.ConvertStringArr(v.([]interface{}))
+	}
+	return batch
+}
+
+// ---- Spark Batch ----
+
+var sparkBatchSchema = &schema.Schema{
+	Type:         schema.TypeList,
+	Optional:     true,
+	ForceNew:     true,
+	MaxItems:     1,
+	Description:  `Spark batch config.`,
+	ExactlyOneOf: batchTypes,
+	Elem: &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			// main driver: can be only one of the main_class | main_jar_file_uri
+			"main_class": {
+				Type:         schema.TypeString,
+				Description:  "The name of the driver main class. The jar file that contains the class must be in the classpath or specified in jar_file_uris.",
+				Optional:     true,
+				ForceNew:     true,
+				ExactlyOneOf: []string{"spark_batch.0.main_class", "spark_batch.0.main_jar_file_uri"},
+			},
+
+			"main_jar_file_uri": {
+				Type:         schema.TypeString,
+				Description:  "The HCFS URI of the jar file that contains the main class.",
+				Optional:     true,
+				ForceNew:     true,
+				ExactlyOneOf: []string{"spark_batch.0.main_class", "spark_batch.0.main_jar_file_uri"},
+			},
+
+			"args": {
+				Type:        schema.TypeList,
+				Description: "The arguments to pass to the driver. Do not include arguments that can be set as batch properties, such as --conf, since a collision can occur that causes an incorrect batch submission.",
+				Optional:    true,
+				ForceNew:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+
+			"jar_file_uris": {
+				Type:        schema.TypeList,
+				Description: "HCFS URIs of jar files to add to the classpath of the Spark driver and tasks.",
+				Optional:    true,
+				ForceNew:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+
+			"file_uris": {
+				Type:        schema.TypeList,
+				Description: "HCFS URIs of files to be placed in the working directory of each executor.",
+				Optional:    true,
+				ForceNew:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+
+			"archive_uris": {
+				Type:        schema.TypeList,
+				Description: "HCFS URIs of archives to be extracted into the working directory of each executor. Supported file types: .jar, .tar, .tar.gz, .tgz, and .zip.",
+				Optional:    true,
+				ForceNew:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	},
+}
+
+func flattenSparkBatch(batch *dataproc.SparkBatch) []map[string]interface{} {
+	return []map[string]interface{}{
+		{
+			"main_class":        batch.MainClass,
+			"main_jar_file_uri": batch.MainJarFileUri,
+			"args":              batch.Args,
+			"jar_file_uris":     batch.JarFileUris,
+			"file_uris":         batch.FileUris,
+			"archive_uris":      batch.ArchiveUris,
+		},
+	}
+}
+
+func expandSparkBatch(config map[string]interface{}) *dataproc.SparkBatch {
+	batch := &dataproc.SparkBatch{}
+	if v, ok := config["main_class"]; ok {
+		batch.MainClass = v.(string)
+	}
+	if v, ok := config["main_jar_file_uri"]; ok {
+		batch.MainJarFileUri = v.(string)
+	}
+	if v, ok := config["args"]; ok {
+		batch.Args = tpgresource.ConvertStringArr(v.([]interface{}))
+	}
+	if v, ok := config["jar_file_uris"]; ok {
+		batch.JarFileUris = tpgresource.ConvertStringArr(v.([]interface{}))
+	}
+	if v, ok := config["file_uris"]; ok {
+		batch.FileUris = tpgresource.ConvertStringArr(v.([]interface{}))
+	}
+	if v, ok := config["archive_uris"]; ok {
+		batch.ArchiveUris = tpgresource.ConvertStringArr(v.([]interface{}))
+	}
+	return batch
+}
+
+// ---- SparkR Batch ----
+
+var sparkRBatchSchema = &schema.Schema{
+	Type:         schema.TypeList,
+	Optional:     true,
+	ForceNew:     true,
+	MaxItems:     1,
+	Description:  `SparkR batch config.`,
+	ExactlyOneOf: batchTypes,
+	Elem: &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"main_r_file_uri": {
+				Type:        schema.TypeString,
+				Description: "The HCFS URI of the main R file to use as the driver. Must be a .R or .r file.",
+				Required:    true,
+				ForceNew:    true,
+			},
+
+			"args": {
+				Type:        schema.TypeList,
+				Description: "The arguments to pass to the Spark driver. Do not include arguments that can be set as batch properties, such as --conf, since a collision can occur that causes an incorrect batch submission.",
+				Optional:    true,
+				ForceNew:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+
+			"file_uris": {
+				Type:        schema.TypeList,
+				Description: "HCFS URIs of files to be placed in the working directory of each executor.",
+				Optional:    true,
+				ForceNew:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+
+			"archive_uris": {
+				Type:        schema.TypeList,
+				Description: "HCFS URIs of archives to be extracted into the working directory of each executor. Supported file types: .jar, .tar, .tar.gz, .tgz, and .zip.",
+				Optional:    true,
+				ForceNew:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	},
+}
+
+func flattenSparkRBatch(batch *dataproc.SparkRBatch) []map[string]interface{} {
+	return []map[string]interface{}{
+		{
+			"main_r_file_uri": batch.MainRFileUri,
+			"args":            batch.Args,
+			"file_uris":       batch.FileUris,
+			"archive_uris":    batch.ArchiveUris,
+		},
+	}
+}
+
+func expandSparkRBatch(config map[string]interface{}) *dataproc.SparkRBatch {
+	batch := &dataproc.SparkRBatch{}
+	if v, ok := config["main_r_file_uri"]; ok {
+		batch.MainRFileUri = v.(string)
+	}
+	if v, ok := config["args"]; ok {
+		batch.Args = tpgresource.ConvertStringArr(v.([]interface{}))
+	}
+	if v, ok := config["file_uris"]; ok {
+		batch.FileUris = tpgresource.ConvertStringArr(v.([]interface{}))
+	}
+	if v, ok := config["archive_uris"]; ok {
+		batch.ArchiveUris = tpgresource.ConvertStringArr(v.([]interface{}))
+	}
+	return batch
+}
+
+// ---- Spark SQL Batch ----
+
+var sparkSqlBatchSchema = &schema.Schema{
+	Type:         schema.TypeList,
+	Optional:     true,
+	ForceNew:     true,
+	MaxItems:     1,
+	Description:  `Spark SQL batch config.`,
+	ExactlyOneOf: batchTypes,
+	Elem: &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"query_file_uri": {
+				Type:        schema.TypeString,
+				Description: "The HCFS URI of the script that contains Spark SQL queries to execute.",
+				Required:    true,
+				ForceNew:    true,
+			},
+
+			"query_variables": {
+				Type:        schema.TypeMap,
+				Description: "Mapping of query variable names to values (equivalent to the Spark SQL command: SET name=\"value\";).",
+				Optional:    true,
+				ForceNew:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+
+			"jar_file_uris": {
+				Type:        schema.TypeList,
+				Description: "HCFS URIs of jar files to be added to the Spark CLASSPATH.",
+				Optional:    true,
+				ForceNew:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	},
+}
+
+func flattenSparkSqlBatch(batch *dataproc.SparkSqlBatch) []map[string]interface{} {
+	return []map[string]interface{}{
+		{
+			"query_file_uri":  batch.QueryFileUri,
+			"query_variables": batch.QueryVariables,
+			"jar_file_uris":   batch.JarFileUris,
+		},
+	}
+}
+
+func expandSparkSqlBatch(config map[string]interface{}) *dataproc.SparkSqlBatch {
+	batch := &dataproc.SparkSqlBatch{}
+	if v, ok := config["query_file_uri"]; ok {
+		batch.QueryFileUri = v.(string)
+	}
+	if v, ok := config["query_variables"]; ok {
+		batch.QueryVariables = tpgresource.ConvertStringMap(v.(map[string]interface{}))
+	}
+	if v, ok := config["jar_file_uris"]; ok {
+		batch.JarFileUris = tpgresource.ConvertStringArr(v.([]interface{}))
+	}
+	return batch
+}
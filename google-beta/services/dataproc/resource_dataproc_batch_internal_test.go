@@ -0,0 +1,25 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+package dataproc
+
+import (
+	"testing"
+)
+
+func TestParseDataprocBatchName(t *testing.T) {
+	t.Parallel()
+
+	project, location, batchId, err := parseDataprocBatchName("projects/my-project/locations/us-central1/batches/terraform-20240101")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if project != "my-project" || location != "us-central1" || batchId != "terraform-20240101" {
+		t.Errorf("unexpected parts: %q, %q, %q", project, location, batchId)
+	}
+
+	for _, name := range []string{"", "my-batch", "projects/my-project/locations/us-central1/sessionTemplates/t"} {
+		if _, _, _, err := parseDataprocBatchName(name); err == nil {
+			t.Errorf("expected an error for %q", name)
+		}
+	}
+}
@@ -0,0 +1,159 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+package dataproc_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-google-beta/google-beta/acctest"
+	"google.golang.org/api/googleapi"
+)
+
+func TestAccDataprocBatch_sparkBatch(t *testing.T) {
+	t.Parallel()
+
+	rnd := acctest.RandString(t, 10)
+	networkName := acctest.BootstrapSharedTestNetwork(t, "dataproc-cluster")
+	subnetworkName := acctest.BootstrapSubnet(t, "dataproc-cluster", networkName)
+	acctest.BootstrapFirewallForDataprocSharedNetwork(t, "dataproc-cluster", networkName)
+
+	acctest.VcrTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.AccTestPreCheck(t) },
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories(t),
+		CheckDestroy:             testAccCheckDataprocBatchDestroyProducer(t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataprocBatch_sparkBatch(rnd, subnetworkName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("google_dataproc_batch.spark", "uuid"),
+					resource.TestCheckResourceAttrSet("google_dataproc_batch.spark", "state"),
+				),
+			},
+			{
+				ResourceName:            "google_dataproc_batch.spark",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"force_delete", "labels", "terraform_labels", "state", "state_message", "state_time", "runtime_info", "runtime_config.0.properties"},
+			},
+		},
+	})
+}
+
+func TestAccDataprocBatch_pysparkBatch(t *testing.T) {
+	t.Parallel()
+
+	rnd := acctest.RandString(t, 10)
+	networkName := acctest.BootstrapSharedTestNetwork(t, "dataproc-cluster")
+	subnetworkName := acctest.BootstrapSubnet(t, "dataproc-cluster", networkName)
+	acctest.BootstrapFirewallForDataprocSharedNetwork(t, "dataproc-cluster", networkName)
+
+	acctest.VcrTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.AccTestPreCheck(t) },
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories(t),
+		CheckDestroy:             testAccCheckDataprocBatchDestroyProducer(t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataprocBatch_pysparkBatch(rnd, subnetworkName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("google_dataproc_batch.pyspark", "batch_id", fmt.Sprintf("tf-test-pyspark-%s", rnd)),
+					resource.TestCheckResourceAttr("google_dataproc_batch.pyspark", "runtime_config.0.properties.spark.dynamicAllocation.enabled", "false"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckDataprocBatchDestroyProducer(t *testing.T) func(s *terraform.State) error {
+	return func(s *terraform.State) error {
+		config := acctest.GoogleProviderConfig(t)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "google_dataproc_batch" {
+				continue
+			}
+
+			if rs.Primary.ID == "" {
+				return fmt.Errorf("Unable to verify delete of dataproc batch ID is empty")
+			}
+
+			_, err := config.NewDataprocClient(config.UserAgent).Projects.Locations.Batches.Get(rs.Primary.ID).Do()
+			if err != nil {
+				if gerr, ok := err.(*googleapi.Error); ok && gerr.Code == 404 {
+					return nil
+				} else if ok {
+					return fmt.Errorf("Error making GCP platform call: http code error : %d, http message error: %s", gerr.Code, gerr.Message)
+				}
+				return fmt.Errorf("Error making GCP platform call: %s", err.Error())
+			}
+			return fmt.Errorf("Dataproc batch still exists")
+		}
+
+		return nil
+	}
+}
+
+func testAccDataprocBatch_sparkBatch(rnd, subnetworkName string) string {
+	return fmt.Sprintf(`
+resource "google_dataproc_batch" "spark" {
+  batch_id     = "tf-test-spark-%s"
+  location     = "us-central1"
+  force_delete = true
+
+  labels = {
+    batch_test_id = "example-value"
+  }
+
+  runtime_config {
+    properties = {
+      "spark.dynamicAllocation.enabled" = "false"
+      "spark.executor.instances"        = "2"
+    }
+  }
+
+  environment_config {
+    execution_config {
+      subnetwork_uri = "%s"
+      ttl            = "3600s"
+      network_tags   = ["tag1"]
+    }
+  }
+
+  spark_batch {
+    main_class    = "org.apache.spark.examples.SparkPi"
+    args          = ["10"]
+    jar_file_uris = ["file:///usr/lib/spark/examples/jars/spark-examples.jar"]
+  }
+}
+`, rnd, subnetworkName)
+}
+
+func testAccDataprocBatch_pysparkBatch(rnd, subnetworkName string) string {
+	return fmt.Sprintf(`
+resource "google_dataproc_batch" "pyspark" {
+  batch_id     = "tf-test-pyspark-%s"
+  location     = "us-central1"
+  force_delete = true
+
+  runtime_config {
+    properties = {
+      "spark.dynamicAllocation.enabled" = "false"
+      "spark.executor.instances"        = "2"
+    }
+  }
+
+  environment_config {
+    execution_config {
+      subnetwork_uri = "%s"
+    }
+  }
+
+  pyspark_batch {
+    main_python_file_uri = "gs://dataproc-examples/pyspark/hello-world/hello-world.py"
+    args                 = ["10"]
+  }
+}
+`, rnd, subnetworkName)
+}
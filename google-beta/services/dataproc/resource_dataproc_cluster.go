@@ -82,6 +82,7 @@ var (
 		"cluster_config.0.preemptible_worker_config.0.disk_config.0.num_local_ssds",
 		"cluster_config.0.preemptible_worker_config.0.disk_config.0.boot_disk_size_gb",
 		"cluster_config.0.preemptible_worker_config.0.disk_config.0.boot_disk_type",
+		"cluster_config.0.preemptible_worker_config.0.disk_config.0.local_ssd_interface",
 	}
 
 	clusterSoftwareConfigKeys = []string{
@@ -829,6 +830,7 @@ func ResourceDataprocCluster() *schema.Resource {
 														"cluster_config.0.master_config.0.disk_config.0.num_local_ssds",
 														"cluster_config.0.master_config.0.disk_config.0.boot_disk_size_gb",
 														"cluster_config.0.master_config.0.disk_config.0.boot_disk_type",
+														"cluster_config.0.master_config.0.disk_config.0.local_ssd_interface",
 													},
 													ForceNew: true,
 												},
@@ -842,6 +844,7 @@ func ResourceDataprocCluster() *schema.Resource {
 														"cluster_config.0.master_config.0.disk_config.0.num_local_ssds",
 														"cluster_config.0.master_config.0.disk_config.0.boot_disk_size_gb",
 														"cluster_config.0.master_config.0.disk_config.0.boot_disk_type",
+														"cluster_config.0.master_config.0.disk_config.0.local_ssd_interface",
 													},
 													ForceNew:     true,
 													ValidateFunc: validation.IntAtLeast(10),
@@ -855,10 +858,26 @@ func ResourceDataprocCluster() *schema.Resource {
 														"cluster_config.0.master_config.0.disk_config.0.num_local_ssds",
 														"cluster_config.0.master_config.0.disk_config.0.boot_disk_size_gb",
 														"cluster_config.0.master_config.0.disk_config.0.boot_disk_type",
+														"cluster_config.0.master_config.0.disk_config.0.local_ssd_interface",
 													},
 													ForceNew: true,
 													Default:  "pd-standard",
 												},
+
+												"local_ssd_interface": {
+													Type:         schema.TypeString,
+													Optional:     true,
+													Computed:     true,
+													Description:  `Interface type of local SSDs (default is "scsi"). Valid values: "scsi" (Small Computer System Interface), "nvme" (Non-Volatile Memory Express).`,
+													ValidateFunc: validation.StringInSlice([]string{"scsi", "nvme"}, false),
+													AtLeastOneOf: []string{
+														"cluster_config.0.master_config.0.disk_config.0.num_local_ssds",
+														"cluster_config.0.master_config.0.disk_config.0.boot_disk_size_gb",
+														"cluster_config.0.master_config.0.disk_config.0.boot_disk_type",
+														"cluster_config.0.master_config.0.disk_config.0.local_ssd_interface",
+													},
+													ForceNew: true,
+												},
 											},
 										},
 									},
@@ -978,6 +997,7 @@ func ResourceDataprocCluster() *schema.Resource {
 														"cluster_config.0.worker_config.0.disk_config.0.num_local_ssds",
 														"cluster_config.0.worker_config.0.disk_config.0.boot_disk_size_gb",
 														"cluster_config.0.worker_config.0.disk_config.0.boot_disk_type",
+														"cluster_config.0.worker_config.0.disk_config.0.local_ssd_interface",
 													},
 													ForceNew: true,
 												},
@@ -991,6 +1011,7 @@ func ResourceDataprocCluster() *schema.Resource {
 														"cluster_config.0.worker_config.0.disk_config.0.num_local_ssds",
 														"cluster_config.0.worker_config.0.disk_config.0.boot_disk_size_gb",
 														"cluster_config.0.worker_config.0.disk_config.0.boot_disk_type",
+														"cluster_config.0.worker_config.0.disk_config.0.local_ssd_interface",
 													},
 													ForceNew:     true,
 													ValidateFunc: validation.IntAtLeast(10),
@@ -1004,10 +1025,26 @@ func ResourceDataprocCluster() *schema.Resource {
 														"cluster_config.0.worker_config.0.disk_config.0.num_local_ssds",
 														"cluster_config.0.worker_config.0.disk_config.0.boot_disk_size_gb",
 														"cluster_config.0.worker_config.0.disk_config.0.boot_disk_type",
+														"cluster_config.0.worker_config.0.disk_config.0.local_ssd_interface",
 													},
 													ForceNew: true,
 													Default:  "pd-standard",
 												},
+
+												"local_ssd_interface": {
+													Type:         schema.TypeString,
+													Optional:     true,
+													Computed:     true,
+													Description:  `Interface type of local SSDs (default is "scsi"). Valid values: "scsi" (Small Computer System Interface), "nvme" (Non-Volatile Memory Express).`,
+													ValidateFunc: validation.StringInSlice([]string{"scsi", "nvme"}, false),
+													AtLeastOneOf: []string{
+														"cluster_config.0.worker_config.0.disk_config.0.num_local_ssds",
+														"cluster_config.0.worker_config.0.disk_config.0.boot_disk_size_gb",
+														"cluster_config.0.worker_config.0.disk_config.0.boot_disk_type",
+														"cluster_config.0.worker_config.0.disk_config.0.local_ssd_interface",
+													},
+													ForceNew: true,
+												},
 											},
 										},
 									},
@@ -1132,6 +1169,16 @@ func ResourceDataprocCluster() *schema.Resource {
 													Default:      "pd-standard",
 													Description:  `The disk type of the primary disk attached to each preemptible worker node. Such as "pd-ssd" or "pd-standard". Defaults to "pd-standard".`,
 												},
+
+												"local_ssd_interface": {
+													Type:         schema.TypeString,
+													Optional:     true,
+													Computed:     true,
+													AtLeastOneOf: preemptibleWorkerDiskConfigKeys,
+													ForceNew:     true,
+													ValidateFunc: validation.StringInSlice([]string{"scsi", "nvme"}, false),
+													Description:  `Interface type of local SSDs (default is "scsi"). Valid values: "scsi" (Small Computer System Interface), "nvme" (Non-Volatile Memory Express).`,
+												},
 											},
 										},
 									},
@@ -2368,6 +2415,9 @@ func expandPreemptibleInstanceGroupConfig(cfg map[string]interface{}) *dataproc.
 			if v, ok := dcfg["boot_disk_type"]; ok {
 				icg.DiskConfig.BootDiskType = v.(string)
 			}
+			if v, ok := dcfg["local_ssd_interface"]; ok {
+				icg.DiskConfig.LocalSsdInterface = v.(string)
+			}
 		}
 	}
 
@@ -2441,6 +2491,9 @@ func expandMasterInstanceGroupConfig(cfg map[string]interface{}) *dataproc.Insta
 			if v, ok := dcfg["boot_disk_type"]; ok {
 				icg.DiskConfig.BootDiskType = v.(string)
 			}
+			if v, ok := dcfg["local_ssd_interface"]; ok {
+				icg.DiskConfig.LocalSsdInterface = v.(string)
+			}
 		}
 	}
 
@@ -2482,6 +2535,9 @@ func expandWorkerInstanceGroupConfig(cfg map[string]interface{}) *dataproc.Insta
 			if v, ok := dcfg["boot_disk_type"]; ok {
 				icg.DiskConfig.BootDiskType = v.(string)
 			}
+			if v, ok := dcfg["local_ssd_interface"]; ok {
+				icg.DiskConfig.LocalSsdInterface = v.(string)
+			}
 		}
 	}
 
@@ -3137,6 +3193,7 @@ func flattenPreemptibleInstanceGroupConfig(d *schema.ResourceData, icg *dataproc
 			disk["boot_disk_size_gb"] = icg.DiskConfig.BootDiskSizeGb
 			disk["num_local_ssds"] = icg.DiskConfig.NumLocalSsds
 			disk["boot_disk_type"] = icg.DiskConfig.BootDiskType
+			disk["local_ssd_interface"] = icg.DiskConfig.LocalSsdInterface
 		}
 		if icg.InstanceFlexibilityPolicy != nil {
 			instanceFlexibilityPolicy["instance_selection_list"] = flattenInstanceSelectionList(icg.InstanceFlexibilityPolicy.InstanceSelectionList)
@@ -3191,6 +3248,7 @@ func flattenMasterInstanceGroupConfig(d *schema.ResourceData, icg *dataproc.Inst
 			disk["boot_disk_size_gb"] = icg.DiskConfig.BootDiskSizeGb
 			disk["num_local_ssds"] = icg.DiskConfig.NumLocalSsds
 			disk["boot_disk_type"] = icg.DiskConfig.BootDiskType
+			disk["local_ssd_interface"] = icg.DiskConfig.LocalSsdInterface
 		}
 
 		data["accelerators"] = flattenAccelerators(icg.Accelerators)
@@ -3215,6 +3273,7 @@ func flattenWorkerInstanceGroupConfig(d *schema.ResourceData, icg *dataproc.Inst
 			disk["boot_disk_size_gb"] = icg.DiskConfig.BootDiskSizeGb
 			disk["num_local_ssds"] = icg.DiskConfig.NumLocalSsds
 			disk["boot_disk_type"] = icg.DiskConfig.BootDiskType
+			disk["local_ssd_interface"] = icg.DiskConfig.LocalSsdInterface
 		}
 
 		data["accelerators"] = flattenAccelerators(icg.Accelerators)
@@ -510,6 +510,34 @@ func TestAccDataprocCluster_spotSecondary(t *testing.T) {
 	})
 }
 
+func TestAccDataprocCluster_spotSecondaryWithLocalSsds(t *testing.T) {
+	t.Parallel()
+
+	rnd := acctest.RandString(t, 10)
+	networkName := acctest.BootstrapSharedTestNetwork(t, "dataproc-cluster")
+	subnetworkName := acctest.BootstrapSubnet(t, "dataproc-cluster", networkName)
+	acctest.BootstrapFirewallForDataprocSharedNetwork(t, "dataproc-cluster", networkName)
+	var cluster dataproc.Cluster
+
+	acctest.VcrTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.AccTestPreCheck(t) },
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories(t),
+		CheckDestroy:             testAccCheckDataprocClusterDestroy(t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataprocCluster_spotSecondaryWithLocalSsds(rnd, subnetworkName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDataprocClusterExists(t, "google_dataproc_cluster.spot_secondary", &cluster),
+					resource.TestCheckResourceAttr("google_dataproc_cluster.spot_secondary", "cluster_config.0.preemptible_worker_config.0.preemptibility", "SPOT"),
+					resource.TestCheckResourceAttr("google_dataproc_cluster.spot_secondary", "cluster_config.0.preemptible_worker_config.0.disk_config.0.num_local_ssds", "1"),
+					resource.TestCheckResourceAttr("google_dataproc_cluster.spot_secondary", "cluster_config.0.preemptible_worker_config.0.disk_config.0.local_ssd_interface", "nvme"),
+					resource.TestCheckResourceAttr("google_dataproc_cluster.spot_secondary", "cluster_config.0.worker_config.0.disk_config.0.local_ssd_interface", "nvme"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccDataprocCluster_spotWithInstanceFlexibilityPolicy(t *testing.T) {
 	t.Parallel()
 
@@ -1888,6 +1916,49 @@ resource "google_dataproc_cluster" "spot_secondary" {
 	`, rnd, subnetworkName)
 }
 
+func testAccDataprocCluster_spotSecondaryWithLocalSsds(rnd, subnetworkName string) string {
+	return fmt.Sprintf(`
+resource "google_dataproc_cluster" "spot_secondary" {
+  name   = "tf-test-dproc-%s"
+  region = "us-central1"
+
+  cluster_config {
+    gce_cluster_config {
+      subnetwork = "%s"
+    }
+
+    master_config {
+      num_instances = "1"
+      machine_type  = "e2-medium"
+      disk_config {
+        boot_disk_size_gb = 35
+      }
+    }
+
+    worker_config {
+      num_instances = "2"
+      machine_type  = "n2-standard-2"
+      disk_config {
+        boot_disk_size_gb   = 35
+        num_local_ssds      = 1
+        local_ssd_interface = "nvme"
+      }
+    }
+
+    preemptible_worker_config {
+      num_instances  = "1"
+      preemptibility = "SPOT"
+      disk_config {
+        boot_disk_size_gb   = 35
+        num_local_ssds      = 1
+        local_ssd_interface = "nvme"
+      }
+    }
+  }
+}
+	`, rnd, subnetworkName)
+}
+
 func testAccDataprocCluster_spotWithInstanceFlexibilityPolicy(rnd string) string {
 	return fmt.Sprintf(`
 resource "google_dataproc_cluster" "spot_with_instance_flexibility_policy" {
@@ -0,0 +1,378 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+package dataproc
+
+import (
+	"fmt"
+	"log"
+	"regexp"
+	"time"
+
+	"github.com/hashicorp/terraform-provider-google-beta/google-beta/tpgresource"
+	transport_tpg "github.com/hashicorp/terraform-provider-google-beta/google-beta/transport"
+	"github.com/hashicorp/terraform-provider-google-beta/google-beta/verify"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"google.golang.org/api/dataproc/v1"
+)
+
+var dataprocSessionTemplateNameRegex = regexp.MustCompile("^projects/([^/]+)/locations/([^/]+)/sessionTemplates/([^/]+)$")
+
+func ResourceDataprocSessionTemplate() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceDataprocSessionTemplateCreate,
+		Read:   resourceDataprocSessionTemplateRead,
+		Update: resourceDataprocSessionTemplateUpdate,
+		Delete: resourceDataprocSessionTemplateDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: resourceDataprocSessionTemplateImport,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(20 * time.Minute),
+			Update: schema.DefaultTimeout(20 * time.Minute),
+			Delete: schema.DefaultTimeout(20 * time.Minute),
+		},
+
+		CustomizeDiff: customdiff.All(
+			tpgresource.DefaultProviderProject,
+			tpgresource.SetLabelsDiff,
+		),
+
+		Schema: map[string]*schema.Schema{
+			"project": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+				Description: `The project in which the session template will be created. If it is not provided, the provider project is used.`,
+			},
+
+			"location": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+				Description: `The region in which the session template will be created. If it is not provided, the provider region is used.`,
+			},
+
+			"session_template_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidateRegexp(`^[^/]+$`),
+				Description:  `The ID to use for the session template, which will become the final component of the session template's resource name.`,
+			},
+
+			"name": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: `The resource name of the session template, in the format projects/{{project}}/locations/{{location}}/sessionTemplates/{{session_template_id}}.`,
+			},
+
+			"description": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: `A description of the session template.`,
+			},
+
+			"labels": {
+				Type: schema.TypeMap,
+				Description: `The labels to associate with this session template.
+
+				**Note**: This field is non-authoritative, and will only manage the labels present in your configuration.
+				Please refer to the field 'effective_labels' for all of the labels present on the resource.`,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+
+			"terraform_labels": {
+				Type:        schema.TypeMap,
+				Computed:    true,
+				Description: `The combination of labels configured directly on the resource and default labels configured on the provider.`,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+
+			"effective_labels": {
+				Type:        schema.TypeMap,
+				Computed:    true,
+				Description: `All of labels (key/value pairs) present on the resource in GCP, including the labels configured through Terraform, other clients and services.`,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+
+			"runtime_config":     dataprocRuntimeConfigSchema(false),
+			"environment_config": dataprocEnvironmentConfigSchema(false, "idle_ttl"),
+
+			"jupyter_session": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Description: `Jupyter session config.`,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"kernel": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringInSlice([]string{"PYTHON", "SCALA"}, false),
+							Description:  `Kernel to be used with Jupyter interactive session. Possible values are: PYTHON, SCALA.`,
+						},
+						"display_name": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: `Display name, shown in the Jupyter kernelspec card.`,
+						},
+					},
+				},
+			},
+
+			"uuid": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: `A session template UUID (Unique Universal Identifier). The service generates this value when it creates the session template.`,
+			},
+
+			"create_time": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: `The time when the session template was created.`,
+			},
+
+			"update_time": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: `The time when the session template was last updated.`,
+			},
+
+			"creator": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: `The email address of the user who created the session template.`,
+			},
+		},
+		UseJSONNumber: true,
+	}
+}
+
+func expandDataprocSessionTemplate(d *schema.ResourceData, name string) *dataproc.SessionTemplate {
+	tmpl := &dataproc.SessionTemplate{
+		Name:        name,
+		Description: d.Get("description").(string),
+	}
+
+	if _, ok := d.GetOk("effective_labels"); ok {
+		tmpl.Labels = tpgresource.ExpandEffectiveLabels(d)
+	}
+
+	if v, ok := d.GetOk("runtime_config"); ok {
+		tmpl.RuntimeConfig = expandDataprocRuntimeConfig(tpgresource.ExtractFirstMapConfig(v.([]interface{})))
+	}
+
+	if v, ok := d.GetOk("environment_config"); ok {
+		tmpl.EnvironmentConfig = expandDataprocEnvironmentConfig(tpgresource.ExtractFirstMapConfig(v.([]interface{})))
+	}
+
+	if v, ok := d.GetOk("jupyter_session"); ok {
+		cfg := tpgresource.ExtractFirstMapConfig(v.([]interface{}))
+		tmpl.JupyterSession = &dataproc.JupyterConfig{
+			Kernel:      cfg["kernel"].(string),
+			DisplayName: cfg["display_name"].(string),
+		}
+	}
+
+	return tmpl
+}
+
+func resourceDataprocSessionTemplateCreate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*transport_tpg.Config)
+	userAgent, err := tpgresource.GenerateUserAgentString(d, config.UserAgent)
+	if err != nil {
+		return err
+	}
+
+	project, err := tpgresource.GetProject(d, config)
+	if err != nil {
+		return err
+	}
+
+	location, err := tpgresource.GetLocation(d, config)
+	if err != nil {
+		return err
+	}
+
+	parent := fmt.Sprintf("projects/%s/locations/%s", project, location)
+	tmpl := expandDataprocSessionTemplate(d, fmt.Sprintf("%s/sessionTemplates/%s", parent, d.Get("session_template_id").(string)))
+
+	log.Printf("[DEBUG] Creating Dataproc session template %s", tmpl.Name)
+	err = transport_tpg.Retry(transport_tpg.RetryOptions{
+		RetryFunc: func() error {
+			_, err := config.NewDataprocClient(userAgent).Projects.Locations.SessionTemplates.Create(parent, tmpl).Do()
+			return err
+		},
+		Timeout: d.Timeout(schema.TimeoutCreate),
+	})
+	if err != nil {
+		return fmt.Errorf("Error creating Dataproc session template: %s", err)
+	}
+
+	d.SetId(tmpl.Name)
+
+	log.Printf("[INFO] Dataproc session template %s has been created", tmpl.Name)
+	return resourceDataprocSessionTemplateRead(d, meta)
+}
+
+func resourceDataprocSessionTemplateRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*transport_tpg.Config)
+	userAgent, err := tpgresource.GenerateUserAgentString(d, config.UserAgent)
+	if err != nil {
+		return err
+	}
+
+	tmpl, err := config.NewDataprocClient(userAgent).Projects.Locations.SessionTemplates.Get(d.Id()).Do()
+	if err != nil {
+		return transport_tpg.HandleNotFoundError(err, d, fmt.Sprintf("Dataproc Session Template %q", d.Id()))
+	}
+
+	project, location, templateId, err := parseDataprocSessionTemplateName(tmpl.Name)
+	if err != nil {
+		return err
+	}
+	if err := d.Set("project", project); err != nil {
+		return fmt.Errorf("Error setting project: %s", err)
+	}
+	if err := d.Set("location", location); err != nil {
+		return fmt.Errorf("Error setting location: %s", err)
+	}
+	if err := d.Set("session_template_id", templateId); err != nil {
+		return fmt.Errorf("Error setting session_template_id: %s", err)
+	}
+	if err := d.Set("name", tmpl.Name); err != nil {
+		return fmt.Errorf("Error setting name: %s", err)
+	}
+	if err := d.Set("description", tmpl.Description); err != nil {
+		return fmt.Errorf("Error setting description: %s", err)
+	}
+	if err := tpgresource.SetLabels(tmpl.Labels, d, "labels"); err != nil {
+		return fmt.Errorf("Error setting labels: %s", err)
+	}
+	if err := tpgresource.SetLabels(tmpl.Labels, d, "terraform_labels"); err != nil {
+		return fmt.Errorf("Error setting terraform_labels: %s", err)
+	}
+	if err := d.Set("effective_labels", tmpl.Labels); err != nil {
+		return fmt.Errorf("Error setting effective_labels: %s", err)
+	}
+	if err := d.Set("runtime_config", flattenDataprocRuntimeConfig(d, "runtime_config", tmpl.RuntimeConfig)); err != nil {
+		return fmt.Errorf("Error setting runtime_config: %s", err)
+	}
+	if err := d.Set("environment_config", flattenDataprocEnvironmentConfig(tmpl.EnvironmentConfig, "idle_ttl")); err != nil {
+		return fmt.Errorf("Error setting environment_config: %s", err)
+	}
+	var jupyterSession []map[string]interface{}
+	if tmpl.JupyterSession != nil {
+		jupyterSession = []map[string]interface{}{
+			{
+				"kernel":       tmpl.JupyterSession.Kernel,
+				"display_name": tmpl.JupyterSession.DisplayName,
+			},
+		}
+	}
+	if err := d.Set("jupyter_session", jupyterSession); err != nil {
+		return fmt.Errorf("Error setting jupyter_session: %s", err)
+	}
+	if err := d.Set("uuid", tmpl.Uuid); err != nil {
+		return fmt.Errorf("Error setting uuid: %s", err)
+	}
+	if err := d.Set("create_time", tmpl.CreateTime); err != nil {
+		return fmt.Errorf("Error setting create_time: %s", err)
+	}
+	if err := d.Set("update_time", tmpl.UpdateTime); err != nil {
+		return fmt.Errorf("Error setting update_time: %s", err)
+	}
+	if err := d.Set("creator", tmpl.Creator); err != nil {
+		return fmt.Errorf("Error setting creator: %s", err)
+	}
+
+	return nil
+}
+
+func resourceDataprocSessionTemplateUpdate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*transport_tpg.Config)
+	userAgent, err := tpgresource.GenerateUserAgentString(d, config.UserAgent)
+	if err != nil {
+		return err
+	}
+
+	// Patch doesn't take an update mask, the whole template is replaced.
+	tmpl := expandDataprocSessionTemplate(d, d.Id())
+
+	log.Printf("[DEBUG] Updating Dataproc session template %s", d.Id())
+	err = transport_tpg.Retry(transport_tpg.RetryOptions{
+		RetryFunc: func() error {
+			_, err := config.NewDataprocClient(userAgent).Projects.Locations.SessionTemplates.Patch(d.Id(), tmpl).Do()
+			return err
+		},
+		Timeout: d.Timeout(schema.TimeoutUpdate),
+	})
+	if err != nil {
+		return fmt.Errorf("Error updating Dataproc session template %s: %s", d.Id(), err)
+	}
+
+	return resourceDataprocSessionTemplateRead(d, meta)
+}
+
+func resourceDataprocSessionTemplateDelete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*transport_tpg.Config)
+	userAgent, err := tpgresource.GenerateUserAgentString(d, config.UserAgent)
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Deleting Dataproc session template %s", d.Id())
+	err = transport_tpg.Retry(transport_tpg.RetryOptions{
+		RetryFunc: func() error {
+			_, err := config.NewDataprocClient(userAgent).Projects.Locations.SessionTemplates.Delete(d.Id()).Do()
+			return err
+		},
+		Timeout: d.Timeout(schema.TimeoutDelete),
+	})
+	if err != nil {
+		return transport_tpg.HandleNotFoundError(err, d, fmt.Sprintf("Dataproc Session Template %q", d.Id()))
+	}
+
+	log.Printf("[INFO] Dataproc session template %s has been deleted", d.Id())
+	d.SetId("")
+
+	return nil
+}
+
+// parseDataprocSessionTemplateName returns the project, location and session
+// template ID of a session template's resource name, which is also its id.
+func parseDataprocSessionTemplateName(name string) (string, string, string, error) {
+	parts := dataprocSessionTemplateNameRegex.FindStringSubmatch(name)
+	if parts == nil {
+		return "", "", "", fmt.Errorf("Unexpected Dataproc session template name %q, expected projects/{{project}}/locations/{{location}}/sessionTemplates/{{session_template_id}}", name)
+	}
+	return parts[1], parts[2], parts[3], nil
+}
+
+func resourceDataprocSessionTemplateImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	config := meta.(*transport_tpg.Config)
+	if err := tpgresource.ParseImportId([]string{
+		"^projects/(?P<project>[^/]+)/locations/(?P<location>[^/]+)/sessionTemplates/(?P<session_template_id>[^/]+)$",
+		"^(?P<project>[^/]+)/(?P<location>[^/]+)/(?P<session_template_id>[^/]+)$",
+		"^(?P<location>[^/]+)/(?P<session_template_id>[^/]+)$",
+	}, d, config); err != nil {
+		return nil, err
+	}
+
+	// Replace import id for the resource id
+	id, err := tpgresource.ReplaceVars(d, config, "projects/{{project}}/locations/{{location}}/sessionTemplates/{{session_template_id}}")
+	if err != nil {
+		return nil, fmt.Errorf("Error constructing id: %s", err)
+	}
+	d.SetId(id)
+
+	return []*schema.ResourceData{d}, nil
+}
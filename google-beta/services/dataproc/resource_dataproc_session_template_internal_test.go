@@ -0,0 +1,25 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+package dataproc
+
+import (
+	"testing"
+)
+
+func TestParseDataprocSessionTemplateName(t *testing.T) {
+	t.Parallel()
+
+	project, location, templateId, err := parseDataprocSessionTemplateName("projects/my-project/locations/us-central1/sessionTemplates/jupyter")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if project != "my-project" || location != "us-central1" || templateId != "jupyter" {
+		t.Errorf("unexpected parts: %q, %q, %q", project, location, templateId)
+	}
+
+	for _, name := range []string{"", "jupyter", "projects/my-project/locations/us-central1/batches/b"} {
+		if _, _, _, err := parseDataprocSessionTemplateName(name); err == nil {
+			t.Errorf("expected an error for %q", name)
+		}
+	}
+}
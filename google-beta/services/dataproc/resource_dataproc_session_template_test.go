@@ -0,0 +1,113 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+package dataproc_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-google-beta/google-beta/acctest"
+	"github.com/hashicorp/terraform-provider-google-beta/google-beta/envvar"
+	"google.golang.org/api/googleapi"
+)
+
+func TestAccDataprocSessionTemplate_update(t *testing.T) {
+	t.Parallel()
+
+	rnd := acctest.RandString(t, 10)
+	project := envvar.GetTestProjectFromEnv()
+
+	acctest.VcrTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.AccTestPreCheck(t) },
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories(t),
+		CheckDestroy:             testAccCheckDataprocSessionTemplateDestroyProducer(t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataprocSessionTemplate_jupyter(project, rnd, "PYTHON", "3600s"),
+			},
+			{
+				ResourceName:            "google_dataproc_session_template.template",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"labels", "terraform_labels", "runtime_config.0.properties"},
+			},
+			{
+				Config: testAccDataprocSessionTemplate_jupyter(project, rnd, "SCALA", "7200s"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("google_dataproc_session_template.template", "jupyter_session.0.kernel", "SCALA"),
+					resource.TestCheckResourceAttr("google_dataproc_session_template.template", "environment_config.0.execution_config.0.idle_ttl", "7200s"),
+				),
+			},
+			{
+				ResourceName:            "google_dataproc_session_template.template",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"labels", "terraform_labels", "runtime_config.0.properties"},
+			},
+		},
+	})
+}
+
+func testAccCheckDataprocSessionTemplateDestroyProducer(t *testing.T) func(s *terraform.State) error {
+	return func(s *terraform.State) error {
+		config := acctest.GoogleProviderConfig(t)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "google_dataproc_session_template" {
+				continue
+			}
+
+			if rs.Primary.ID == "" {
+				return fmt.Errorf("Unable to verify delete of dataproc session template ID is empty")
+			}
+
+			_, err := config.NewDataprocClient(config.UserAgent).Projects.Locations.SessionTemplates.Get(rs.Primary.ID).Do()
+			if err != nil {
+				if gerr, ok := err.(*googleapi.Error); ok && gerr.Code == 404 {
+					return nil
+				} else if ok {
+					return fmt.Errorf("Error making GCP platform call: http code error : %d, http message error: %s", gerr.Code, gerr.Message)
+				}
+				return fmt.Errorf("Error making GCP platform call: %s", err.Error())
+			}
+			return fmt.Errorf("Dataproc session template still exists")
+		}
+
+		return nil
+	}
+}
+
+func testAccDataprocSessionTemplate_jupyter(project, rnd, kernel, idleTtl string) string {
+	return fmt.Sprintf(`
+resource "google_dataproc_session_template" "template" {
+  project             = "%s"
+  location            = "us-central1"
+  session_template_id = "tf-test-template-%s"
+
+  labels = {
+    session_template_test = "terraform"
+  }
+
+  runtime_config {
+    properties = {
+      "spark.dynamicAllocation.enabled" = "false"
+      "spark.executor.instances"        = "2"
+    }
+  }
+
+  environment_config {
+    execution_config {
+      subnetwork_uri = "default"
+      idle_ttl       = "%s"
+    }
+  }
+
+  jupyter_session {
+    kernel       = "%s"
+    display_name = "tf-test-%s"
+  }
+}
+`, project, rnd, idleTtl, kernel, rnd)
+}
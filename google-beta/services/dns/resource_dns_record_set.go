@@ -3,6 +3,7 @@
 package dns
 
 import (
+	"context"
 	"fmt"
 	"log"
 
@@ -74,6 +75,85 @@ func RrdatasListDiffSuppress(oldList, newList []string, fun func(x string) strin
 	return true
 }
 
+func validateRecordSetHealthCheckedTargets(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	// separate func to allow unit testing
+	return validateRecordSetHealthCheckedTargetsFunc(d)
+}
+
+// recordSetResourceDiff is implemented by *schema.ResourceDiff.
+type recordSetResourceDiff interface {
+	tpgresource.TerraformResourceDiff
+	NewValueKnown(string) bool
+}
+
+// Health checked targets are only supported for A and AAAA records, and
+// regional load balancers must be identified by their region. Values that
+// aren't known until apply, such as ones computed from other resources, are
+// not checked.
+func validateRecordSetHealthCheckedTargetsFunc(d recordSetResourceDiff) error {
+	policies := listOfMaps(d.Get("routing_policy"))
+	if len(policies) == 0 {
+		return nil
+	}
+	policy := policies[0]
+
+	// The keys of the health checked targets, to check whether their values are known
+	var targetKeys []string
+	var targets []map[string]interface{}
+	addTargets := func(prefix string, v interface{}) {
+		for i, target := range listOfMaps(v) {
+			targetKeys = append(targetKeys, fmt.Sprintf("%s.%d", prefix, i))
+			targets = append(targets, target)
+		}
+	}
+	for i, item := range listOfMaps(policy["wrr"]) {
+		addTargets(fmt.Sprintf("routing_policy.0.wrr.%d.health_checked_targets", i), item["health_checked_targets"])
+	}
+	for i, item := range listOfMaps(policy["geo"]) {
+		addTargets(fmt.Sprintf("routing_policy.0.geo.%d.health_checked_targets", i), item["health_checked_targets"])
+	}
+	for _, pb := range listOfMaps(policy["primary_backup"]) {
+		addTargets("routing_policy.0.primary_backup.0.primary", pb["primary"])
+		for i, item := range listOfMaps(pb["backup_geo"]) {
+			addTargets(fmt.Sprintf("routing_policy.0.primary_backup.0.backup_geo.%d.health_checked_targets", i), item["health_checked_targets"])
+		}
+	}
+	if len(targets) == 0 {
+		return nil
+	}
+
+	if d.NewValueKnown("type") {
+		if rType, _ := d.Get("type").(string); rType != "A" && rType != "AAAA" {
+			return fmt.Errorf("health checked targets are only supported for A and AAAA record sets, got %s", rType)
+		}
+	}
+	for i, target := range targets {
+		for j, lb := range listOfMaps(target["internal_load_balancers"]) {
+			key := fmt.Sprintf("%s.internal_load_balancers.%d", targetKeys[i], j)
+			if !d.NewValueKnown(key+".load_balancer_type") || !d.NewValueKnown(key+".region") {
+				continue
+			}
+			lbType, _ := lb["load_balancer_type"].(string)
+			region, _ := lb["region"].(string)
+			if strings.HasPrefix(lbType, "regional") && region == "" {
+				return fmt.Errorf("region must be set for internal load balancer %s of type %s", lb["ip_address"], lbType)
+			}
+		}
+	}
+	return nil
+}
+
+func listOfMaps(v interface{}) []map[string]interface{} {
+	l, _ := v.([]interface{})
+	result := make([]map[string]interface{}, 0, len(l))
+	for _, raw := range l {
+		if m, ok := raw.(map[string]interface{}); ok {
+			result = append(result, m)
+		}
+	}
+	return result
+}
+
 func ResourceDnsRecordSet() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceDnsRecordSetCreate,
@@ -85,6 +165,7 @@ func ResourceDnsRecordSet() *schema.Resource {
 		},
 
 		CustomizeDiff: customdiff.All(
+			validateRecordSetHealthCheckedTargets,
 			tpgresource.DefaultProviderProject,
 		),
 
@@ -144,7 +225,7 @@ func ResourceDnsRecordSet() *schema.Resource {
 									"health_checked_targets": {
 										Type:        schema.TypeList,
 										Optional:    true,
-										Description: "The list of targets to be health checked. Note that if DNSSEC is enabled for this zone, only one of `rrdatas` or `health_checked_targets` can be set.",
+										Description: "For A and AAAA types only. The list of targets to be health checked. Note that if DNSSEC is enabled for this zone, only one of `rrdatas` or `health_checked_targets` can be set.",
 										MaxItems:    1,
 										Elem:        healthCheckedTargetSchema,
 									},
@@ -192,9 +273,10 @@ func ResourceDnsRecordSet() *schema.Resource {
 										Description: "Specifies whether to enable fencing for backup geo queries.",
 									},
 									"trickle_ratio": {
-										Type:        schema.TypeFloat,
-										Optional:    true,
-										Description: "Specifies the percentage of traffic to send to the backup targets even when the primary targets are healthy.",
+										Type:         schema.TypeFloat,
+										Optional:     true,
+										ValidateFunc: validation.FloatBetween(0, 1),
+										Description:  "Specifies the percentage of traffic to send to the backup targets even when the primary targets are healthy, as a ratio between 0 and 1.",
 									},
 								},
 							},
@@ -298,7 +380,7 @@ var healthCheckedTargetSchema *schema.Resource = &schema.Resource{
 					"region": {
 						Type:        schema.TypeString,
 						Optional:    true,
-						Description: "The region of the load balancer. Only needed for regional load balancers.",
+						Description: "The region of the load balancer. Required for regional load balancers.",
 					},
 				},
 			},
@@ -5,6 +5,7 @@ package dns
 import (
 	"testing"
 
+	"github.com/hashicorp/terraform-provider-google-beta/google-beta/tpgresource"
 	"github.com/hashicorp/terraform-provider-google-beta/google-beta/verify"
 )
 
@@ -23,3 +24,139 @@ func TestValidateRecordNameTrailingDot(t *testing.T) {
 		t.Errorf("Failed to validate DNS Record name with value: %v", es)
 	}
 }
+
+// recordSetResourceDiffMock is a ResourceDiffMock whose keys in Unknown aren't
+// known until apply.
+type recordSetResourceDiffMock struct {
+	*tpgresource.ResourceDiffMock
+	Unknown map[string]bool
+}
+
+func (d *recordSetResourceDiffMock) NewValueKnown(key string) bool {
+	return !d.Unknown[key]
+}
+
+func TestValidateRecordSetHealthCheckedTargets(t *testing.T) {
+	t.Parallel()
+
+	target := func(lbType, region string) []interface{} {
+		return []interface{}{
+			map[string]interface{}{
+				"internal_load_balancers": []interface{}{
+					map[string]interface{}{
+						"load_balancer_type": lbType,
+						"ip_address":         "10.128.1.1",
+						"region":             region,
+					},
+				},
+			},
+		}
+	}
+
+	cases := map[string]struct {
+		Type          string
+		RoutingPolicy map[string]interface{}
+		Unknown       map[string]bool
+		ExpectError   bool
+	}{
+		"no routing policy": {
+			Type: "CNAME",
+		},
+		"wrr without health checked targets": {
+			Type: "TXT",
+			RoutingPolicy: map[string]interface{}{
+				"wrr": []interface{}{
+					map[string]interface{}{"weight": 1.0, "rrdatas": []interface{}{"foo"}},
+				},
+			},
+		},
+		"primary backup with regional and global targets": {
+			Type: "A",
+			RoutingPolicy: map[string]interface{}{
+				"primary_backup": []interface{}{
+					map[string]interface{}{
+						"primary": target("globalL7ilb", ""),
+						"backup_geo": []interface{}{
+							map[string]interface{}{"location": "us-west1", "health_checked_targets": target("regionalL4ilb", "us-west1")},
+						},
+					},
+				},
+			},
+		},
+		"geo target on CNAME record": {
+			Type: "CNAME",
+			RoutingPolicy: map[string]interface{}{
+				"geo": []interface{}{
+					map[string]interface{}{"location": "us-central1", "health_checked_targets": target("regionalL4ilb", "us-central1")},
+				},
+			},
+			ExpectError: true,
+		},
+		"regional wrr target without region": {
+			Type: "AAAA",
+			RoutingPolicy: map[string]interface{}{
+				"wrr": []interface{}{
+					map[string]interface{}{"weight": 1.0, "health_checked_targets": target("regionalL7ilb", "")},
+				},
+			},
+			ExpectError: true,
+		},
+		"geo target with unknown type": {
+			Type: "",
+			RoutingPolicy: map[string]interface{}{
+				"geo": []interface{}{
+					map[string]interface{}{"location": "us-central1", "health_checked_targets": target("regionalL4ilb", "us-central1")},
+				},
+			},
+			Unknown: map[string]bool{"type": true},
+		},
+		"regional wrr target with unknown region": {
+			Type: "A",
+			RoutingPolicy: map[string]interface{}{
+				"wrr": []interface{}{
+					map[string]interface{}{"weight": 1.0, "health_checked_targets": target("regionalL4ilb", "us-central1")},
+					map[string]interface{}{"weight": 1.0, "health_checked_targets": target("regionalL7ilb", "")},
+				},
+			},
+			Unknown: map[string]bool{"routing_policy.0.wrr.1.health_checked_targets.0.internal_load_balancers.0.region": true},
+		},
+		"regional backup target without region": {
+			Type: "A",
+			RoutingPolicy: map[string]interface{}{
+				"primary_backup": []interface{}{
+					map[string]interface{}{
+						"primary": target("regionalL4ilb", "us-central1"),
+						"backup_geo": []interface{}{
+							map[string]interface{}{"location": "us-west1", "health_checked_targets": target("regionalL4ilb", "")},
+						},
+					},
+				},
+			},
+			ExpectError: true,
+		},
+	}
+
+	for tn, tc := range cases {
+		routingPolicy := []interface{}{}
+		if tc.RoutingPolicy != nil {
+			routingPolicy = append(routingPolicy, tc.RoutingPolicy)
+		}
+		d := &recordSetResourceDiffMock{
+			ResourceDiffMock: &tpgresource.ResourceDiffMock{
+				After: map[string]interface{}{
+					"type":           tc.Type,
+					"routing_policy": routingPolicy,
+				},
+			},
+			Unknown: tc.Unknown,
+		}
+
+		err := validateRecordSetHealthCheckedTargetsFunc(d)
+		if tc.ExpectError && err == nil {
+			t.Errorf("%s: expected an error", tn)
+		}
+		if !tc.ExpectError && err != nil {
+			t.Errorf("%s: unexpected error: %s", tn, err)
+		}
+	}
+}
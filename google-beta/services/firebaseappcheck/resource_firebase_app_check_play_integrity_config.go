@@ -29,6 +29,7 @@ import (
 
 	"github.com/hashicorp/terraform-provider-google-beta/google-beta/tpgresource"
 	transport_tpg "github.com/hashicorp/terraform-provider-google-beta/google-beta/transport"
+	"github.com/hashicorp/terraform-provider-google-beta/google-beta/verify"
 )
 
 func ResourceFirebaseAppCheckPlayIntegrityConfig() *schema.Resource {
@@ -60,6 +61,60 @@ func ResourceFirebaseAppCheckPlayIntegrityConfig() *schema.Resource {
 				Description: `The ID of an
 [Android App](https://firebase.google.com/docs/reference/firebase-management/rest/v1beta1/projects.androidApps#AndroidApp.FIELDS.app_id).`,
 			},
+			"account_details": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Optional:    true,
+				Description: `Specifies account requirements for Android devices running your app. These settings correspond to requirements on the account details field obtained from the Play Integrity API.`,
+				MaxItems:    1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"require_licensed": {
+							Type:        schema.TypeBool,
+							Required:    true,
+							Description: `If set to true, apps must be licensed, i.e. the user must have installed or updated the app from Google Play. Defaults to false.`,
+						},
+					},
+				},
+			},
+			"app_integrity": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Optional:    true,
+				Description: `Specifies application integrity requirements for Android devices running your app. These settings correspond to requirements on the application integrity field obtained from the Play Integrity API.`,
+				MaxItems:    1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"allow_unrecognized_version": {
+							Type:        schema.TypeBool,
+							Required:    true,
+							Description: `If set to true, apps that aren't recognized by Google Play, such as sideloaded apps or apps installed from other app stores, are allowed. Defaults to false.`,
+						},
+					},
+				},
+			},
+			"device_integrity": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Optional:    true,
+				Description: `Specifies device integrity requirements for Android devices running your app. These settings correspond to requirements on the device integrity field obtained from the Play Integrity API.`,
+				MaxItems:    1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"min_device_recognition_level": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: verify.ValidateEnum([]string{"NO_INTEGRITY", "MEETS_BASIC_INTEGRITY", "MEETS_DEVICE_INTEGRITY", "MEETS_STRONG_INTEGRITY"}),
+							Description: `The minimum level of device integrity required for App Check to issue tokens.
+Possible values are:
+* 'NO_INTEGRITY': Any device is allowed, including emulators and compromised devices.
+* 'MEETS_BASIC_INTEGRITY': The device must pass basic system integrity checks, but it may be rooted or running an unrecognized version of Android.
+* 'MEETS_DEVICE_INTEGRITY': The device must be a genuine Android device with Google Play services.
+* 'MEETS_STRONG_INTEGRITY': The device must also have a recent security update. Defaults to 'MEETS_DEVICE_INTEGRITY'. Possible values: ["NO_INTEGRITY", "MEETS_BASIC_INTEGRITY", "MEETS_DEVICE_INTEGRITY", "MEETS_STRONG_INTEGRITY"]`,
+						},
+					},
+				},
+			},
 			"token_ttl": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -93,6 +148,24 @@ func resourceFirebaseAppCheckPlayIntegrityConfigCreate(d *schema.ResourceData, m
 	}
 
 	obj := make(map[string]interface{})
+	accountDetailsProp, err := expandFirebaseAppCheckPlayIntegrityConfigAccountDetails(d.Get("account_details"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("account_details"); !tpgresource.IsEmptyValue(reflect.ValueOf(accountDetailsProp)) && (ok || !reflect.DeepEqual(v, accountDetailsProp)) {
+		obj["accountDetails"] = accountDetailsProp
+	}
+	appIntegrityProp, err := expandFirebaseAppCheckPlayIntegrityConfigAppIntegrity(d.Get("app_integrity"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("app_integrity"); !tpgresource.IsEmptyValue(reflect.ValueOf(appIntegrityProp)) && (ok || !reflect.DeepEqual(v, appIntegrityProp)) {
+		obj["appIntegrity"] = appIntegrityProp
+	}
+	deviceIntegrityProp, err := expandFirebaseAppCheckPlayIntegrityConfigDeviceIntegrity(d.Get("device_integrity"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("device_integrity"); !tpgresource.IsEmptyValue(reflect.ValueOf(deviceIntegrityProp)) && (ok || !reflect.DeepEqual(v, deviceIntegrityProp)) {
+		obj["deviceIntegrity"] = deviceIntegrityProp
+	}
 	tokenTtlProp, err := expandFirebaseAppCheckPlayIntegrityConfigTokenTtl(d.Get("token_ttl"), d, config)
 	if err != nil {
 		return err
@@ -100,7 +173,7 @@ func resourceFirebaseAppCheckPlayIntegrityConfigCreate(d *schema.ResourceData, m
 		obj["tokenTtl"] = tokenTtlProp
 	}
 
-	url, err := tpgresource.ReplaceVars(d, config, "{{FirebaseAppCheckBasePath}}projects/{{project}}/apps/{{app_id}}/playIntegrityConfig?updateMask=tokenTtl")
+	url, err := tpgresource.ReplaceVars(d, config, "{{FirebaseAppCheckBasePath}}projects/{{project}}/apps/{{app_id}}/playIntegrityConfig?updateMask=accountDetails,appIntegrity,deviceIntegrity,tokenTtl")
 	if err != nil {
 		return err
 	}
@@ -190,6 +263,15 @@ func resourceFirebaseAppCheckPlayIntegrityConfigRead(d *schema.ResourceData, met
 	if err := d.Set("name", flattenFirebaseAppCheckPlayIntegrityConfigName(res["name"], d, config)); err != nil {
 		return fmt.Errorf("Error reading PlayIntegrityConfig: %s", err)
 	}
+	if err := d.Set("account_details", flattenFirebaseAppCheckPlayIntegrityConfigAccountDetails(res["accountDetails"], d, config)); err != nil {
+		return fmt.Errorf("Error reading PlayIntegrityConfig: %s", err)
+	}
+	if err := d.Set("app_integrity", flattenFirebaseAppCheckPlayIntegrityConfigAppIntegrity(res["appIntegrity"], d, config)); err != nil {
+		return fmt.Errorf("Error reading PlayIntegrityConfig: %s", err)
+	}
+	if err := d.Set("device_integrity", flattenFirebaseAppCheckPlayIntegrityConfigDeviceIntegrity(res["deviceIntegrity"], d, config)); err != nil {
+		return fmt.Errorf("Error reading PlayIntegrityConfig: %s", err)
+	}
 	if err := d.Set("token_ttl", flattenFirebaseAppCheckPlayIntegrityConfigTokenTtl(res["tokenTtl"], d, config)); err != nil {
 		return fmt.Errorf("Error reading PlayIntegrityConfig: %s", err)
 	}
@@ -213,6 +295,24 @@ func resourceFirebaseAppCheckPlayIntegrityConfigUpdate(d *schema.ResourceData, m
 	billingProject = project
 
 	obj := make(map[string]interface{})
+	accountDetailsProp, err := expandFirebaseAppCheckPlayIntegrityConfigAccountDetails(d.Get("account_details"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("account_details"); !tpgresource.IsEmptyValue(reflect.ValueOf(v)) && (ok || !reflect.DeepEqual(v, accountDetailsProp)) {
+		obj["accountDetails"] = accountDetailsProp
+	}
+	appIntegrityProp, err := expandFirebaseAppCheckPlayIntegrityConfigAppIntegrity(d.Get("app_integrity"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("app_integrity"); !tpgresource.IsEmptyValue(reflect.ValueOf(v)) && (ok || !reflect.DeepEqual(v, appIntegrityProp)) {
+		obj["appIntegrity"] = appIntegrityProp
+	}
+	deviceIntegrityProp, err := expandFirebaseAppCheckPlayIntegrityConfigDeviceIntegrity(d.Get("device_integrity"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("device_integrity"); !tpgresource.IsEmptyValue(reflect.ValueOf(v)) && (ok || !reflect.DeepEqual(v, deviceIntegrityProp)) {
+		obj["deviceIntegrity"] = deviceIntegrityProp
+	}
 	tokenTtlProp, err := expandFirebaseAppCheckPlayIntegrityConfigTokenTtl(d.Get("token_ttl"), d, config)
 	if err != nil {
 		return err
@@ -228,6 +328,18 @@ func resourceFirebaseAppCheckPlayIntegrityConfigUpdate(d *schema.ResourceData, m
 	log.Printf("[DEBUG] Updating PlayIntegrityConfig %q: %#v", d.Id(), obj)
 	updateMask := []string{}
 
+	if d.HasChange("account_details") {
+		updateMask = append(updateMask, "accountDetails")
+	}
+
+	if d.HasChange("app_integrity") {
+		updateMask = append(updateMask, "appIntegrity")
+	}
+
+	if d.HasChange("device_integrity") {
+		updateMask = append(updateMask, "deviceIntegrity")
+	}
+
 	if d.HasChange("token_ttl") {
 		updateMask = append(updateMask, "tokenTtl")
 	}
@@ -299,10 +411,130 @@ func flattenFirebaseAppCheckPlayIntegrityConfigName(v interface{}, d *schema.Res
 	return v
 }
 
+func flattenFirebaseAppCheckPlayIntegrityConfigAccountDetails(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
+	if v == nil {
+		return nil
+	}
+	original := v.(map[string]interface{})
+	if len(original) == 0 {
+		return nil
+	}
+	transformed := make(map[string]interface{})
+	transformed["require_licensed"] =
+		flattenFirebaseAppCheckPlayIntegrityConfigAccountDetailsRequireLicensed(original["requireLicensed"], d, config)
+	return []interface{}{transformed}
+}
+func flattenFirebaseAppCheckPlayIntegrityConfigAccountDetailsRequireLicensed(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
+	return v
+}
+
+func flattenFirebaseAppCheckPlayIntegrityConfigAppIntegrity(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
+	if v == nil {
+		return nil
+	}
+	original := v.(map[string]interface{})
+	if len(original) == 0 {
+		return nil
+	}
+	transformed := make(map[string]interface{})
+	transformed["allow_unrecognized_version"] =
+		flattenFirebaseAppCheckPlayIntegrityConfigAppIntegrityAllowUnrecognizedVersion(original["allowUnrecognizedVersion"], d, config)
+	return []interface{}{transformed}
+}
+func flattenFirebaseAppCheckPlayIntegrityConfigAppIntegrityAllowUnrecognizedVersion(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
+	return v
+}
+
+func flattenFirebaseAppCheckPlayIntegrityConfigDeviceIntegrity(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
+	if v == nil {
+		return nil
+	}
+	original := v.(map[string]interface{})
+	if len(original) == 0 {
+		return nil
+	}
+	transformed := make(map[string]interface{})
+	transformed["min_device_recognition_level"] =
+		flattenFirebaseAppCheckPlayIntegrityConfigDeviceIntegrityMinDeviceRecognitionLevel(original["minDeviceRecognitionLevel"], d, config)
+	return []interface{}{transformed}
+}
+func flattenFirebaseAppCheckPlayIntegrityConfigDeviceIntegrityMinDeviceRecognitionLevel(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
+	return v
+}
+
 func flattenFirebaseAppCheckPlayIntegrityConfigTokenTtl(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
 	return v
 }
 
+func expandFirebaseAppCheckPlayIntegrityConfigAccountDetails(v interface{}, d tpgresource.TerraformResourceData, config *transport_tpg.Config) (interface{}, error) {
+	l := v.([]interface{})
+	if len(l) == 0 || l[0] == nil {
+		return nil, nil
+	}
+	raw := l[0]
+	original := raw.(map[string]interface{})
+	transformed := make(map[string]interface{})
+
+	transformedRequireLicensed, err := expandFirebaseAppCheckPlayIntegrityConfigAccountDetailsRequireLicensed(original["require_licensed"], d, config)
+	if err != nil {
+		return nil, err
+	} else {
+		transformed["requireLicensed"] = transformedRequireLicensed
+	}
+
+	return transformed, nil
+}
+
+func expandFirebaseAppCheckPlayIntegrityConfigAccountDetailsRequireLicensed(v interface{}, d tpgresource.TerraformResourceData, config *transport_tpg.Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandFirebaseAppCheckPlayIntegrityConfigAppIntegrity(v interface{}, d tpgresource.TerraformResourceData, config *transport_tpg.Config) (interface{}, error) {
+	l := v.([]interface{})
+	if len(l) == 0 || l[0] == nil {
+		return nil, nil
+	}
+	raw := l[0]
+	original := raw.(map[string]interface{})
+	transformed := make(map[string]interface{})
+
+	transformedAllowUnrecognizedVersion, err := expandFirebaseAppCheckPlayIntegrityConfigAppIntegrityAllowUnrecognizedVersion(original["allow_unrecognized_version"], d, config)
+	if err != nil {
+		return nil, err
+	} else {
+		transformed["allowUnrecognizedVersion"] = transformedAllowUnrecognizedVersion
+	}
+
+	return transformed, nil
+}
+
+func expandFirebaseAppCheckPlayIntegrityConfigAppIntegrityAllowUnrecognizedVersion(v interface{}, d tpgresource.TerraformResourceData, config *transport_tpg.Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandFirebaseAppCheckPlayIntegrityConfigDeviceIntegrity(v interface{}, d tpgresource.TerraformResourceData, config *transport_tpg.Config) (interface{}, error) {
+	l := v.([]interface{})
+	if len(l) == 0 || l[0] == nil {
+		return nil, nil
+	}
+	raw := l[0]
+	original := raw.(map[string]interface{})
+	transformed := make(map[string]interface{})
+
+	transformedMinDeviceRecognitionLevel, err := expandFirebaseAppCheckPlayIntegrityConfigDeviceIntegrityMinDeviceRecognitionLevel(original["min_device_recognition_level"], d, config)
+	if err != nil {
+		return nil, err
+	} else {
+		transformed["minDeviceRecognitionLevel"] = transformedMinDeviceRecognitionLevel
+	}
+
+	return transformed, nil
+}
+
+func expandFirebaseAppCheckPlayIntegrityConfigDeviceIntegrityMinDeviceRecognitionLevel(v interface{}, d tpgresource.TerraformResourceData, config *transport_tpg.Config) (interface{}, error) {
+	return v, nil
+}
+
 func expandFirebaseAppCheckPlayIntegrityConfigTokenTtl(v interface{}, d tpgresource.TerraformResourceData, config *transport_tpg.Config) (interface{}, error) {
 	return v, nil
 }
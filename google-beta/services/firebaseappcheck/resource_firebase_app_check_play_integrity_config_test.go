@@ -46,6 +46,20 @@ func TestAccFirebaseAppCheckPlayIntegrityConfig_firebaseAppCheckPlayIntegrityCon
 				ImportStateVerify:       true,
 				ImportStateVerifyIgnore: []string{"app_id"},
 			},
+			{
+				Config: testAccFirebaseAppCheckPlayIntegrityConfig_verdictRequirements(context),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("google_firebase_app_check_play_integrity_config.default", "account_details.0.require_licensed", "true"),
+					resource.TestCheckResourceAttr("google_firebase_app_check_play_integrity_config.default", "app_integrity.0.allow_unrecognized_version", "true"),
+					resource.TestCheckResourceAttr("google_firebase_app_check_play_integrity_config.default", "device_integrity.0.min_device_recognition_level", "MEETS_STRONG_INTEGRITY"),
+				),
+			},
+			{
+				ResourceName:            "google_firebase_app_check_play_integrity_config.default",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"app_id"},
+			},
 			{
 				Config: testAccFirebaseAppCheckPlayIntegrityConfig_firebaseAppCheckPlayIntegrityConfigMinimalExample(context),
 			},
@@ -58,3 +72,44 @@ func TestAccFirebaseAppCheckPlayIntegrityConfig_firebaseAppCheckPlayIntegrityCon
 		},
 	})
 }
+
+func testAccFirebaseAppCheckPlayIntegrityConfig_verdictRequirements(context map[string]interface{}) string {
+	return acctest.Nprintf(`
+resource "google_firebase_android_app" "default" {
+  provider = google-beta
+
+  project       = "%{project_id}"
+  display_name  = "Play Integrity app"
+  package_name  = "package.name.playintegrity%{random_suffix}"
+  sha1_hashes   = ["2145bdf698b8715039bd0e83f2069bed435ac21c"]
+  sha256_hashes = ["2145bdf698b8715039bd0e83f2069bed435ac21ca1b2c3d4e5f6123456789abc"]
+}
+
+resource "time_sleep" "wait_30s" {
+  depends_on      = [google_firebase_android_app.default]
+  create_duration = "30s"
+}
+
+resource "google_firebase_app_check_play_integrity_config" "default" {
+  provider = google-beta
+
+  project   = "%{project_id}"
+  app_id    = google_firebase_android_app.default.app_id
+  token_ttl = "%{token_ttl}"
+
+  account_details {
+    require_licensed = true
+  }
+
+  app_integrity {
+    allow_unrecognized_version = true
+  }
+
+  device_integrity {
+    min_device_recognition_level = "MEETS_STRONG_INTEGRITY"
+  }
+
+  depends_on = [time_sleep.wait_30s]
+}
+`, context)
+}
@@ -0,0 +1,117 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+package iap_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	"github.com/hashicorp/terraform-provider-google-beta/google-beta/acctest"
+)
+
+func TestAccIapSettings_update(t *testing.T) {
+	t.Parallel()
+
+	context := map[string]interface{}{
+		"random_suffix": acctest.RandString(t, 10),
+	}
+
+	acctest.VcrTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.AccTestPreCheck(t) },
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories(t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccIapSettings_basic(context),
+			},
+			{
+				ResourceName:      "google_iap_settings.iap_settings",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccIapSettings_update(context),
+			},
+			{
+				ResourceName:      "google_iap_settings.iap_settings",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccIapSettings_backendService(context map[string]interface{}) string {
+	return acctest.Nprintf(`
+data "google_project" "project" {
+}
+
+resource "google_compute_health_check" "default" {
+  name = "tf-test-iap-hc%{random_suffix}"
+
+  http_health_check {
+    port = 80
+  }
+}
+
+resource "google_compute_backend_service" "default" {
+  name                  = "tf-test-iap-bs%{random_suffix}"
+  health_checks         = [google_compute_health_check.default.id]
+  load_balancing_scheme = "EXTERNAL_MANAGED"
+}
+`, context)
+}
+
+func testAccIapSettings_basic(context map[string]interface{}) string {
+	return testAccIapSettings_backendService(context) + acctest.Nprintf(`
+resource "google_iap_settings" "iap_settings" {
+  name = "projects/${data.google_project.project.number}/iap_web/compute/services/${google_compute_backend_service.default.name}"
+
+  access_settings {
+    cors_settings {
+      allow_http_options = true
+    }
+    reauth_settings {
+      method      = "SECURE_KEY"
+      max_age     = "305s"
+      policy_type = "MINIMUM"
+    }
+  }
+  application_settings {
+    cookie_domain = "test.abc.com"
+    csm_settings {
+      rctoken_aud = "test-aud-set"
+    }
+  }
+}
+`, context)
+}
+
+func testAccIapSettings_update(context map[string]interface{}) string {
+	return testAccIapSettings_backendService(context) + acctest.Nprintf(`
+resource "google_iap_settings" "iap_settings" {
+  name = "projects/${data.google_project.project.number}/iap_web/compute/services/${google_compute_backend_service.default.name}"
+
+  access_settings {
+    oauth_settings {
+      login_hint = "test"
+    }
+    allowed_domains_settings {
+      domains = ["test.abc.com"]
+      enable  = true
+    }
+  }
+  application_settings {
+    access_denied_page_settings {
+      access_denied_page_uri       = "test.com"
+      generate_troubleshooting_uri = true
+    }
+    attribute_propagation_settings {
+      output_credentials = ["HEADER"]
+      expression         = "attributes.saml_attributes.filter(attribute, attribute.name in [\"test1\", \"test2\"])"
+      enable             = false
+    }
+  }
+}
+`, context)
+}
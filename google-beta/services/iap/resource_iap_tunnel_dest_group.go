@@ -21,6 +21,7 @@ import (
 	"fmt"
 	"log"
 	"reflect"
+	"strings"
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
@@ -79,7 +80,7 @@ func ResourceIapTunnelDestGroup() *schema.Resource {
 				Computed:    true,
 				Optional:    true,
 				ForceNew:    true,
-				Description: `The region of the tunnel group. Must be the same as the network resources in the group.`,
+				Description: `The region of the tunnel group. Must be the same as the network resources in the group. If it is not provided, the provider region is used.`,
 			},
 			"name": {
 				Type:        schema.TypeString,
@@ -208,6 +209,9 @@ func resourceIapTunnelDestGroupRead(d *schema.ResourceData, meta interface{}) er
 	if err := d.Set("name", flattenIapTunnelDestGroupName(res["name"], d, config)); err != nil {
 		return fmt.Errorf("Error reading TunnelDestGroup: %s", err)
 	}
+	if err := d.Set("region", flattenIapTunnelDestGroupRegion(res["name"], d, config)); err != nil {
+		return fmt.Errorf("Error reading TunnelDestGroup: %s", err)
+	}
 	if err := d.Set("cidrs", flattenIapTunnelDestGroupCidrs(res["cidrs"], d, config)); err != nil {
 		return fmt.Errorf("Error reading TunnelDestGroup: %s", err)
 	}
@@ -350,6 +354,21 @@ func flattenIapTunnelDestGroupName(v interface{}, d *schema.ResourceData, config
 	return v
 }
 
+// The region falls back to the provider region when it is not configured, so
+// read it from the name to expose the region the group was created in.
+func flattenIapTunnelDestGroupRegion(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
+	if v == nil {
+		return d.Get("region")
+	}
+	parts := strings.Split(v.(string), "/")
+	for i := 0; i < len(parts)-1; i++ {
+		if parts[i] == "locations" {
+			return parts[i+1]
+		}
+	}
+	return d.Get("region")
+}
+
 func flattenIapTunnelDestGroupCidrs(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
 	return v
 }
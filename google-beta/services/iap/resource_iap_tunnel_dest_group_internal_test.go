@@ -0,0 +1,44 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+package iap
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-google-beta/google-beta/tpgresource"
+	transport_tpg "github.com/hashicorp/terraform-provider-google-beta/google-beta/transport"
+)
+
+func TestFlattenIapTunnelDestGroupRegion(t *testing.T) {
+	t.Parallel()
+
+	d := tpgresource.SetupTestResourceDataFromConfigMap(t, ResourceIapTunnelDestGroup().Schema, map[string]interface{}{
+		"region": "us-east1",
+	})
+	config := &transport_tpg.Config{}
+
+	cases := map[string]struct {
+		name     interface{}
+		expected string
+	}{
+		"name": {
+			name:     "projects/123456789/iap_tunnel/locations/us-central1/destGroups/testgroup",
+			expected: "us-central1",
+		},
+		"missing name keeps configured region": {
+			name:     nil,
+			expected: "us-east1",
+		},
+		"unexpected name keeps configured region": {
+			name:     "testgroup",
+			expected: "us-east1",
+		},
+	}
+
+	for tn, tc := range cases {
+		got := flattenIapTunnelDestGroupRegion(tc.name, d, config)
+		if got != tc.expected {
+			t.Errorf("%s: expected %q, got %q", tn, tc.expected, got)
+		}
+	}
+}
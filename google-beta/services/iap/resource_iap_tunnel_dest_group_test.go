@@ -8,6 +8,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 
 	"github.com/hashicorp/terraform-provider-google-beta/google-beta/acctest"
+	"github.com/hashicorp/terraform-provider-google-beta/google-beta/envvar"
 )
 
 func TestAccIapTunnelDestGroup_updates(t *testing.T) {
@@ -33,7 +34,7 @@ func TestAccIapTunnelDestGroup_updates(t *testing.T) {
 				ResourceName:            "google_iap_tunnel_dest_group.dest_group",
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"region", "group_name"},
+				ImportStateVerifyIgnore: []string{"group_name"},
 			},
 			{
 				Config: testAccIapTunnelDestGroup_updated(context),
@@ -42,7 +43,7 @@ func TestAccIapTunnelDestGroup_updates(t *testing.T) {
 				ResourceName:            "google_iap_tunnel_dest_group.dest_group",
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"region", "group_name"},
+				ImportStateVerifyIgnore: []string{"group_name"},
 			},
 			{
 				Config: testAccIapTunnelDestGroup_updated_fqdns(context),
@@ -51,7 +52,35 @@ func TestAccIapTunnelDestGroup_updates(t *testing.T) {
 				ResourceName:            "google_iap_tunnel_dest_group.dest_group",
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"region", "group_name"},
+				ImportStateVerifyIgnore: []string{"group_name"},
+			},
+		},
+	})
+}
+
+func TestAccIapTunnelDestGroup_providerRegion(t *testing.T) {
+	t.Parallel()
+
+	context := map[string]interface{}{
+		"random_suffix": acctest.RandString(t, 10),
+	}
+
+	acctest.VcrTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.AccTestPreCheck(t) },
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories(t),
+		CheckDestroy:             testAccCheckIapTunnelDestGroupDestroyProducer(t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccIapTunnelDestGroup_providerRegion(context),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("google_iap_tunnel_dest_group.dest_group", "region", envvar.GetTestRegionFromEnv()),
+				),
+			},
+			{
+				ResourceName:            "google_iap_tunnel_dest_group.dest_group",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"group_name"},
 			},
 		},
 	})
@@ -94,3 +123,14 @@ resource "google_iap_tunnel_dest_group" "dest_group" {
 }
 `, context)
 }
+
+func testAccIapTunnelDestGroup_providerRegion(context map[string]interface{}) string {
+	return acctest.Nprintf(`
+resource "google_iap_tunnel_dest_group" "dest_group" {
+  group_name = "testgroup%{random_suffix}"
+  cidrs = [
+    "10.1.0.0/16",
+  ]
+}
+`, context)
+}
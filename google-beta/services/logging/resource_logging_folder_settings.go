@@ -66,10 +66,11 @@ func ResourceLoggingFolderSettings() *schema.Resource {
 				Description: `The resource name for the configured Cloud KMS key.`,
 			},
 			"storage_location": {
-				Type:        schema.TypeString,
-				Computed:    true,
-				Optional:    true,
-				Description: `The storage location that Cloud Logging will use to create new resources when a location is needed but not explicitly provided.`,
+				Type:             schema.TypeString,
+				Computed:         true,
+				Optional:         true,
+				DiffSuppressFunc: tpgresource.CompareLocations,
+				Description:      `The storage location that Cloud Logging will use to create new resources when a location is needed but not explicitly provided.`,
 			},
 			"kms_service_account_id": {
 				Type:        schema.TypeString,
@@ -0,0 +1,124 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+package monitoring
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-google-beta/google-beta/tpgresource"
+	transport_tpg "github.com/hashicorp/terraform-provider-google-beta/google-beta/transport"
+)
+
+func DataSourceMonitoringMetricsScope() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceMonitoringMetricsScopeRead,
+
+		Schema: map[string]*schema.Schema{
+			"metrics_scope": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: `The ID or number of the scoping project, or the resource name of the Metrics Scope. Example: locations/global/metricsScopes/{SCOPING_PROJECT_ID_OR_NUMBER}`,
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: `The resource name of the Metrics Scope, containing the project number of the scoping project.`,
+			},
+			"create_time": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: `The time when this Metrics Scope was created.`,
+			},
+			"update_time": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: `The time when this Metrics Scope record was last updated.`,
+			},
+			"monitored_projects": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: `The projects monitored by this Metrics Scope, other than the scoping project.`,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: `The resource name of the monitored project, containing project numbers. Example: locations/global/metricsScopes/{SCOPING_PROJECT_NUMBER}/projects/{MONITORED_PROJECT_NUMBER}`,
+						},
+						"create_time": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: `The time when the project was added to the Metrics Scope.`,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceMonitoringMetricsScopeRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*transport_tpg.Config)
+	userAgent, err := tpgresource.GenerateUserAgentString(d, config.UserAgent)
+	if err != nil {
+		return err
+	}
+
+	metricsScope := tpgresource.GetResourceNameFromSelfLink(d.Get("metrics_scope").(string))
+	url := fmt.Sprintf("%sv1/locations/global/metricsScopes/%s", config.MonitoringBasePath, metricsScope)
+
+	billingProject := ""
+
+	// err == nil indicates that the billing_project value was found
+	if bp, err := tpgresource.GetBillingProject(d, config); err == nil {
+		billingProject = bp
+	}
+
+	res, err := transport_tpg.SendRequest(transport_tpg.SendRequestOptions{
+		Config:               config,
+		Method:               "GET",
+		Project:              billingProject,
+		RawURL:               url,
+		UserAgent:            userAgent,
+		ErrorRetryPredicates: []transport_tpg.RetryErrorPredicateFunc{transport_tpg.IsMonitoringPermissionError},
+	})
+	if err != nil {
+		return fmt.Errorf("Error retrieving Metrics Scope %q: %s", metricsScope, err)
+	}
+
+	if err := d.Set("name", res["name"]); err != nil {
+		return fmt.Errorf("Error setting name: %s", err)
+	}
+	if err := d.Set("create_time", res["createTime"]); err != nil {
+		return fmt.Errorf("Error setting create_time: %s", err)
+	}
+	if err := d.Set("update_time", res["updateTime"]); err != nil {
+		return fmt.Errorf("Error setting update_time: %s", err)
+	}
+	if err := d.Set("monitored_projects", flattenMonitoringMetricsScopeMonitoredProjects(res["monitoredProjects"])); err != nil {
+		return fmt.Errorf("Error setting monitored_projects: %s", err)
+	}
+
+	d.SetId(fmt.Sprintf("locations/global/metricsScopes/%s", metricsScope))
+	return nil
+}
+
+func flattenMonitoringMetricsScopeMonitoredProjects(v interface{}) []interface{} {
+	if v == nil {
+		return nil
+	}
+	l := v.([]interface{})
+	transformed := make([]interface{}, 0, len(l))
+	for _, raw := range l {
+		original, ok := raw.(map[string]interface{})
+		if !ok || len(original) == 0 {
+			continue
+		}
+		transformed = append(transformed, map[string]interface{}{
+			"name":        original["name"],
+			"create_time": original["createTime"],
+		})
+	}
+	return transformed
+}
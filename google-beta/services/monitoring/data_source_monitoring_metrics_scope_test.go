@@ -0,0 +1,61 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+package monitoring_test
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-google-beta/google-beta/acctest"
+	"github.com/hashicorp/terraform-provider-google-beta/google-beta/envvar"
+)
+
+func TestAccDataSourceMonitoringMetricsScope_basic(t *testing.T) {
+	t.Parallel()
+
+	context := map[string]interface{}{
+		"org_id":        envvar.GetTestOrgFromEnv(t),
+		"project_id":    envvar.GetTestProjectFromEnv(),
+		"random_suffix": acctest.RandString(t, 10),
+	}
+
+	acctest.VcrTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.AccTestPreCheck(t) },
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories(t),
+		CheckDestroy:             testAccCheckMonitoringMonitoredProjectDestroyProducer(t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceMonitoringMetricsScope_basic(context),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.google_monitoring_metrics_scope.scope", "name"),
+					resource.TestCheckResourceAttrSet("data.google_monitoring_metrics_scope.scope", "create_time"),
+					// The scope may monitor other projects too, so only check that the list is nonempty.
+					resource.TestMatchResourceAttr("data.google_monitoring_metrics_scope.scope", "monitored_projects.#", regexp.MustCompile(`^[1-9][0-9]*$`)),
+					resource.TestCheckResourceAttrSet("data.google_monitoring_metrics_scope.scope", "monitored_projects.0.name"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceMonitoringMetricsScope_basic(context map[string]interface{}) string {
+	return acctest.Nprintf(`
+resource "google_project" "basic" {
+  project_id = "tf-test-m-id%{random_suffix}"
+  name       = "tf-test-m-id%{random_suffix}-display"
+  org_id     = "%{org_id}"
+}
+
+resource "google_monitoring_monitored_project" "primary" {
+  metrics_scope = "%{project_id}"
+  name          = google_project.basic.project_id
+}
+
+data "google_monitoring_metrics_scope" "scope" {
+  metrics_scope = "%{project_id}"
+
+  depends_on = [google_monitoring_monitored_project.primary]
+}
+`, context)
+}
@@ -345,10 +345,12 @@ uptime checks:
 							Elem: &schema.Resource{
 								Schema: map[string]*schema.Schema{
 									"name": {
-										Type:        schema.TypeString,
-										Required:    true,
-										ForceNew:    true,
-										Description: `The fully qualified name of the cloud function resource.`,
+										Type:             schema.TypeString,
+										Required:         true,
+										ForceNew:         true,
+										DiffSuppressFunc: tpgresource.CompareResourceReferences,
+										Description: `The fully qualified name of the cloud function resource, e.g. the id of a 'google_cloudfunctions2_function'.
+A short function name is expanded using the provider project and region.`,
 									},
 								},
 							},
@@ -1799,5 +1801,24 @@ func expandMonitoringUptimeCheckConfigSyntheticMonitorCloudFunctionV2(v interfac
 }
 
 func expandMonitoringUptimeCheckConfigSyntheticMonitorCloudFunctionV2Name(v interface{}, d tpgresource.TerraformResourceData, config *transport_tpg.Config) (interface{}, error) {
-	return v, nil
+	name, ok := v.(string)
+	if !ok || name == "" {
+		return v, nil
+	}
+	if strings.Contains(name, "/") {
+		// Accept self links and full resource names of the function as well
+		if path, err := tpgresource.GetRelativePath(name); err == nil {
+			return path, nil
+		}
+		return name, nil
+	}
+
+	project, err := tpgresource.GetProject(d, config)
+	if err != nil {
+		return nil, err
+	}
+	if config.Region == "" {
+		return nil, fmt.Errorf("Cannot determine the location of cloud function %q: set the provider region or use the function's id (projects/{project}/locations/{location}/functions/{name})", name)
+	}
+	return fmt.Sprintf("projects/%s/locations/%s/functions/%s", project, config.Region, name), nil
 }
@@ -0,0 +1,66 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+package monitoring
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-google-beta/google-beta/tpgresource"
+	transport_tpg "github.com/hashicorp/terraform-provider-google-beta/google-beta/transport"
+)
+
+func TestExpandMonitoringUptimeCheckConfigSyntheticMonitorCloudFunctionV2Name(t *testing.T) {
+	t.Parallel()
+
+	cases := map[string]struct {
+		name      string
+		region    string
+		expected  string
+		expectErr bool
+	}{
+		"id": {
+			name:     "projects/my-project/locations/us-east1/functions/my-function",
+			region:   "us-central1",
+			expected: "projects/my-project/locations/us-east1/functions/my-function",
+		},
+		"full resource name": {
+			name:     "//cloudfunctions.googleapis.com/projects/my-project/locations/us-east1/functions/my-function",
+			region:   "us-central1",
+			expected: "projects/my-project/locations/us-east1/functions/my-function",
+		},
+		"short name": {
+			name:     "my-function",
+			region:   "us-central1",
+			expected: "projects/provider-project/locations/us-central1/functions/my-function",
+		},
+		"short name without provider region": {
+			name:      "my-function",
+			expectErr: true,
+		},
+	}
+
+	for tn, tc := range cases {
+		d := &tpgresource.ResourceDataMock{
+			FieldsInSchema: map[string]interface{}{},
+		}
+		config := &transport_tpg.Config{
+			Project: "provider-project",
+			Region:  tc.region,
+		}
+
+		got, err := expandMonitoringUptimeCheckConfigSyntheticMonitorCloudFunctionV2Name(tc.name, d, config)
+		if tc.expectErr {
+			if err == nil {
+				t.Errorf("%s: expected an error, got %v", tn, got)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("%s: unexpected error: %s", tn, err)
+			continue
+		}
+		if got != tc.expected {
+			t.Errorf("%s: expected %q, got %q", tn, tc.expected, got)
+		}
+	}
+}
@@ -25,6 +25,7 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
 	"github.com/hashicorp/terraform-provider-google-beta/google-beta/tpgresource"
 	transport_tpg "github.com/hashicorp/terraform-provider-google-beta/google-beta/transport"
@@ -77,11 +78,12 @@ func ResourceNetworkConnectivityPolicyBasedRoute() *schema.Resource {
 							Default:     "0.0.0.0/0",
 						},
 						"ip_protocol": {
-							Type:        schema.TypeString,
-							Optional:    true,
-							ForceNew:    true,
-							Description: `The IP protocol that this policy-based route applies to. Valid values are 'TCP', 'UDP', and 'ALL'. Default is 'ALL'.`,
-							Default:     "ALL",
+							Type:         schema.TypeString,
+							Optional:     true,
+							ForceNew:     true,
+							ValidateFunc: verify.ValidateEnum([]string{"TCP", "UDP", "ALL"}),
+							Description:  `The IP protocol that this policy-based route applies to. Valid values are 'TCP', 'UDP', and 'ALL'. Default is 'ALL'.`,
+							Default:      "ALL",
 						},
 						"src_range": {
 							Type:        schema.TypeString,
@@ -100,10 +102,11 @@ func ResourceNetworkConnectivityPolicyBasedRoute() *schema.Resource {
 				Description: `The name of the policy based route.`,
 			},
 			"network": {
-				Type:        schema.TypeString,
-				Required:    true,
-				ForceNew:    true,
-				Description: `Fully-qualified URL of the network that this route applies to, for example: projects/my-project/global/networks/my-network.`,
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				DiffSuppressFunc: tpgresource.CompareSelfLinkOrResourceName,
+				Description:      `The name, self link or fully-qualified URL of the network that this route applies to, for example: projects/my-project/global/networks/my-network.`,
 			},
 			"description": {
 				Type:        schema.TypeString,
@@ -143,6 +146,7 @@ Please refer to the field 'effective_labels' for all of the labels present on th
 				Type:         schema.TypeString,
 				Optional:     true,
 				ForceNew:     true,
+				ValidateFunc: validation.IsIPv4Address,
 				Description:  `The IP address of a global-access-enabled L4 ILB that is the next hop for matching packets.`,
 				ExactlyOneOf: []string{"next_hop_ilb_ip", "next_hop_other_routes"},
 			},
@@ -155,11 +159,12 @@ Please refer to the field 'effective_labels' for all of the labels present on th
 				ExactlyOneOf: []string{"next_hop_ilb_ip", "next_hop_other_routes"},
 			},
 			"priority": {
-				Type:        schema.TypeInt,
-				Optional:    true,
-				ForceNew:    true,
-				Description: `The priority of this policy-based route. Priority is used to break ties in cases where there are more than one matching policy-based routes found. In cases where multiple policy-based routes are matched, the one with the lowest-numbered priority value wins. The default value is 1000. The priority value must be from 1 to 65535, inclusive.`,
-				Default:     1000,
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IntBetween(1, 65535),
+				Description:  `The priority of this policy-based route. Priority is used to break ties in cases where there are more than one matching policy-based routes found. In cases where multiple policy-based routes are matched, the one with the lowest-numbered priority value wins. The default value is 1000. The priority value must be from 1 to 65535, inclusive.`,
+				Default:      1000,
 			},
 			"virtual_machine": {
 				Type:        schema.TypeList,
@@ -716,7 +721,11 @@ func expandNetworkConnectivityPolicyBasedRouteDescription(v interface{}, d tpgre
 }
 
 func expandNetworkConnectivityPolicyBasedRouteNetwork(v interface{}, d tpgresource.TerraformResourceData, config *transport_tpg.Config) (interface{}, error) {
-	return v, nil
+	f, err := tpgresource.ParseGlobalFieldValue("networks", v.(string), "project", d, config, true)
+	if err != nil {
+		return nil, fmt.Errorf("Invalid value for network: %s", err)
+	}
+	return f.RelativeLink(), nil
 }
 
 func expandNetworkConnectivityPolicyBasedRouteFilter(v interface{}, d tpgresource.TerraformResourceData, config *transport_tpg.Config) (interface{}, error) {
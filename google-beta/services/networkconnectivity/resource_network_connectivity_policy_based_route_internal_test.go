@@ -0,0 +1,33 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+package networkconnectivity
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-google-beta/google-beta/tpgresource"
+	transport_tpg "github.com/hashicorp/terraform-provider-google-beta/google-beta/transport"
+)
+
+func TestExpandNetworkConnectivityPolicyBasedRouteNetwork(t *testing.T) {
+	cases := map[string]string{
+		"name":          "my-network",
+		"relative link": "projects/my-project/global/networks/my-network",
+		"self link":     "https://www.googleapis.com/compute/v1/projects/my-project/global/networks/my-network",
+	}
+
+	for tn, network := range cases {
+		d := &tpgresource.ResourceDataMock{
+			FieldsInSchema: map[string]interface{}{
+				"project": "my-project",
+			},
+		}
+		got, err := expandNetworkConnectivityPolicyBasedRouteNetwork(network, d, &transport_tpg.Config{})
+		if err != nil {
+			t.Fatalf("%s: unexpected error: %s", tn, err)
+		}
+		if got != "projects/my-project/global/networks/my-network" {
+			t.Errorf("%s: expected projects/my-project/global/networks/my-network, got %q", tn, got)
+		}
+	}
+}
@@ -0,0 +1,30 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+package networkmanagement
+
+import (
+	transport_tpg "github.com/hashicorp/terraform-provider-google-beta/google-beta/transport"
+)
+
+// pollCheckNetworkManagementConnectivityTestReachability waits until a
+// connectivity test reports the result of a reachability analysis that
+// finished at a different time than previousVerifyTime.
+func pollCheckNetworkManagementConnectivityTestReachability(previousVerifyTime string) transport_tpg.PollCheckResponseFunc {
+	return func(resp map[string]interface{}, respErr error) transport_tpg.PollResult {
+		if respErr != nil {
+			return transport_tpg.ErrorPollResult(respErr)
+		}
+		details, ok := resp["reachabilityDetails"].(map[string]interface{})
+		if !ok || len(details) == 0 {
+			return transport_tpg.PendingStatusPollResult("no reachability details yet")
+		}
+		result, _ := details["result"].(string)
+		if result == "" || result == "RESULT_UNSPECIFIED" {
+			return transport_tpg.PendingStatusPollResult("reachability analysis in progress")
+		}
+		if verifyTime, _ := details["verifyTime"].(string); verifyTime == previousVerifyTime {
+			return transport_tpg.PendingStatusPollResult("waiting for a new reachability analysis")
+		}
+		return transport_tpg.SuccessPollResult()
+	}
+}
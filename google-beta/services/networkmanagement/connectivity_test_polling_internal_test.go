@@ -0,0 +1,72 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+package networkmanagement
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestPollCheckNetworkManagementConnectivityTestReachability(t *testing.T) {
+	t.Parallel()
+
+	cases := map[string]struct {
+		previousVerifyTime string
+		resp               map[string]interface{}
+		done               bool
+	}{
+		"no reachability details": {
+			resp: map[string]interface{}{"name": "test"},
+		},
+		"unspecified result": {
+			resp: map[string]interface{}{
+				"reachabilityDetails": map[string]interface{}{
+					"result": "RESULT_UNSPECIFIED",
+				},
+			},
+		},
+		"result": {
+			resp: map[string]interface{}{
+				"reachabilityDetails": map[string]interface{}{
+					"result":     "REACHABLE",
+					"verifyTime": "2024-01-01T00:00:00Z",
+				},
+			},
+			done: true,
+		},
+		"result from before update": {
+			previousVerifyTime: "2024-01-01T00:00:00Z",
+			resp: map[string]interface{}{
+				"reachabilityDetails": map[string]interface{}{
+					"result":     "REACHABLE",
+					"verifyTime": "2024-01-01T00:00:00Z",
+				},
+			},
+		},
+		"result after update": {
+			previousVerifyTime: "2024-01-01T00:00:00Z",
+			resp: map[string]interface{}{
+				"reachabilityDetails": map[string]interface{}{
+					"result":     "UNREACHABLE",
+					"verifyTime": "2024-01-01T00:05:00Z",
+				},
+			},
+			done: true,
+		},
+	}
+
+	for tn, tc := range cases {
+		res := pollCheckNetworkManagementConnectivityTestReachability(tc.previousVerifyTime)(tc.resp, nil)
+		if tc.done && res != nil {
+			t.Errorf("%s: expected polling to finish, got %v", tn, res.Err)
+		}
+		if !tc.done && (res == nil || !res.Retryable) {
+			t.Errorf("%s: expected polling to continue, got %v", tn, res)
+		}
+	}
+
+	res := pollCheckNetworkManagementConnectivityTestReachability("")(nil, errors.New("boom"))
+	if res == nil || res.Retryable {
+		t.Errorf("expected a non-retryable error, got %v", res)
+	}
+}
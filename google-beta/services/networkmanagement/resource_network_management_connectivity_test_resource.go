@@ -230,6 +230,45 @@ boundaries.`,
 					Type: schema.TypeString,
 				},
 			},
+			"reachability_details": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: `The reachability details of this test from the latest run.`,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"error": {
+							Type:        schema.TypeList,
+							Computed:    true,
+							Description: `The details of a failure or a cancellation of reachability analysis.`,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"code": {
+										Type:        schema.TypeInt,
+										Computed:    true,
+										Description: `The status code.`,
+									},
+									"message": {
+										Type:        schema.TypeString,
+										Computed:    true,
+										Description: `A developer-facing error message.`,
+									},
+								},
+							},
+						},
+						"result": {
+							Type:     schema.TypeString,
+							Computed: true,
+							Description: `The overall result of the test's configuration analysis. One of
+'REACHABLE', 'UNREACHABLE', 'AMBIGUOUS' or 'UNDETERMINED'.`,
+						},
+						"verify_time": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: `The time of the configuration analysis.`,
+						},
+					},
+				},
+			},
 			"effective_labels": {
 				Type:        schema.TypeMap,
 				Computed:    true,
@@ -243,6 +282,14 @@ boundaries.`,
  and default labels configured on the provider.`,
 				Elem: &schema.Schema{Type: schema.TypeString},
 			},
+			"wait_for_reachability_result": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+				Description: `If set to true, Terraform waits for the reachability analysis of the test to
+finish after creating or updating it, so that 'reachability_details' holds
+its result.`,
+			},
 			"project": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -368,11 +415,60 @@ func resourceNetworkManagementConnectivityTestCreate(d *schema.ResourceData, met
 	}
 	d.SetId(id)
 
+	if d.Get("wait_for_reachability_result").(bool) {
+		err = transport_tpg.PollingWaitTime(resourceNetworkManagementConnectivityTestPollRead(d, meta), pollCheckNetworkManagementConnectivityTestReachability(""), "Waiting for ConnectivityTest reachability analysis", d.Timeout(schema.TimeoutCreate), 1)
+		if err != nil {
+			return fmt.Errorf("Error waiting for reachability analysis of ConnectivityTest: %s", err)
+		}
+	}
+
 	log.Printf("[DEBUG] Finished creating ConnectivityTest %q: %#v", d.Id(), res)
 
 	return resourceNetworkManagementConnectivityTestRead(d, meta)
 }
 
+func resourceNetworkManagementConnectivityTestPollRead(d *schema.ResourceData, meta interface{}) transport_tpg.PollReadFunc {
+	return func() (map[string]interface{}, error) {
+		config := meta.(*transport_tpg.Config)
+
+		url, err := tpgresource.ReplaceVars(d, config, "{{NetworkManagementBasePath}}projects/{{project}}/locations/global/connectivityTests/{{name}}")
+
+		if err != nil {
+			return nil, err
+		}
+
+		billingProject := ""
+
+		project, err := tpgresource.GetProject(d, config)
+		if err != nil {
+			return nil, fmt.Errorf("Error fetching project for ConnectivityTest: %s", err)
+		}
+		billingProject = project
+
+		// err == nil indicates that the billing_project value was found
+		if bp, err := tpgresource.GetBillingProject(d, config); err == nil {
+			billingProject = bp
+		}
+
+		userAgent, err := tpgresource.GenerateUserAgentString(d, config.UserAgent)
+		if err != nil {
+			return nil, err
+		}
+
+		res, err := transport_tpg.SendRequest(transport_tpg.SendRequestOptions{
+			Config:    config,
+			Method:    "GET",
+			Project:   billingProject,
+			RawURL:    url,
+			UserAgent: userAgent,
+		})
+		if err != nil {
+			return res, err
+		}
+		return res, nil
+	}
+}
+
 func resourceNetworkManagementConnectivityTestRead(d *schema.ResourceData, meta interface{}) error {
 	config := meta.(*transport_tpg.Config)
 	userAgent, err := tpgresource.GenerateUserAgentString(d, config.UserAgent)
@@ -409,6 +505,12 @@ func resourceNetworkManagementConnectivityTestRead(d *schema.ResourceData, meta
 		return transport_tpg.HandleNotFoundError(err, d, fmt.Sprintf("NetworkManagementConnectivityTest %q", d.Id()))
 	}
 
+	// Explicitly set virtual fields to default values if unset
+	if _, ok := d.GetOkExists("wait_for_reachability_result"); !ok {
+		if err := d.Set("wait_for_reachability_result", false); err != nil {
+			return fmt.Errorf("Error setting wait_for_reachability_result: %s", err)
+		}
+	}
 	if err := d.Set("project", project); err != nil {
 		return fmt.Errorf("Error reading ConnectivityTest: %s", err)
 	}
@@ -434,6 +536,9 @@ func resourceNetworkManagementConnectivityTestRead(d *schema.ResourceData, meta
 	if err := d.Set("labels", flattenNetworkManagementConnectivityTestLabels(res["labels"], d, config)); err != nil {
 		return fmt.Errorf("Error reading ConnectivityTest: %s", err)
 	}
+	if err := d.Set("reachability_details", flattenNetworkManagementConnectivityTestReachabilityDetails(res["reachabilityDetails"], d, config)); err != nil {
+		return fmt.Errorf("Error reading ConnectivityTest: %s", err)
+	}
 	if err := d.Set("terraform_labels", flattenNetworkManagementConnectivityTestTerraformLabels(res["labels"], d, config)); err != nil {
 		return fmt.Errorf("Error reading ConnectivityTest: %s", err)
 	}
@@ -574,6 +679,16 @@ func resourceNetworkManagementConnectivityTestUpdate(d *schema.ResourceData, met
 		if err != nil {
 			return err
 		}
+
+		// Updating a test reruns its analysis, so wait for a result newer
+		// than the one from before the update.
+		if d.Get("wait_for_reachability_result").(bool) {
+			previousVerifyTime := d.Get("reachability_details.0.verify_time").(string)
+			err = transport_tpg.PollingWaitTime(resourceNetworkManagementConnectivityTestPollRead(d, meta), pollCheckNetworkManagementConnectivityTestReachability(previousVerifyTime), "Waiting for ConnectivityTest reachability analysis", d.Timeout(schema.TimeoutUpdate), 1)
+			if err != nil {
+				return fmt.Errorf("Error waiting for reachability analysis of ConnectivityTest %q: %s", d.Id(), err)
+			}
+		}
 	}
 
 	return resourceNetworkManagementConnectivityTestRead(d, meta)
@@ -649,6 +764,11 @@ func resourceNetworkManagementConnectivityTestImport(d *schema.ResourceData, met
 	}
 	d.SetId(id)
 
+	// Explicitly set virtual fields to default values on import
+	if err := d.Set("wait_for_reachability_result", false); err != nil {
+		return nil, fmt.Errorf("Error setting wait_for_reachability_result: %s", err)
+	}
+
 	return []*schema.ResourceData{d}, nil
 }
 
@@ -800,6 +920,67 @@ func flattenNetworkManagementConnectivityTestLabels(v interface{}, d *schema.Res
 	return transformed
 }
 
+func flattenNetworkManagementConnectivityTestReachabilityDetails(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
+	if v == nil {
+		return nil
+	}
+	original := v.(map[string]interface{})
+	if len(original) == 0 {
+		return nil
+	}
+	transformed := make(map[string]interface{})
+	transformed["error"] =
+		flattenNetworkManagementConnectivityTestReachabilityDetailsError(original["error"], d, config)
+	transformed["result"] =
+		flattenNetworkManagementConnectivityTestReachabilityDetailsResult(original["result"], d, config)
+	transformed["verify_time"] =
+		flattenNetworkManagementConnectivityTestReachabilityDetailsVerifyTime(original["verifyTime"], d, config)
+	return []interface{}{transformed}
+}
+func flattenNetworkManagementConnectivityTestReachabilityDetailsError(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
+	if v == nil {
+		return nil
+	}
+	original := v.(map[string]interface{})
+	if len(original) == 0 {
+		return nil
+	}
+	transformed := make(map[string]interface{})
+	transformed["code"] =
+		flattenNetworkManagementConnectivityTestReachabilityDetailsErrorCode(original["code"], d, config)
+	transformed["message"] =
+		flattenNetworkManagementConnectivityTestReachabilityDetailsErrorMessage(original["message"], d, config)
+	return []interface{}{transformed}
+}
+func flattenNetworkManagementConnectivityTestReachabilityDetailsErrorCode(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
+	// Handles the string fixed64 format
+	if strVal, ok := v.(string); ok {
+		if intVal, err := tpgresource.StringToFixed64(strVal); err == nil {
+			return intVal
+		}
+	}
+
+	// number values are represented as float64
+	if floatVal, ok := v.(float64); ok {
+		intVal := int(floatVal)
+		return intVal
+	}
+
+	return v // let terraform core handle it otherwise
+}
+
+func flattenNetworkManagementConnectivityTestReachabilityDetailsErrorMessage(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
+	return v
+}
+
+func flattenNetworkManagementConnectivityTestReachabilityDetailsResult(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
+	return v
+}
+
+func flattenNetworkManagementConnectivityTestReachabilityDetailsVerifyTime(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
+	return v
+}
+
 func flattenNetworkManagementConnectivityTestTerraformLabels(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
 	if v == nil {
 		return v
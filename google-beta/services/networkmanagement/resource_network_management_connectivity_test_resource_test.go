@@ -24,19 +24,28 @@ func TestAccNetworkManagementConnectivityTest_update(t *testing.T) {
 		Steps: []resource.TestStep{
 			{
 				Config: testAccNetworkManagementConnectivityTest_instanceToInstance(context),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("google_network_management_connectivity_test.conn-test", "reachability_details.0.result"),
+					resource.TestCheckResourceAttrSet("google_network_management_connectivity_test.conn-test", "reachability_details.0.verify_time"),
+				),
 			},
 			{
-				ResourceName:      "google_network_management_connectivity_test.conn-test",
-				ImportState:       true,
-				ImportStateVerify: true,
+				ResourceName:            "google_network_management_connectivity_test.conn-test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"wait_for_reachability_result"},
 			},
 			{
 				Config: testAccNetworkManagementConnectivityTest_instanceToAddr(context),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("google_network_management_connectivity_test.conn-test", "reachability_details.0.result"),
+				),
 			},
 			{
-				ResourceName:      "google_network_management_connectivity_test.conn-test",
-				ImportState:       true,
-				ImportStateVerify: true,
+				ResourceName:            "google_network_management_connectivity_test.conn-test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"wait_for_reachability_result"},
 			},
 		},
 	})
@@ -55,6 +64,8 @@ resource "google_network_management_connectivity_test" "conn-test" {
   }
 
   protocol = "TCP"
+
+  wait_for_reachability_result = true
 }
 `, context)
 	return fmt.Sprintf("%s\n\n%s\n\n", connTestCfg, testAccNetworkManagementConnectivityTest_baseResources(context))
@@ -78,6 +89,8 @@ resource "google_network_management_connectivity_test" "conn-test" {
   }
 
   protocol = "TCP"
+
+  wait_for_reachability_result = true
 }
 `, context)
 	return fmt.Sprintf("%s\n\n%s\n\n", connTestCfg, testAccNetworkManagementConnectivityTest_baseResources(context))
@@ -4,6 +4,7 @@ package resourcemanager
 
 import (
 	"fmt"
+	"strings"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-google-beta/google-beta/tpgresource"
@@ -39,7 +40,7 @@ func dataSourceGoogleActiveFolderRead(d *schema.ResourceData, meta interface{})
 		return err
 	}
 
-	var folderMatch *resourceManagerV3.Folder
+	var folderMatches []*resourceManagerV3.Folder
 	parent := d.Get("parent").(string)
 	displayName := d.Get("display_name").(string)
 	token := ""
@@ -52,19 +53,24 @@ func dataSourceGoogleActiveFolderRead(d *schema.ResourceData, meta interface{})
 
 		for _, folder := range resp.Folders {
 			if folder.DisplayName == displayName && folder.State == "ACTIVE" {
-				if folderMatch != nil {
-					return fmt.Errorf("more than one matching folder found")
-				}
-				folderMatch = folder
+				folderMatches = append(folderMatches, folder)
 			}
 		}
 		token = resp.NextPageToken
 		paginate = token != ""
 	}
 
-	if folderMatch == nil {
-		return fmt.Errorf("folder not found: %s", displayName)
+	if len(folderMatches) == 0 {
+		return fmt.Errorf("folder not found: no active folder with display name %q under %s", displayName, parent)
 	}
+	if len(folderMatches) > 1 {
+		names := make([]string, 0, len(folderMatches))
+		for _, folder := range folderMatches {
+			names = append(names, folder.Name)
+		}
+		return fmt.Errorf("more than one matching folder found: active folders with display name %q under %s are %s", displayName, parent, strings.Join(names, ", "))
+	}
+	folderMatch := folderMatches[0]
 
 	d.SetId(folderMatch.Name)
 	if err := d.Set("name", folderMatch.Name); err != nil {
@@ -4,6 +4,7 @@ package resourcemanager
 
 import (
 	"fmt"
+	"strings"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-google-beta/google-beta/tpgresource"
@@ -27,6 +28,10 @@ func DataSourceGoogleFolders() *schema.Resource {
 							Type:     schema.TypeString,
 							Computed: true,
 						},
+						"folder_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
 						"parent": {
 							Type:     schema.TypeString,
 							Computed: true,
@@ -121,9 +126,10 @@ func flattenDataSourceGoogleFoldersList(v interface{}) []map[string]interface{}
 	for _, raw := range ls {
 		f := raw.(map[string]interface{})
 
-		var mState, mName, mCreateTime, mUpdateTime, mDeleteTime, mParent, mDisplayName, mEtag interface{}
+		var mState, mName, mFolderId, mCreateTime, mUpdateTime, mDeleteTime, mParent, mDisplayName, mEtag interface{}
 		if fName, ok := f["name"]; ok {
 			mName = fName
+			mFolderId = strings.TrimPrefix(fName.(string), "folders/")
 		}
 		if fState, ok := f["state"]; ok {
 			mState = fState
@@ -148,6 +154,7 @@ func flattenDataSourceGoogleFoldersList(v interface{}) []map[string]interface{}
 		}
 		folders = append(folders, map[string]interface{}{
 			"name":         mName,
+			"folder_id":    mFolderId,
 			"state":        mState,
 			"create_time":  mCreateTime,
 			"update_time":  mUpdateTime,
@@ -26,6 +26,7 @@ func TestAccDataSourceGoogleFolders_basic(t *testing.T) {
 				Config: testAccCheckGoogleFoldersConfig(parent, displayName),
 				Check: resource.ComposeTestCheckFunc(
 					resource.TestCheckResourceAttrSet("data.google_folders.root-test", "folders.0.name"),
+					resource.TestCheckResourceAttrSet("data.google_folders.root-test", "folders.0.folder_id"),
 					resource.TestCheckResourceAttrSet("data.google_folders.root-test", "folders.0.display_name"),
 					resource.TestCheckResourceAttrSet("data.google_folders.root-test", "folders.0.state"),
 					resource.TestCheckResourceAttrSet("data.google_folders.root-test", "folders.0.create_time"),
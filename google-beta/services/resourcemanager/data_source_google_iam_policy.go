@@ -95,8 +95,9 @@ func DataSourceGoogleIamPolicy() *schema.Resource {
 							Elem: &schema.Resource{
 								Schema: map[string]*schema.Schema{
 									"log_type": {
-										Type:     schema.TypeString,
-										Required: true,
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringInSlice([]string{"ADMIN_READ", "DATA_READ", "DATA_WRITE"}, false),
 									},
 									"exempted_members": {
 										Type:     schema.TypeSet,
@@ -190,28 +191,64 @@ func dataSourceGoogleIamPolicyRead(d *schema.ResourceData, meta interface{}) err
 }
 
 func expandAuditConfig(set *schema.Set) []*cloudresourcemanager.AuditConfig {
-	auditConfigs := make([]*cloudresourcemanager.AuditConfig, 0, set.Len())
+	// Merge audit_config{} blocks for the same service, as the API stores a single
+	// AuditConfig per service and would otherwise produce a permanent diff
+	auditConfigMap := map[string]map[string][]string{}
 	for _, v := range set.List() {
 		config := v.(map[string]interface{})
-		// build list of audit configs first
-		auditLogConfigSet := config["audit_log_configs"].(*schema.Set)
-		// the array we're going to add to the outgoing resource
-		auditLogConfigs := make([]*cloudresourcemanager.AuditLogConfig, 0, auditLogConfigSet.Len())
-		for _, y := range auditLogConfigSet.List() {
+		service := config["service"].(string)
+		logConfigMap, ok := auditConfigMap[service]
+		if !ok {
+			logConfigMap = map[string][]string{}
+			auditConfigMap[service] = logConfigMap
+		}
+		for _, y := range config["audit_log_configs"].(*schema.Set).List() {
 			logConfig := y.(map[string]interface{})
+			logType := logConfig["log_type"].(string)
+			exemptedMembers := tpgresource.ConvertStringArr(logConfig["exempted_members"].(*schema.Set).List())
+			logConfigMap[logType] = append(logConfigMap[logType], exemptedMembers...)
+		}
+	}
+
+	// Sort services, log types and exempted members to get simpler diffs, as it's what the API does
+	auditConfigs := make([]*cloudresourcemanager.AuditConfig, 0, len(auditConfigMap))
+	for service, logConfigMap := range auditConfigMap {
+		auditLogConfigs := make([]*cloudresourcemanager.AuditLogConfig, 0, len(logConfigMap))
+		for logType, exemptedMembers := range logConfigMap {
 			auditLogConfigs = append(auditLogConfigs, &cloudresourcemanager.AuditLogConfig{
-				LogType:         logConfig["log_type"].(string),
-				ExemptedMembers: tpgresource.ConvertStringArr(logConfig["exempted_members"].(*schema.Set).List()),
+				LogType:         logType,
+				ExemptedMembers: dedupeSortedStrings(exemptedMembers),
 			})
 		}
+		sort.Slice(auditLogConfigs, func(i, j int) bool {
+			return auditLogConfigs[i].LogType < auditLogConfigs[j].LogType
+		})
 		auditConfigs = append(auditConfigs, &cloudresourcemanager.AuditConfig{
-			Service:         config["service"].(string),
+			Service:         service,
 			AuditLogConfigs: auditLogConfigs,
 		})
 	}
+	sort.Slice(auditConfigs, func(i, j int) bool {
+		return auditConfigs[i].Service < auditConfigs[j].Service
+	})
 	return auditConfigs
 }
 
+// dedupeSortedStrings returns the unique values of s in ascending order.
+func dedupeSortedStrings(s []string) []string {
+	if len(s) == 0 {
+		return nil
+	}
+	sort.Strings(s)
+	result := s[:1]
+	for _, v := range s[1:] {
+		if v != result[len(result)-1] {
+			result = append(result, v)
+		}
+	}
+	return result
+}
+
 func iamPolicyBindingsLessFunction(policy cloudresourcemanager.Policy) func(i, j int) bool {
 
 	return func(i, j int) bool {
@@ -394,3 +394,53 @@ func TestDataSourceGoogleIamPolicyRead(t *testing.T) {
 		})
 	}
 }
+
+func TestDataSourceGoogleIamPolicyRead_auditConfig(t *testing.T) {
+	rawData := map[string]interface{}{
+		"binding":     []interface{}{},
+		"policy_data": "",
+		"audit_config": []interface{}{
+			map[string]interface{}{
+				"service": "storage.googleapis.com",
+				"audit_log_configs": []interface{}{
+					map[string]interface{}{
+						"log_type":         "DATA_READ",
+						"exempted_members": []interface{}{"user:b"},
+					},
+				},
+			},
+			map[string]interface{}{
+				"service": "allServices",
+				"audit_log_configs": []interface{}{
+					map[string]interface{}{
+						"log_type": "ADMIN_READ",
+					},
+				},
+			},
+			// Should be merged into the existing storage.googleapis.com audit config
+			map[string]interface{}{
+				"service": "storage.googleapis.com",
+				"audit_log_configs": []interface{}{
+					map[string]interface{}{
+						"log_type":         "DATA_READ",
+						"exempted_members": []interface{}{"user:a", "user:b"},
+					},
+					map[string]interface{}{
+						"log_type": "ADMIN_READ",
+					},
+				},
+			},
+		},
+	}
+	d := schema.TestResourceDataRaw(t, DataSourceGoogleIamPolicy().Schema, rawData)
+
+	var meta interface{}
+	if err := dataSourceGoogleIamPolicyRead(d, meta); err != nil {
+		t.Fatal(err)
+	}
+
+	expected := "{\"auditConfigs\":[{\"auditLogConfigs\":[{\"logType\":\"ADMIN_READ\"}],\"service\":\"allServices\"},{\"auditLogConfigs\":[{\"logType\":\"ADMIN_READ\"},{\"exemptedMembers\":[\"user:a\",\"user:b\"],\"logType\":\"DATA_READ\"}],\"service\":\"storage.googleapis.com\"}]}"
+	if policyData := d.Get("policy_data").(string); policyData != expected {
+		t.Errorf("expected `policy_data` to be %s, got: %s", expected, policyData)
+	}
+}
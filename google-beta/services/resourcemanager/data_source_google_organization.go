@@ -89,7 +89,11 @@ func dataSourceOrganizationRead(d *schema.ResourceData, meta interface{}) error
 				}
 			}
 			if organization == nil {
-				return fmt.Errorf("Received multiple organizations in the response, but could not find an exact domain match.")
+				names := make([]string, 0, len(resp.Organizations))
+				for _, org := range resp.Organizations {
+					names = append(names, fmt.Sprintf("%s (%s)", org.Name, org.DisplayName))
+				}
+				return fmt.Errorf("Received multiple organizations in the response, but could not find an exact domain match for %q: %s", v, strings.Join(names, ", "))
 			}
 		} else {
 			organization = resp.Organizations[0]
@@ -9,6 +9,7 @@ import (
 	"github.com/hashicorp/terraform-provider-google-beta/google-beta/tpgresource"
 	transport_tpg "github.com/hashicorp/terraform-provider-google-beta/google-beta/transport"
 	"github.com/hashicorp/terraform-provider-google-beta/google-beta/verify"
+	"google.golang.org/api/cloudresourcemanager/v1"
 )
 
 func DataSourceGoogleProject() *schema.Resource {
@@ -18,6 +19,25 @@ func DataSourceGoogleProject() *schema.Resource {
 	tpgresource.AddOptionalFieldsToSchema(dsSchema, "project_id")
 
 	dsSchema["project_id"].ValidateFunc = verify.ValidateDSProjectID()
+	dsSchema["ancestors"] = &schema.Schema{
+		Type:        schema.TypeList,
+		Computed:    true,
+		Description: `The folders and organization the project is in, from its parent up to the root of the hierarchy.`,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"type": {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: `The type of the ancestor, either "folder" or "organization".`,
+				},
+				"id": {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: `The numeric ID of the ancestor.`,
+				},
+			},
+		},
+	}
 	return &schema.Resource{
 		Read:   datasourceGoogleProjectRead,
 		Schema: dsSchema,
@@ -52,5 +72,34 @@ func datasourceGoogleProjectRead(d *schema.ResourceData, meta interface{}) error
 		return fmt.Errorf("%s not found or not in ACTIVE state", id)
 	}
 
+	userAgent, err := tpgresource.GenerateUserAgentString(d, config.UserAgent)
+	if err != nil {
+		return err
+	}
+	pid := d.Get("project_id").(string)
+	ancestry, err := config.NewResourceManagerClient(userAgent).Projects.GetAncestry(pid, &cloudresourcemanager.GetAncestryRequest{}).Do()
+	if err != nil {
+		return fmt.Errorf("Error reading ancestry of project %q: %s", pid, err)
+	}
+	if err := d.Set("ancestors", flattenProjectAncestors(ancestry.Ancestor)); err != nil {
+		return fmt.Errorf("Error setting ancestors: %s", err)
+	}
+
 	return nil
 }
+
+// flattenProjectAncestors returns the ancestors of a project, as returned by
+// projects.getAncestry, without the project itself.
+func flattenProjectAncestors(ancestors []*cloudresourcemanager.Ancestor) []map[string]interface{} {
+	result := make([]map[string]interface{}, 0, len(ancestors))
+	for _, a := range ancestors {
+		if a.ResourceId == nil || a.ResourceId.Type == "project" {
+			continue
+		}
+		result = append(result, map[string]interface{}{
+			"type": a.ResourceId.Type,
+			"id":   a.ResourceId.Id,
+		})
+	}
+	return result
+}
@@ -0,0 +1,31 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+package resourcemanager
+
+import (
+	"reflect"
+	"testing"
+
+	"google.golang.org/api/cloudresourcemanager/v1"
+)
+
+func TestFlattenProjectAncestors(t *testing.T) {
+	ancestors := []*cloudresourcemanager.Ancestor{
+		{ResourceId: &cloudresourcemanager.ResourceId{Type: "project", Id: "my-project"}},
+		{ResourceId: &cloudresourcemanager.ResourceId{Type: "folder", Id: "123"}},
+		{ResourceId: &cloudresourcemanager.ResourceId{Type: "folder", Id: "456"}},
+		{ResourceId: &cloudresourcemanager.ResourceId{Type: "organization", Id: "789"}},
+	}
+	expected := []map[string]interface{}{
+		{"type": "folder", "id": "123"},
+		{"type": "folder", "id": "456"},
+		{"type": "organization", "id": "789"},
+	}
+
+	if got := flattenProjectAncestors(ancestors); !reflect.DeepEqual(got, expected) {
+		t.Errorf("expected %v, got %v", expected, got)
+	}
+	if got := flattenProjectAncestors(nil); len(got) != 0 {
+		t.Errorf("expected no ancestors, got %v", got)
+	}
+}
@@ -31,6 +31,9 @@ func TestAccDataSourceGoogleProject_basic(t *testing.T) {
 							"auto_create_network": {},
 							"skip_delete":         {},
 						}),
+					resource.TestCheckResourceAttr("data.google_project.project", "ancestors.#", "1"),
+					resource.TestCheckResourceAttr("data.google_project.project", "ancestors.0.type", "organization"),
+					resource.TestCheckResourceAttr("data.google_project.project", "ancestors.0.id", org),
 				),
 			},
 		},
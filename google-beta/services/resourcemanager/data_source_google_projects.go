@@ -51,7 +51,7 @@ func DataSourceGoogleProjects() *schema.Resource {
 						"lifecycle_state": {
 							Type:        schema.TypeString,
 							Computed:    true,
-							Description: `The numeric identifier of the project.`,
+							Description: `The lifecycle state of the project, e.g. ACTIVE or DELETE_REQUESTED.`,
 						},
 						"name": {
 							Type:        schema.TypeString,
@@ -4,7 +4,9 @@ package resourcemanager
 
 import (
 	"encoding/json"
+	"errors"
 	"fmt"
+	"log"
 	"strings"
 	"time"
 
@@ -12,6 +14,7 @@ import (
 	"github.com/hashicorp/terraform-provider-google-beta/google-beta/tpgresource"
 	transport_tpg "github.com/hashicorp/terraform-provider-google-beta/google-beta/transport"
 	resourceManagerV3 "google.golang.org/api/cloudresourcemanager/v3"
+	"google.golang.org/api/googleapi"
 )
 
 func ResourceGoogleFolder() *schema.Resource {
@@ -95,7 +98,11 @@ func resourceGoogleFolderCreate(d *schema.ResourceData, meta interface{}) error
 		Timeout: d.Timeout(schema.TimeoutCreate),
 	})
 	if err != nil {
-		return fmt.Errorf("Error creating folder '%s' in '%s': %s", displayName, parent, err)
+		createErr := fmt.Errorf("Error creating folder '%s' in '%s': %s", displayName, parent, err)
+		if isFolderAlreadyExistsError(err) {
+			return resourceGoogleFolderUndeleteOnConflict(d, meta, createErr)
+		}
+		return createErr
 	}
 
 	opAsMap, err := tpgresource.ConvertToMap(op)
@@ -105,7 +112,11 @@ func resourceGoogleFolderCreate(d *schema.ResourceData, meta interface{}) error
 
 	err = ResourceManagerOperationWaitTime(config, opAsMap, "creating folder", userAgent, d.Timeout(schema.TimeoutCreate))
 	if err != nil {
-		return fmt.Errorf("Error creating folder '%s' in '%s': %s", displayName, parent, err)
+		createErr := fmt.Errorf("Error creating folder '%s' in '%s': %s", displayName, parent, err)
+		if isFolderAlreadyExistsError(err) {
+			return resourceGoogleFolderUndeleteOnConflict(d, meta, createErr)
+		}
+		return createErr
 	}
 
 	// Since we waited above, the operation is guaranteed to have been successful by this point.
@@ -127,6 +138,89 @@ func resourceGoogleFolderCreate(d *schema.ResourceData, meta interface{}) error
 	return fmt.Errorf("The folder '%s' has been created but we could not retrieve its id. Delete the folder manually and retry or use 'terraform import'", displayName)
 }
 
+// isFolderAlreadyExistsError returns whether err, returned by the create call or its
+// operation, means that a folder with the same display name already exists in the parent.
+func isFolderAlreadyExistsError(err error) bool {
+	var gerr *googleapi.Error
+	if errors.As(err, &gerr) {
+		return gerr.Code == 409
+	}
+	var opErr *tpgresource.CommonOpError
+	if errors.As(err, &opErr) && opErr.Status != nil {
+		// google.rpc.Code ALREADY_EXISTS
+		return opErr.Code == 6
+	}
+	return false
+}
+
+// Display names must be unique amongst siblings, including folders pending deletion, so
+// recreating a folder deleted less than 30 days ago fails with ALREADY_EXISTS. In that case,
+// the deleted folder is restored and managed instead. createErr is returned if there is no
+// such folder.
+func resourceGoogleFolderUndeleteOnConflict(d *schema.ResourceData, meta interface{}, createErr error) error {
+	config := meta.(*transport_tpg.Config)
+	userAgent, err := tpgresource.GenerateUserAgentString(d, config.UserAgent)
+	if err != nil {
+		return err
+	}
+
+	displayName := d.Get("display_name").(string)
+	parent := d.Get("parent").(string)
+
+	var folders []*resourceManagerV3.Folder
+	err = config.NewResourceManagerV3Client(userAgent).Folders.List().Parent(parent).ShowDeleted(true).Pages(config.Context, func(res *resourceManagerV3.ListFoldersResponse) error {
+		folders = append(folders, res.Folders...)
+		return nil
+	})
+	if err != nil {
+		log.Printf("[WARN] Unable to list the folders in '%s' to look for a deleted folder named '%s': %s", parent, displayName, err)
+		return createErr
+	}
+
+	deleted := findDeletedFolder(folders, parent, displayName)
+	if deleted == nil {
+		return createErr
+	}
+	log.Printf("[WARN] Folder '%s' in '%s' is pending deletion as %s, restoring it instead of creating a new folder", displayName, parent, deleted.Name)
+
+	var op *resourceManagerV3.Operation
+	err = transport_tpg.Retry(transport_tpg.RetryOptions{
+		RetryFunc: func() error {
+			var reqErr error
+			op, reqErr = config.NewResourceManagerV3Client(userAgent).Folders.Undelete(deleted.Name, &resourceManagerV3.UndeleteFolderRequest{}).Do()
+			return reqErr
+		},
+		Timeout: d.Timeout(schema.TimeoutCreate),
+	})
+	if err != nil {
+		return fmt.Errorf("Error restoring deleted folder %s: %s", deleted.Name, err)
+	}
+
+	opAsMap, err := tpgresource.ConvertToMap(op)
+	if err != nil {
+		return err
+	}
+
+	err = ResourceManagerOperationWaitTime(config, opAsMap, "restoring folder", userAgent, d.Timeout(schema.TimeoutCreate))
+	if err != nil {
+		return fmt.Errorf("Error restoring deleted folder %s: %s", deleted.Name, err)
+	}
+
+	d.SetId(deleted.Name)
+	return resourceGoogleFolderRead(d, meta)
+}
+
+// findDeletedFolder returns the folder pending deletion with the given parent and display
+// name, if any.
+func findDeletedFolder(folders []*resourceManagerV3.Folder, parent, displayName string) *resourceManagerV3.Folder {
+	for _, f := range folders {
+		if f.State == "DELETE_REQUESTED" && f.Parent == parent && f.DisplayName == displayName {
+			return f
+		}
+	}
+	return nil
+}
+
 func resourceGoogleFolderRead(d *schema.ResourceData, meta interface{}) error {
 	config := meta.(*transport_tpg.Config)
 	userAgent, err := tpgresource.GenerateUserAgentString(d, config.UserAgent)
@@ -172,17 +266,30 @@ func resourceGoogleFolderUpdate(d *schema.ResourceData, meta interface{}) error
 
 	d.Partial(true)
 	if d.HasChange("display_name") {
+		var op *resourceManagerV3.Operation
 		err := transport_tpg.Retry(transport_tpg.RetryOptions{
 			RetryFunc: func() error {
-				_, reqErr := config.NewResourceManagerV3Client(userAgent).Folders.Patch(d.Id(), &resourceManagerV3.Folder{
+				var reqErr error
+				op, reqErr = config.NewResourceManagerV3Client(userAgent).Folders.Patch(d.Id(), &resourceManagerV3.Folder{
 					DisplayName: displayName,
-				}).Do()
+				}).UpdateMask("display_name").Do()
 				return reqErr
 			},
+			Timeout: d.Timeout(schema.TimeoutUpdate),
 		})
 		if err != nil {
 			return fmt.Errorf("Error updating display_name to '%s': %s", displayName, err)
 		}
+
+		opAsMap, err := tpgresource.ConvertToMap(op)
+		if err != nil {
+			return err
+		}
+
+		err = ResourceManagerOperationWaitTime(config, opAsMap, "update folder", userAgent, d.Timeout(schema.TimeoutUpdate))
+		if err != nil {
+			return fmt.Errorf("Error updating display_name to '%s': %s", displayName, err)
+		}
 	}
 
 	if d.HasChange("parent") {
@@ -197,6 +304,7 @@ func resourceGoogleFolderUpdate(d *schema.ResourceData, meta interface{}) error
 				}).Do()
 				return reqErr
 			},
+			Timeout: d.Timeout(schema.TimeoutUpdate),
 		})
 		if err != nil {
 			return fmt.Errorf("Error moving folder '%s' to '%s': %s", displayName, newParent, err)
@@ -215,7 +323,7 @@ func resourceGoogleFolderUpdate(d *schema.ResourceData, meta interface{}) error
 
 	d.Partial(false)
 
-	return nil
+	return resourceGoogleFolderRead(d, meta)
 }
 
 func resourceGoogleFolderDelete(d *schema.ResourceData, meta interface{}) error {
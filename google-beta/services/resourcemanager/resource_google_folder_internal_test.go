@@ -0,0 +1,65 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+package resourcemanager
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-google-beta/google-beta/tpgresource"
+	"google.golang.org/api/cloudresourcemanager/v1"
+	resourceManagerV3 "google.golang.org/api/cloudresourcemanager/v3"
+	"google.golang.org/api/googleapi"
+)
+
+func TestFindDeletedFolder(t *testing.T) {
+	folders := []*resourceManagerV3.Folder{
+		{Name: "folders/1", Parent: "organizations/1", DisplayName: "Team ABC", State: "ACTIVE"},
+		{Name: "folders/2", Parent: "organizations/1", DisplayName: "Team XYZ", State: "DELETE_REQUESTED"},
+		{Name: "folders/3", Parent: "organizations/1", DisplayName: "Team ABC", State: "DELETE_REQUESTED"},
+		{Name: "folders/4", Parent: "folders/9", DisplayName: "Team DEF", State: "DELETE_REQUESTED"},
+	}
+
+	cases := map[string]struct {
+		Parent      string
+		DisplayName string
+		Expected    string
+	}{
+		"deleted folder":             {Parent: "organizations/1", DisplayName: "Team XYZ", Expected: "folders/2"},
+		"active and deleted folders": {Parent: "organizations/1", DisplayName: "Team ABC", Expected: "folders/3"},
+		"no folder":                  {Parent: "organizations/1", DisplayName: "Team GHI", Expected: ""},
+		"other parent":               {Parent: "organizations/1", DisplayName: "Team DEF", Expected: ""},
+	}
+
+	for tn, tc := range cases {
+		name := ""
+		if f := findDeletedFolder(folders, tc.Parent, tc.DisplayName); f != nil {
+			name = f.Name
+		}
+		if name != tc.Expected {
+			t.Errorf("%s: expected %q, got %q", tn, tc.Expected, name)
+		}
+	}
+}
+
+func TestIsFolderAlreadyExistsError(t *testing.T) {
+	cases := map[string]struct {
+		Err      error
+		Expected bool
+	}{
+		"conflict":              {Err: &googleapi.Error{Code: 409}, Expected: true},
+		"wrapped conflict":      {Err: fmt.Errorf("creating folder: %w", &googleapi.Error{Code: 409}), Expected: true},
+		"permission denied":     {Err: &googleapi.Error{Code: 403}},
+		"unavailable":           {Err: &googleapi.Error{Code: 503}},
+		"operation conflict":    {Err: &tpgresource.CommonOpError{Status: &cloudresourcemanager.Status{Code: 6}}, Expected: true},
+		"operation other error": {Err: &tpgresource.CommonOpError{Status: &cloudresourcemanager.Status{Code: 13}}},
+		"other error":           {Err: errors.New("timeout while waiting")},
+	}
+
+	for tn, tc := range cases {
+		if got := isFolderAlreadyExistsError(tc.Err); got != tc.Expected {
+			t.Errorf("%s: expected %t, got %t", tn, tc.Expected, got)
+		}
+	}
+}
@@ -89,6 +89,45 @@ func TestAccFolder_moveParent(t *testing.T) {
 	})
 }
 
+// Recreating a folder with the name of a folder pending deletion restores the deleted folder.
+func TestAccFolder_undeleteOnConflict(t *testing.T) {
+	t.Parallel()
+
+	folderDisplayName := "tf-test-" + acctest.RandString(t, 10)
+	org := envvar.GetTestOrgFromEnv(t)
+	parent := "organizations/" + org
+	deleted := resourceManagerV3.Folder{}
+	restored := resourceManagerV3.Folder{}
+
+	acctest.VcrTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.AccTestPreCheck(t) },
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories(t),
+		CheckDestroy:             testAccCheckGoogleFolderDestroyProducer(t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFolder_basic(folderDisplayName, parent),
+				Check:  testAccCheckGoogleFolderExists(t, "google_folder.folder1", &deleted),
+			},
+			{
+				Config: testAccFolder_none(),
+			},
+			{
+				Config: testAccFolder_basic(folderDisplayName, parent),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckGoogleFolderExists(t, "google_folder.folder1", &restored),
+					resource.TestCheckResourceAttr("google_folder.folder1", "lifecycle_state", "ACTIVE"),
+					func(s *terraform.State) error {
+						if restored.Name != deleted.Name {
+							return fmt.Errorf("expected folder %s to be restored, got %s", deleted.Name, restored.Name)
+						}
+						return nil
+					},
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckGoogleFolderDestroyProducer(t *testing.T) func(s *terraform.State) error {
 	return func(s *terraform.State) error {
 		config := acctest.GoogleProviderConfig(t)
@@ -172,3 +211,9 @@ resource "google_folder" "folder2" {
 }
 `, folder1, folder2, parent)
 }
+
+func testAccFolder_none() string {
+	return `
+# The folder is deleted.
+`
+}
@@ -3,6 +3,7 @@
 package resourcemanager
 
 import (
+	"context"
 	"fmt"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -24,6 +25,8 @@ func ResourceGoogleOrganizationIamCustomRole() *schema.Resource {
 			State: schema.ImportStatePassthrough,
 		},
 
+		CustomizeDiff: validateOrganizationIamCustomRolePermissions,
+
 		Schema: map[string]*schema.Schema{
 			"role_id": {
 				Type:         schema.TypeString,
@@ -47,7 +50,7 @@ func ResourceGoogleOrganizationIamCustomRole() *schema.Resource {
 				Type:        schema.TypeSet,
 				Required:    true,
 				MinItems:    1,
-				Description: `The names of the permissions this role grants when bound in an IAM policy. At least one permission must be specified.`,
+				Description: `The names of the permissions this role grants when bound in an IAM policy. At least one permission must be specified. Permissions are checked at plan time against the permissions that can be used in custom roles for the organization.`,
 				Elem:        &schema.Schema{Type: schema.TypeString},
 			},
 			"stage": {
@@ -78,6 +81,18 @@ func ResourceGoogleOrganizationIamCustomRole() *schema.Resource {
 	}
 }
 
+func validateOrganizationIamCustomRolePermissions(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	orgId, ok := d.GetOk("org_id")
+	if !ok || !d.NewValueKnown("org_id") || !d.HasChange("permissions") || !d.NewValueKnown("permissions") {
+		return nil
+	}
+
+	config := meta.(*transport_tpg.Config)
+	// A ResourceDiff doesn't expose provider_meta, so the module name can't be
+	// added to the user agent with GenerateUserAgentString.
+	return validateIamCustomRolePermissions(config, config.UserAgent, "//cloudresourcemanager.googleapis.com/organizations/"+orgId.(string), d.Get("permissions").(*schema.Set))
+}
+
 func resourceGoogleOrganizationIamCustomRoleCreate(d *schema.ResourceData, meta interface{}) error {
 	config := meta.(*transport_tpg.Config)
 	userAgent, err := tpgresource.GenerateUserAgentString(d, config.UserAgent)
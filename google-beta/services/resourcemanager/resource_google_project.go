@@ -15,6 +15,7 @@ import (
 	"github.com/hashicorp/errwrap"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
 	tpgcompute "github.com/hashicorp/terraform-provider-google-beta/google-beta/services/compute"
 	tpgserviceusage "github.com/hashicorp/terraform-provider-google-beta/google-beta/services/serviceusage"
@@ -23,6 +24,7 @@ import (
 	"github.com/hashicorp/terraform-provider-google-beta/google-beta/verify"
 	"google.golang.org/api/cloudbilling/v1"
 	"google.golang.org/api/cloudresourcemanager/v1"
+	resourceManagerV3 "google.golang.org/api/cloudresourcemanager/v3"
 	"google.golang.org/api/googleapi"
 	"google.golang.org/api/serviceusage/v1"
 )
@@ -72,7 +74,14 @@ func ResourceGoogleProject() *schema.Resource {
 				Type:        schema.TypeBool,
 				Optional:    true,
 				Computed:    true,
-				Description: `If true, the Terraform resource can be deleted without deleting the Project via the Google API.`,
+				Description: `If true, the Terraform resource can be deleted without deleting the Project via the Google API. Equivalent to setting deletion_policy to ABANDON.`,
+			},
+			"deletion_policy": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "DELETE",
+				ValidateFunc: validation.StringInSlice([]string{"PREVENT", "ABANDON", "DELETE"}, false),
+				Description:  `The deletion policy for the Project. Setting PREVENT makes destroying the resource fail, and ABANDON removes the resource from state without deleting the Project. Possible values are: "PREVENT", "ABANDON", "DELETE". Defaults to "DELETE".`,
 			},
 			"auto_create_network": {
 				Type:        schema.TypeBool,
@@ -90,14 +99,14 @@ func ResourceGoogleProject() *schema.Resource {
 				Type:          schema.TypeString,
 				Optional:      true,
 				ConflictsWith: []string{"folder_id"},
-				Description:   `The numeric ID of the organization this project belongs to. Changing this forces a new project to be created.  Only one of org_id or folder_id may be specified. If the org_id is specified then the project is created at the top level. Changing this forces the project to be migrated to the newly specified organization.`,
+				Description:   `The numeric ID of the organization this project belongs to. Only one of org_id or folder_id may be specified. If the org_id is specified then the project is created at the top level. Changing this moves the project to the newly specified organization in place.`,
 			},
 			"folder_id": {
 				Type:          schema.TypeString,
 				Optional:      true,
 				StateFunc:     ParseFolderId,
 				ConflictsWith: []string{"org_id"},
-				Description:   `The numeric ID of the folder this project should be created under. Only one of org_id or folder_id may be specified. If the folder_id is specified, then the project is created under the specified folder. Changing this forces the project to be migrated to the newly specified folder.`,
+				Description:   `The numeric ID of the folder this project should be created under. Only one of org_id or folder_id may be specified. If the folder_id is specified, then the project is created under the specified folder. Changing this moves the project to the newly specified folder in place.`,
 			},
 			"number": {
 				Type:        schema.TypeString,
@@ -291,8 +300,35 @@ func resourceGoogleProjectRead(d *schema.ResourceData, meta interface{}) error {
 	}
 	parts := strings.Split(d.Id(), "/")
 	pid := parts[len(parts)-1]
+	timeout := d.Timeout(schema.TimeoutRead)
 
-	p, err := readGoogleProject(d, config, userAgent)
+	// The project and its billing info are read from different APIs, so
+	// read them concurrently. The billing info is only used if the project
+	// exists, so its read is cancelled once the project read fails.
+	var p *cloudresourcemanager.Project
+	var ba *cloudbilling.ProjectBillingInfo
+	var billingErr error
+	err = tpgresource.ConcurrentReads(context.Background(),
+		func(context.Context) (readErr error) {
+			p, readErr = readGoogleProjectWithTimeout(pid, config, userAgent, timeout)
+			return readErr
+		},
+		func(ctx context.Context) error {
+			billingErr = transport_tpg.Retry(transport_tpg.RetryOptions{
+				RetryFunc: func() (reqErr error) {
+					if ctx.Err() != nil {
+						return ctx.Err()
+					}
+					ba, reqErr = config.NewBillingClient(userAgent).Projects.GetBillingInfo(PrefixedProject(pid)).Context(ctx).Do()
+					return reqErr
+				},
+				Timeout: timeout,
+			})
+			// Errors reading the billing info are handled once the project
+			// is known to exist.
+			return nil
+		},
+	)
 	if err != nil {
 		if gerr, ok := err.(*googleapi.Error); ok && gerr.Code == 403 && strings.Contains(gerr.Message, "caller does not have permission") {
 			return fmt.Errorf("the user does not have permission to access Project %q or it may not exist", pid)
@@ -307,6 +343,12 @@ func resourceGoogleProjectRead(d *schema.ResourceData, meta interface{}) error {
 		return nil
 	}
 
+	// Explicitly set virtual fields to default values if unset
+	if _, ok := d.GetOkExists("deletion_policy"); !ok {
+		if err := d.Set("deletion_policy", "DELETE"); err != nil {
+			return fmt.Errorf("Error setting deletion_policy: %s", err)
+		}
+	}
 	if err := d.Set("project_id", pid); err != nil {
 		return fmt.Errorf("Error setting project_id: %s", err)
 	}
@@ -345,19 +387,11 @@ func resourceGoogleProjectRead(d *schema.ResourceData, meta interface{}) error {
 		}
 	}
 
-	var ba *cloudbilling.ProjectBillingInfo
-	err = transport_tpg.Retry(transport_tpg.RetryOptions{
-		RetryFunc: func() (reqErr error) {
-			ba, reqErr = config.NewBillingClient(userAgent).Projects.GetBillingInfo(PrefixedProject(pid)).Do()
-			return reqErr
-		},
-		Timeout: d.Timeout(schema.TimeoutRead),
-	})
 	// Read the billing account
-	if err != nil && !transport_tpg.IsApiNotEnabledError(err) {
-		return fmt.Errorf("Error reading billing account for project %q: %v", PrefixedProject(pid), err)
-	} else if transport_tpg.IsApiNotEnabledError(err) {
-		log.Printf("[WARN] Billing info API not enabled, please enable it to read billing info about project %q: %s", pid, err.Error())
+	if billingErr != nil && !transport_tpg.IsApiNotEnabledError(billingErr) {
+		return fmt.Errorf("Error reading billing account for project %q: %v", PrefixedProject(pid), billingErr)
+	} else if transport_tpg.IsApiNotEnabledError(billingErr) {
+		log.Printf("[WARN] Billing info API not enabled, please enable it to read billing info about project %q: %s", pid, billingErr.Error())
 	} else if ba.BillingAccountName != "" {
 		// BillingAccountName is contains the resource name of the billing account
 		// associated with the project, if any. For example,
@@ -448,12 +482,12 @@ func resourceGoogleProjectUpdate(d *schema.ResourceData, meta interface{}) error
 
 	// Project parent has changed
 	if d.HasChange("org_id") || d.HasChange("folder_id") {
-		if err := getParentResourceId(d, p); err != nil {
+		if err := moveProject(config, d, userAgent, p); err != nil {
 			return err
 		}
 
-		// Do update on project
-		if p, err = updateProject(config, d, project_name, userAgent, p); err != nil {
+		// Keep the parent of later updates in sync with the move
+		if err := getParentResourceId(d, p); err != nil {
 			return err
 		}
 	}
@@ -494,30 +528,164 @@ func updateProject(config *transport_tpg.Config, d *schema.ResourceData, project
 	return newProj, nil
 }
 
+// The permission needed on a project, its current parent and its destination to move it.
+const projectMovePermission = "resourcemanager.projects.move"
+
+// moveProject moves the project p to the parent set in d, using projects.move.
+func moveProject(config *transport_tpg.Config, d *schema.ResourceData, userAgent string, p *cloudresourcemanager.Project) error {
+	desired := &cloudresourcemanager.Project{}
+	if err := getParentResourceId(d, desired); err != nil {
+		return err
+	}
+	destination := projectParentName(desired)
+	if destination == "" {
+		log.Printf("[WARN] Project %q can't be removed from its parent, leaving it in %q", p.ProjectId, projectParentName(p))
+		return nil
+	}
+
+	name := PrefixedProject(p.ProjectId)
+	resources := []string{name, destination}
+	if current := projectParentName(p); current != "" {
+		resources = append(resources, current)
+	}
+	if missing := missingProjectMovePermission(config, userAgent, resources); len(missing) > 0 {
+		return fmt.Errorf("Error moving project %q to %q: the caller lacks the %s permission on %s. It is granted by roles/resourcemanager.projectMover, which is needed on the project and on both its current and destination parents",
+			p.ProjectId, destination, projectMovePermission, strings.Join(missing, ", "))
+	}
+
+	var op *resourceManagerV3.Operation
+	err := transport_tpg.Retry(transport_tpg.RetryOptions{
+		RetryFunc: func() (reqErr error) {
+			op, reqErr = config.NewResourceManagerV3Client(userAgent).Projects.Move(name, &resourceManagerV3.MoveProjectRequest{
+				DestinationParent: destination,
+			}).Do()
+			return reqErr
+		},
+		Timeout: d.Timeout(schema.TimeoutUpdate),
+	})
+	if err != nil {
+		if transport_tpg.IsGoogleApiErrorWithCode(err, 403) {
+			return fmt.Errorf("Error moving project %q to %q: %s. Moving a project requires roles/resourcemanager.projectMover on the project and on both its current and destination parents, and may be denied by the constraints/resourcemanager.allowedExportDestinations and allowedImportSources organization policies", p.ProjectId, destination, err)
+		}
+		return fmt.Errorf("Error moving project %q to %q: %s", p.ProjectId, destination, err)
+	}
+
+	opAsMap, err := tpgresource.ConvertToMap(op)
+	if err != nil {
+		return err
+	}
+
+	err = ResourceManagerOperationWaitTime(config, opAsMap, "moving project", userAgent, d.Timeout(schema.TimeoutUpdate))
+	if err != nil {
+		return fmt.Errorf("Error moving project %q to %q: %s", p.ProjectId, destination, err)
+	}
+	return nil
+}
+
+// missingProjectMovePermission returns the resources on which the caller lacks the permission
+// to move a project. Resources that can't be checked aren't returned, leaving the move itself
+// to report any error.
+func missingProjectMovePermission(config *transport_tpg.Config, userAgent string, resources []string) []string {
+	client := config.NewResourceManagerV3Client(userAgent)
+	req := &resourceManagerV3.TestIamPermissionsRequest{
+		Permissions: []string{projectMovePermission},
+	}
+
+	var missing []string
+	for _, resource := range resources {
+		var res *resourceManagerV3.TestIamPermissionsResponse
+		var err error
+		switch {
+		case strings.HasPrefix(resource, "projects/"):
+			res, err = client.Projects.TestIamPermissions(resource, req).Do()
+		case strings.HasPrefix(resource, "folders/"):
+			res, err = client.Folders.TestIamPermissions(resource, req).Do()
+		case strings.HasPrefix(resource, "organizations/"):
+			res, err = client.Organizations.TestIamPermissions(resource, req).Do()
+		default:
+			continue
+		}
+		if err != nil {
+			log.Printf("[WARN] Unable to check the permission to move projects on %s: %s", resource, err)
+			continue
+		}
+		if !tpgresource.StringInSlice(res.Permissions, projectMovePermission) {
+			missing = append(missing, resource)
+		}
+	}
+	return missing
+}
+
+// projectParentName returns the resource name of the parent of p, such as folders/123, or ""
+// if it has none.
+func projectParentName(p *cloudresourcemanager.Project) string {
+	if p.Parent == nil || p.Parent.Id == "" {
+		return ""
+	}
+	switch p.Parent.Type {
+	case "organization":
+		return "organizations/" + p.Parent.Id
+	case "folder":
+		return "folders/" + p.Parent.Id
+	}
+	return ""
+}
+
 func resourceGoogleProjectDelete(d *schema.ResourceData, meta interface{}) error {
 	config := meta.(*transport_tpg.Config)
 	userAgent, err := tpgresource.GenerateUserAgentString(d, config.UserAgent)
 	if err != nil {
 		return err
 	}
+	parts := strings.Split(d.Id(), "/")
+	pid := parts[len(parts)-1]
+
+	deletionPolicy := d.Get("deletion_policy").(string)
+	if deletionPolicy == "PREVENT" {
+		return fmt.Errorf("Cannot destroy project %q without setting deletion_policy to ABANDON or DELETE and running `terraform apply`", pid)
+	}
+
 	// Only delete projects if skip_delete isn't set
-	if !d.Get("skip_delete").(bool) {
-		parts := strings.Split(d.Id(), "/")
-		pid := parts[len(parts)-1]
-		if err := transport_tpg.Retry(transport_tpg.RetryOptions{
-			RetryFunc: func() error {
-				_, delErr := config.NewResourceManagerClient(userAgent).Projects.Delete(pid).Do()
-				return delErr
-			},
-			Timeout: d.Timeout(schema.TimeoutDelete),
-		}); err != nil {
-			return transport_tpg.HandleNotFoundError(err, d, fmt.Sprintf("Project %s", pid))
+	if deletionPolicy == "ABANDON" || d.Get("skip_delete").(bool) {
+		log.Printf("[WARN] Project %q is abandoned rather than deleted, removing it from state", pid)
+		d.SetId("")
+		return nil
+	}
+
+	if err := transport_tpg.Retry(transport_tpg.RetryOptions{
+		RetryFunc: func() error {
+			_, delErr := config.NewResourceManagerClient(userAgent).Projects.Delete(pid).Do()
+			return delErr
+		},
+		Timeout: d.Timeout(schema.TimeoutDelete),
+	}); err != nil {
+		if !transport_tpg.IsGoogleApiErrorWithCode(err, 404) {
+			if liens := listProjectLiens(config, userAgent, pid); len(liens) > 0 {
+				return fmt.Errorf("Error deleting project %q, which has liens that must be removed first: %s: %s", pid, strings.Join(liens, "; "), err)
+			}
 		}
+		return transport_tpg.HandleNotFoundError(err, d, fmt.Sprintf("Project %s", pid))
 	}
 	d.SetId("")
 	return nil
 }
 
+// listProjectLiens describes the liens placed on a project, which prevent deleting it. Errors
+// listing them are only logged, as the liens are only used to explain why the deletion failed.
+func listProjectLiens(config *transport_tpg.Config, userAgent, pid string) []string {
+	var liens []string
+	err := config.NewResourceManagerClient(userAgent).Liens.List().Parent(PrefixedProject(pid)).Pages(config.Context, func(res *cloudresourcemanager.ListLiensResponse) error {
+		for _, l := range res.Liens {
+			liens = append(liens, fmt.Sprintf("%s (origin %q, reason %q)", l.Name, l.Origin, l.Reason))
+		}
+		return nil
+	})
+	if err != nil {
+		log.Printf("[WARN] Unable to list the liens on project %q: %s", pid, err)
+	}
+	return liens
+}
+
 func resourceProjectImportState(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
 	parts := strings.Split(d.Id(), "/")
 	pid := parts[len(parts)-1]
@@ -539,6 +707,9 @@ func resourceProjectImportState(d *schema.ResourceData, meta interface{}) ([]*sc
 	if err := d.Set("auto_create_network", true); err != nil {
 		return nil, fmt.Errorf("Error setting auto_create_network: %s", err)
 	}
+	if err := d.Set("deletion_policy", "DELETE"); err != nil {
+		return nil, fmt.Errorf("Error setting deletion_policy: %s", err)
+	}
 	return []*schema.ResourceData{d}, nil
 }
 
@@ -647,16 +818,22 @@ func deleteComputeNetwork(project, network, userAgent string, config *transport_
 }
 
 func readGoogleProject(d *schema.ResourceData, config *transport_tpg.Config, userAgent string) (*cloudresourcemanager.Project, error) {
-	var p *cloudresourcemanager.Project
 	// Read the project
 	parts := strings.Split(d.Id(), "/")
 	pid := parts[len(parts)-1]
+	return readGoogleProjectWithTimeout(pid, config, userAgent, d.Timeout(schema.TimeoutRead))
+}
+
+// readGoogleProjectWithTimeout reads a project without accessing its
+// ResourceData, so that it can be used with tpgresource.ConcurrentReads.
+func readGoogleProjectWithTimeout(pid string, config *transport_tpg.Config, userAgent string, timeout time.Duration) (*cloudresourcemanager.Project, error) {
+	var p *cloudresourcemanager.Project
 	err := transport_tpg.Retry(transport_tpg.RetryOptions{
 		RetryFunc: func() (reqErr error) {
 			p, reqErr = config.NewResourceManagerClient(userAgent).Projects.Get(pid).Do()
 			return reqErr
 		},
-		Timeout: d.Timeout(schema.TimeoutRead),
+		Timeout: timeout,
 	})
 	return p, err
 }
@@ -9,8 +9,10 @@ import (
 
 	"github.com/hashicorp/terraform-provider-google-beta/google-beta/acctest"
 	"github.com/hashicorp/terraform-provider-google-beta/google-beta/envvar"
+	"github.com/hashicorp/terraform-provider-google-beta/google-beta/services/resourcemanager"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 )
 
 func projectIamAuditConfigImportStep(resourceName, pid, service string) resource.TestStep {
@@ -49,6 +51,33 @@ func TestAccProjectIamAuditConfig_basic(t *testing.T) {
 	})
 }
 
+// Test that changing the service of an IAM audit config doesn't leave the old
+// service's audit config behind
+func TestAccProjectIamAuditConfig_changeService(t *testing.T) {
+	t.Parallel()
+
+	org := envvar.GetTestOrgFromEnv(t)
+	pid := fmt.Sprintf("tf-test-%d", acctest.RandInt(t))
+	service := "cloudkms.googleapis.com"
+	service2 := "cloudsql.googleapis.com"
+	acctest.VcrTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.AccTestPreCheck(t) },
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories(t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProjectAssociateAuditConfigBasic(pid, org, service),
+			},
+			{
+				Config: testAccProjectAssociateAuditConfigBasic(pid, org, service2),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckProjectIamAuditConfigAbsent(t, pid, service),
+				),
+			},
+			projectIamAuditConfigImportStep("google_project_iam_audit_config.acceptance", pid, service2),
+		},
+	})
+}
+
 // Test that multiple IAM audit configs can be applied to a project, one at a time
 func TestAccProjectIamAuditConfig_multiple(t *testing.T) {
 	t.Parallel()
@@ -440,3 +469,20 @@ resource "google_project_iam_audit_config" "acceptance" {
 }
 `, pid, pid, org, service, logType)
 }
+
+func testAccCheckProjectIamAuditConfigAbsent(t *testing.T, pid, service string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		config := acctest.GoogleProviderConfig(t)
+		policy, err := resourcemanager.GetProjectIamPolicy(pid, config)
+		if err != nil {
+			return err
+		}
+
+		for _, ac := range policy.AuditConfigs {
+			if ac.Service == service {
+				return fmt.Errorf("Audit config for service %s is still present in the policy of project %s", service, pid)
+			}
+		}
+		return nil
+	}
+}
@@ -3,7 +3,10 @@
 package resourcemanager
 
 import (
+	"context"
 	"fmt"
+	"log"
+	"sort"
 	"strings"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
@@ -28,6 +31,7 @@ func ResourceGoogleProjectIamCustomRole() *schema.Resource {
 
 		CustomizeDiff: customdiff.All(
 			tpgresource.DefaultProviderProject,
+			validateProjectIamCustomRolePermissions,
 		),
 
 		Schema: map[string]*schema.Schema{
@@ -47,7 +51,7 @@ func ResourceGoogleProjectIamCustomRole() *schema.Resource {
 				Type:        schema.TypeSet,
 				Required:    true,
 				MinItems:    1,
-				Description: `The names of the permissions this role grants when bound in an IAM policy. At least one permission must be specified.`,
+				Description: `The names of the permissions this role grants when bound in an IAM policy. At least one permission must be specified. Permissions are checked at plan time against the permissions that can be used in custom roles for the project.`,
 				Elem:        &schema.Schema{Type: schema.TypeString},
 			},
 			"project": {
@@ -85,6 +89,71 @@ func ResourceGoogleProjectIamCustomRole() *schema.Resource {
 	}
 }
 
+func validateProjectIamCustomRolePermissions(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	project, ok := d.GetOk("project")
+	if !ok || !d.NewValueKnown("project") || !d.HasChange("permissions") || !d.NewValueKnown("permissions") {
+		return nil
+	}
+
+	config := meta.(*transport_tpg.Config)
+	// A ResourceDiff doesn't expose provider_meta, so the module name can't be
+	// added to the user agent with GenerateUserAgentString.
+	return validateIamCustomRolePermissions(config, config.UserAgent, "//cloudresourcemanager.googleapis.com/projects/"+project.(string), d.Get("permissions").(*schema.Set))
+}
+
+// validateIamCustomRolePermissions checks the permissions of a custom role
+// against the permissions that can be tested on the resource owning the role.
+// If the testable permissions can't be listed, the check is skipped and the
+// API reports any invalid permission on apply.
+func validateIamCustomRolePermissions(config *transport_tpg.Config, userAgent, fullResourceName string, permissions *schema.Set) error {
+	testable := map[string]string{}
+	req := &iam.QueryTestablePermissionsRequest{
+		FullResourceName: fullResourceName,
+		PageSize:         1000,
+	}
+	for {
+		resp, err := config.NewIamClient(userAgent).Permissions.QueryTestablePermissions(req).Do()
+		if err != nil {
+			log.Printf("[WARN] Unable to list the testable permissions of %s, skipping permission validation: %s", fullResourceName, err)
+			return nil
+		}
+		for _, p := range resp.Permissions {
+			testable[p.Name] = p.CustomRolesSupportLevel
+		}
+		if resp.NextPageToken == "" {
+			break
+		}
+		req.PageToken = resp.NextPageToken
+	}
+
+	return validateIamCustomRolePermissionsFunc(tpgresource.ConvertStringSet(permissions), testable, fullResourceName)
+}
+
+func validateIamCustomRolePermissionsFunc(permissions []string, testable map[string]string, fullResourceName string) error {
+	var unknown, unsupported []string
+	for _, p := range permissions {
+		level, ok := testable[p]
+		if !ok {
+			unknown = append(unknown, p)
+			continue
+		}
+		// An empty support level means SUPPORTED, which is the zero value.
+		if level == "NOT_SUPPORTED" {
+			unsupported = append(unsupported, p)
+		}
+	}
+
+	if len(unknown) > 0 {
+		sort.Strings(unknown)
+		return fmt.Errorf("permissions %s are not valid for custom roles on %s", strings.Join(unknown, ", "), fullResourceName)
+	}
+	if len(unsupported) > 0 {
+		sort.Strings(unsupported)
+		return fmt.Errorf("permissions %s are not supported in custom roles", strings.Join(unsupported, ", "))
+	}
+	return nil
+}
+
 func resourceGoogleProjectIamCustomRoleCreate(d *schema.ResourceData, meta interface{}) error {
 	config := meta.(*transport_tpg.Config)
 	userAgent, err := tpgresource.GenerateUserAgentString(d, config.UserAgent)
@@ -0,0 +1,48 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+package resourcemanager
+
+import (
+	"testing"
+)
+
+func TestValidateIamCustomRolePermissions(t *testing.T) {
+	t.Parallel()
+
+	testable := map[string]string{
+		"iam.roles.list":                        "",
+		"iam.roles.create":                      "TESTING",
+		"resourcemanager.projects.setIamPolicy": "",
+		"resourcemanager.projects.list":         "NOT_SUPPORTED",
+	}
+
+	cases := map[string]struct {
+		Permissions []string
+		ExpectError bool
+	}{
+		"supported permissions": {
+			Permissions: []string{"iam.roles.list", "resourcemanager.projects.setIamPolicy"},
+		},
+		"permission in testing": {
+			Permissions: []string{"iam.roles.create"},
+		},
+		"unknown permission": {
+			Permissions: []string{"iam.roles.list", "iam.roles.fly"},
+			ExpectError: true,
+		},
+		"unsupported permission": {
+			Permissions: []string{"resourcemanager.projects.list"},
+			ExpectError: true,
+		},
+	}
+
+	for tn, tc := range cases {
+		err := validateIamCustomRolePermissionsFunc(tc.Permissions, testable, "//cloudresourcemanager.googleapis.com/projects/my-project")
+		if tc.ExpectError && err == nil {
+			t.Errorf("%s: expected an error", tn)
+		}
+		if !tc.ExpectError && err != nil {
+			t.Errorf("%s: unexpected error: %s", tn, err)
+		}
+	}
+}
@@ -0,0 +1,39 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+package resourcemanager
+
+import (
+	"testing"
+
+	"google.golang.org/api/cloudresourcemanager/v1"
+)
+
+func TestProjectParentName(t *testing.T) {
+	cases := map[string]struct {
+		Parent   *cloudresourcemanager.ResourceId
+		Expected string
+	}{
+		"organization": {
+			Parent:   &cloudresourcemanager.ResourceId{Type: "organization", Id: "123"},
+			Expected: "organizations/123",
+		},
+		"folder": {
+			Parent:   &cloudresourcemanager.ResourceId{Type: "folder", Id: "456"},
+			Expected: "folders/456",
+		},
+		"no parent": {
+			Parent:   nil,
+			Expected: "",
+		},
+		"unknown parent type": {
+			Parent:   &cloudresourcemanager.ResourceId{Type: "unknown", Id: "789"},
+			Expected: "",
+		},
+	}
+
+	for tn, tc := range cases {
+		if got := projectParentName(&cloudresourcemanager.Project{Parent: tc.Parent}); got != tc.Expected {
+			t.Errorf("%s: expected %q, got %q", tn, tc.Expected, got)
+		}
+	}
+}
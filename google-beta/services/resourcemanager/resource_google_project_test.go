@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"os"
 	"reflect"
+	"regexp"
 	"strconv"
 	"strings"
 	"testing"
@@ -70,6 +71,40 @@ func TestAccProject_create(t *testing.T) {
 	})
 }
 
+// Test that a Project resource with deletion_policy PREVENT can't be destroyed
+func TestAccProject_deletionPolicyPrevent(t *testing.T) {
+	t.Parallel()
+
+	org := envvar.GetTestOrgFromEnv(t)
+	pid := fmt.Sprintf("%s-%d", TestPrefix, acctest.RandInt(t))
+	acctest.VcrTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.AccTestPreCheck(t) },
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories(t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProject_deletionPolicy(pid, org, "PREVENT"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckGoogleProjectExists("google_project.acceptance", pid),
+				),
+			},
+			{
+				Config:      testAccProject_deletionPolicy(pid, org, "PREVENT"),
+				Destroy:     true,
+				ExpectError: regexp.MustCompile("Cannot destroy project"),
+			},
+			{
+				Config: testAccProject_deletionPolicy(pid, org, "DELETE"),
+			},
+			{
+				ResourceName:            "google_project.acceptance",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"skip_delete"},
+			},
+		},
+	})
+}
+
 // Test that a Project resource can be created with an associated
 // billing account
 func TestAccProject_billing(t *testing.T) {
@@ -361,6 +396,17 @@ resource "google_project" "acceptance" {
 `, pid, pid)
 }
 
+func testAccProject_deletionPolicy(pid, org, deletionPolicy string) string {
+	return fmt.Sprintf(`
+resource "google_project" "acceptance" {
+  project_id      = "%s"
+  name            = "%s"
+  org_id          = "%s"
+  deletion_policy = "%s"
+}
+`, pid, pid, org, deletionPolicy)
+}
+
 func testAccProject_createBilling(pid, org, billing string) string {
 	return fmt.Sprintf(`
 resource "google_project" "acceptance" {
@@ -65,7 +65,7 @@ func ResourceGoogleServiceAccount() *schema.Resource {
 				Type:        schema.TypeBool,
 				Optional:    true,
 				Default:     false,
-				Description: `Whether the service account is disabled. Defaults to false`,
+				Description: `Whether the service account is disabled. Defaults to false. If set to true on creation, the service account is disabled right after it is created.`,
 			},
 			"description": {
 				Type:         schema.TypeString,
@@ -154,12 +154,22 @@ func resourceGoogleServiceAccountCreate(d *schema.ResourceData, meta interface{}
 
 	// We poll until the resource is found due to eventual consistency issue
 	// on part of the api https://cloud.google.com/iam/docs/overview#consistency
-	err = transport_tpg.PollingWaitTime(resourceServiceAccountPollRead(d, meta), transport_tpg.PollCheckForExistence, "Creating Service Account", d.Timeout(schema.TimeoutCreate), 1)
+	err = transport_tpg.PollReadAfterCreate(resourceServiceAccountPollRead(d, meta), transport_tpg.PollCheckForExistence, "Creating Service Account", d.Timeout(schema.TimeoutCreate))
 
 	if err != nil {
 		return err
 	}
 
+	// Service accounts are always created enabled, so disable it separately
+	// when requested instead of leaving a diff for the next plan.
+	if d.Get("disabled").(bool) {
+		_, err = config.NewIamClient(userAgent).Projects.ServiceAccounts.Disable(d.Id(),
+			&iam.DisableServiceAccountRequest{}).Do()
+		if err != nil {
+			return fmt.Errorf("Error disabling service account %q: %s", d.Id(), err)
+		}
+	}
+
 	return resourceGoogleServiceAccountRead(d, meta)
 }
 
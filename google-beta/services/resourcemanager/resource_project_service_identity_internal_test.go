@@ -0,0 +1,35 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+package resourcemanager
+
+import (
+	"testing"
+)
+
+func TestResourceProjectServiceIdentityRead_member(t *testing.T) {
+	d := ResourceProjectServiceIdentity().TestResourceData()
+	if err := d.Set("email", "service-123@gcp-sa-healthcare.iam.gserviceaccount.com"); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if err := resourceProjectServiceIdentityRead(d, nil); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	expected := "serviceAccount:service-123@gcp-sa-healthcare.iam.gserviceaccount.com"
+	if got := d.Get("member").(string); got != expected {
+		t.Errorf("expected member %q, got %q", expected, got)
+	}
+}
+
+func TestResourceProjectServiceIdentityRead_noEmail(t *testing.T) {
+	d := ResourceProjectServiceIdentity().TestResourceData()
+
+	if err := resourceProjectServiceIdentityRead(d, nil); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if got := d.Get("member").(string); got != "" {
+		t.Errorf("expected no member, got %q", got)
+	}
+}
@@ -28,9 +28,17 @@ func TestAccProjectServiceIdentity_basic(t *testing.T) {
 						}
 						return fmt.Errorf("hc_sa service identity email value was %s, expected a valid email", value)
 					}),
+					resource.TestCheckResourceAttrWith("google_project_service_identity.hc_sa", "member", func(value string) error {
+						email := strings.TrimPrefix(value, "serviceAccount:")
+						if email != value && strings.Contains(email, "@") {
+							return nil
+						}
+						return fmt.Errorf("hc_sa service identity member value was %s, expected serviceAccount:{email}", value)
+					}),
 					// Email field for logging service identity will be empty for as long as
 					// `gcloud beta services identity create --service=logging.googleapis.com` doesn't return an email address
 					resource.TestCheckNoResourceAttr("google_project_service_identity.log_sa", "email"),
+					resource.TestCheckNoResourceAttr("google_project_service_identity.log_sa", "member"),
 				),
 			},
 		},
@@ -72,6 +72,11 @@ func ResourceEndpointsService() *schema.Resource {
 				Computed:    true,
 				Description: `The autogenerated ID for the configuration that is rolled out as part of the creation of this resource. Must be provided to compute engine instances as a tag.`,
 			},
+			"rollout_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: `The ID of the rollout that deployed config_id to the service, as performed by the most recent apply.`,
+			},
 			"apis": {
 				Type:        schema.TypeList,
 				Computed:    true,
@@ -159,6 +164,10 @@ func predictServiceId(_ context.Context, d *schema.ResourceDiff, meta interface{
 	if !d.HasChange("openapi_config") && !d.HasChange("grpc_config") && !d.HasChange("protoc_output_base64") {
 		return nil
 	}
+	// Any config change is deployed by a new rollout.
+	if err := d.SetNewComputed("rollout_id"); err != nil {
+		return err
+	}
 	if !d.NewValueKnown("openapi_config") || !d.NewValueKnown("grpc_config") || !d.NewValueKnown("protoc_output_base64") {
 		d.SetNewComputed("config_id")
 		return nil
@@ -346,10 +355,17 @@ func resourceEndpointsServiceUpdate(d *schema.ResourceData, meta interface{}) er
 	if err != nil {
 		return err
 	}
-	_, err = ServiceManagementOperationWaitTime(config, op, "Performing service rollout.", userAgent, d.Timeout(schema.TimeoutUpdate))
+	r, err := ServiceManagementOperationWaitTime(config, op, "Performing service rollout.", userAgent, d.Timeout(schema.TimeoutUpdate))
 	if err != nil {
 		return err
 	}
+	var rolloutRes servicemanagement.Rollout
+	if err := json.Unmarshal(r, &rolloutRes); err != nil {
+		return err
+	}
+	if err := d.Set("rollout_id", rolloutRes.RolloutId); err != nil {
+		return fmt.Errorf("Error setting rollout_id: %s", err)
+	}
 
 	return resourceEndpointsServiceRead(d, meta)
 }
@@ -29,7 +29,10 @@ func TestAccEndpointsService_basic(t *testing.T) {
 		Steps: []resource.TestStep{
 			{
 				Config: testAccEndpointsService_basic(serviceId, envvar.GetTestProjectFromEnv(), "1"),
-				Check:  testAccCheckEndpointExistsByName(t, serviceId),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckEndpointExistsByName(t, serviceId),
+					resource.TestCheckResourceAttrSet("google_endpoints_service.endpoints_service", "rollout_id"),
+				),
 			},
 			{
 				Config: testAccEndpointsService_basic(serviceId, envvar.GetTestProjectFromEnv(), "2"),
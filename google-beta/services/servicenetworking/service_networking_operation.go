@@ -35,5 +35,5 @@ func ServiceNetworkingOperationWaitTime(config *transport_tpg.Config, op *servic
 	if err := w.SetOp(op); err != nil {
 		return err
 	}
-	return tpgresource.OperationWait(w, activity, timeout, config.PollInterval)
+	return tpgresource.OperationWaitContext(config.Context, w, activity, timeout, config.PollInterval)
 }
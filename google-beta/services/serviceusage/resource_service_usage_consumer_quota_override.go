@@ -53,9 +53,10 @@ func ResourceServiceUsageConsumerQuotaOverride() *schema.Resource {
 
 		Schema: map[string]*schema.Schema{
 			"limit": {
-				Type:     schema.TypeString,
-				Required: true,
-				ForceNew: true,
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateServiceUsageConsumerQuotaOverrideLimit,
 				Description: `The limit on the metric, e.g. '/project/region'.
 
 ~> Make sure that 'limit' is in a format that doesn't start with '1/' or contain curly braces.
@@ -68,9 +69,10 @@ E.g. use '/project/user' instead of '1/{project}/{user}'.`,
 				Description: `The metric that should be limited, e.g. 'compute.googleapis.com/cpus'.`,
 			},
 			"override_value": {
-				Type:        schema.TypeString,
-				Required:    true,
-				Description: `The overriding quota limit value. Can be any nonnegative integer, or -1 (unlimited quota).`,
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validateServiceUsageConsumerQuotaOverrideValue,
+				Description:  `The overriding quota limit value. Can be any nonnegative integer, or -1 (unlimited quota).`,
 			},
 			"service": {
 				Type:        schema.TypeString,
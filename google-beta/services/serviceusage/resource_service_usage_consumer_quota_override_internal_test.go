@@ -0,0 +1,54 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+package serviceusage
+
+import (
+	"testing"
+)
+
+func TestValidateServiceUsageConsumerQuotaOverrideLimit(t *testing.T) {
+	t.Parallel()
+
+	cases := map[string]struct {
+		value     string
+		expectErr bool
+	}{
+		"limit id":                    {value: "/project/region"},
+		"url encoded limit id":        {value: "%2Fmin%2Fproject%2Fuser"},
+		"limit unit":                  {value: "1/{project}/{region}", expectErr: true},
+		"url encoded limit unit":      {value: "1%2Fmin%2F%7Bproject%7D%2F%7Buser%7D", expectErr: true},
+		"limit id with curly braces":  {value: "/{project}/{user}", expectErr: true},
+		"limit unit without braces":   {value: "1/project/region", expectErr: true},
+		"limit id starting with ones": {value: "/1/project"},
+	}
+
+	for tn, tc := range cases {
+		_, errs := validateServiceUsageConsumerQuotaOverrideLimit(tc.value, "limit")
+		if (len(errs) > 0) != tc.expectErr {
+			t.Errorf("%s: expected error: %t, got: %v", tn, tc.expectErr, errs)
+		}
+	}
+}
+
+func TestValidateServiceUsageConsumerQuotaOverrideValue(t *testing.T) {
+	t.Parallel()
+
+	cases := map[string]struct {
+		value     string
+		expectErr bool
+	}{
+		"zero":        {value: "0"},
+		"positive":    {value: "95"},
+		"unlimited":   {value: "-1"},
+		"negative":    {value: "-2", expectErr: true},
+		"not integer": {value: "1.5", expectErr: true},
+		"empty":       {value: "", expectErr: true},
+	}
+
+	for tn, tc := range cases {
+		_, errs := validateServiceUsageConsumerQuotaOverrideValue(tc.value, "override_value")
+		if (len(errs) > 0) != tc.expectErr {
+			t.Errorf("%s: expected error: %t, got: %v", tn, tc.expectErr, errs)
+		}
+	}
+}
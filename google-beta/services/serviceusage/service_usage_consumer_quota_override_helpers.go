@@ -0,0 +1,39 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+package serviceusage
+
+import (
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+)
+
+// validateServiceUsageConsumerQuotaOverrideLimit rejects limits given in the
+// unit format (e.g. "1/{project}/{region}") that the API reports for a limit,
+// rather than as the limit id (e.g. "/project/region"). The value may be url
+// encoded, as is needed when it is used in the resource's URL.
+func validateServiceUsageConsumerQuotaOverrideLimit(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(string)
+	decoded, err := url.PathUnescape(value)
+	if err != nil {
+		decoded = value
+	}
+
+	if strings.HasPrefix(decoded, "1/") || strings.ContainsAny(decoded, "{}") {
+		suggested := strings.NewReplacer("{", "", "}", "").Replace(strings.TrimPrefix(decoded, "1"))
+		errors = append(errors, fmt.Errorf("%q (%q) looks like a limit unit rather than a limit id; use %q instead, e.g. urlencode(%q)", k, decoded, suggested, suggested))
+	}
+	return
+}
+
+// validateServiceUsageConsumerQuotaOverrideValue checks that an override value
+// is a nonnegative integer, or -1 for unlimited quota.
+func validateServiceUsageConsumerQuotaOverrideValue(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(string)
+	i, err := strconv.ParseInt(value, 10, 64)
+	if err != nil || i < -1 {
+		errors = append(errors, fmt.Errorf("%q must be a nonnegative integer or -1 (unlimited quota), got %q", k, value))
+	}
+	return
+}
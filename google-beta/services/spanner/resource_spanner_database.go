@@ -178,6 +178,16 @@ and 7 days, and can be specified in days, hours, minutes, or seconds. For exampl
 the values 1d, 24h, 1440m, and 86400s are equivalent. Default value is 1h.
 If this property is used, you must avoid adding new DDL statements to 'ddl' that
 update the database's version_retention_period.`,
+			},
+			"default_leader": {
+				Type:     schema.TypeString,
+				Computed: true,
+				Optional: true,
+				Description: `The region in which the leader replicas of the database are placed. This must be
+one of the read-write regions of the instance's multi-region configuration.
+If it is not provided, the default leader region of the instance configuration is used.
+If this property is used, you must avoid adding new DDL statements to 'ddl' that
+update the database's default_leader.`,
 			},
 			"state": {
 				Type:        schema.TypeString,
@@ -342,10 +352,12 @@ func resourceSpannerDatabaseCreate(d *schema.ResourceData, meta interface{}) err
 
 	retention, retentionPeriodOk := d.GetOk("version_retention_period")
 	retentionPeriod := retention.(string)
+	leader, defaultLeaderOk := d.GetOk("default_leader")
+	defaultLeader := leader.(string)
 	ddl, ddlOk := d.GetOk("ddl")
 	ddlStatements := ddl.([]interface{})
 
-	if retentionPeriodOk || ddlOk {
+	if retentionPeriodOk || defaultLeaderOk || ddlOk {
 
 		obj := make(map[string]interface{})
 		updateDdls := []string{}
@@ -367,6 +379,10 @@ func resourceSpannerDatabaseCreate(d *schema.ResourceData, meta interface{}) err
 			updateDdls = append(updateDdls, retentionDdl)
 		}
 
+		if defaultLeaderOk {
+			updateDdls = append(updateDdls, spannerDatabaseDefaultLeaderDdl(d.Get("name").(string), d.Get("database_dialect").(string), defaultLeader))
+		}
+
 		// Skip API call if there are no new ddl entries (due to ignoring nil values)
 		if len(updateDdls) > 0 {
 			log.Printf("[DEBUG] Applying extra DDL statements to the new Database: %#v", updateDdls)
@@ -505,6 +521,9 @@ func resourceSpannerDatabaseRead(d *schema.ResourceData, meta interface{}) error
 	if err := d.Set("version_retention_period", flattenSpannerDatabaseVersionRetentionPeriod(res["versionRetentionPeriod"], d, config)); err != nil {
 		return fmt.Errorf("Error reading Database: %s", err)
 	}
+	if err := d.Set("default_leader", flattenSpannerDatabaseDefaultLeader(res["defaultLeader"], d, config)); err != nil {
+		return fmt.Errorf("Error reading Database: %s", err)
+	}
 	if err := d.Set("state", flattenSpannerDatabaseState(res["state"], d, config)); err != nil {
 		return fmt.Errorf("Error reading Database: %s", err)
 	}
@@ -621,7 +640,7 @@ func resourceSpannerDatabaseUpdate(d *schema.ResourceData, meta interface{}) err
 	}
 	d.Partial(true)
 
-	if d.HasChange("version_retention_period") || d.HasChange("ddl") {
+	if d.HasChange("version_retention_period") || d.HasChange("default_leader") || d.HasChange("ddl") {
 		obj := make(map[string]interface{})
 
 		versionRetentionPeriodProp, err := expandSpannerDatabaseVersionRetentionPeriod(d.Get("version_retention_period"), d, config)
@@ -630,6 +649,12 @@ func resourceSpannerDatabaseUpdate(d *schema.ResourceData, meta interface{}) err
 		} else if v, ok := d.GetOkExists("version_retention_period"); !tpgresource.IsEmptyValue(reflect.ValueOf(v)) && (ok || !reflect.DeepEqual(v, versionRetentionPeriodProp)) {
 			obj["versionRetentionPeriod"] = versionRetentionPeriodProp
 		}
+		defaultLeaderProp, err := expandSpannerDatabaseDefaultLeader(d.Get("default_leader"), d, config)
+		if err != nil {
+			return err
+		} else if v, ok := d.GetOkExists("default_leader"); !tpgresource.IsEmptyValue(reflect.ValueOf(v)) && (ok || !reflect.DeepEqual(v, defaultLeaderProp)) {
+			obj["defaultLeader"] = defaultLeaderProp
+		}
 		extraStatementsProp, err := expandSpannerDatabaseDdl(d.Get("ddl"), d, config)
 		if err != nil {
 			return err
@@ -792,6 +817,10 @@ func flattenSpannerDatabaseVersionRetentionPeriod(v interface{}, d *schema.Resou
 	return v
 }
 
+func flattenSpannerDatabaseDefaultLeader(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
+	return v
+}
+
 func flattenSpannerDatabaseState(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
 	return v
 }
@@ -836,6 +865,10 @@ func expandSpannerDatabaseVersionRetentionPeriod(v interface{}, d tpgresource.Te
 	return v, nil
 }
 
+func expandSpannerDatabaseDefaultLeader(v interface{}, d tpgresource.TerraformResourceData, config *transport_tpg.Config) (interface{}, error) {
+	return v, nil
+}
+
 func expandSpannerDatabaseDdl(v interface{}, d tpgresource.TerraformResourceData, config *transport_tpg.Config) (interface{}, error) {
 	return v, nil
 }
@@ -894,6 +927,7 @@ func resourceSpannerDatabaseEncoder(d *schema.ResourceData, meta interface{}, ob
 	delete(obj, "instance")
 
 	delete(obj, "versionRetentionPeriod")
+	delete(obj, "defaultLeader")
 	delete(obj, "extraStatements")
 	delete(obj, "enableDropProtection")
 	return obj, nil
@@ -901,7 +935,7 @@ func resourceSpannerDatabaseEncoder(d *schema.ResourceData, meta interface{}, ob
 
 func resourceSpannerDatabaseUpdateEncoder(d *schema.ResourceData, meta interface{}, obj map[string]interface{}) (map[string]interface{}, error) {
 
-	if obj["versionRetentionPeriod"] != nil || obj["extraStatements"] != nil {
+	if obj["versionRetentionPeriod"] != nil || obj["defaultLeader"] != nil || obj["extraStatements"] != nil {
 		old, new := d.GetChange("ddl")
 		oldDdls := old.([]interface{})
 		newDdls := new.([]interface{})
@@ -924,15 +958,30 @@ func resourceSpannerDatabaseUpdateEncoder(d *schema.ResourceData, meta interface
 			updateDdls = append(updateDdls, retentionDdl)
 		}
 
+		//Add statement to update default_leader property, if needed
+		if d.HasChange("default_leader") && obj["defaultLeader"] != nil {
+			updateDdls = append(updateDdls, spannerDatabaseDefaultLeaderDdl(d.Get("name").(string), d.Get("database_dialect").(string), obj["defaultLeader"].(string)))
+		}
+
 		obj["statements"] = updateDdls
 		delete(obj, "name")
 		delete(obj, "versionRetentionPeriod")
+		delete(obj, "defaultLeader")
 		delete(obj, "instance")
 		delete(obj, "extraStatements")
 	}
 	return obj, nil
 }
 
+// spannerDatabaseDefaultLeaderDdl returns the DDL statement that sets the default
+// leader region of a database, in the syntax of the database's dialect.
+func spannerDatabaseDefaultLeaderDdl(dbName, dialect, leader string) string {
+	if dialect == "POSTGRESQL" {
+		return fmt.Sprintf("ALTER DATABASE \"%s\" SET spanner.default_leader TO '%s'", dbName, leader)
+	}
+	return fmt.Sprintf("ALTER DATABASE `%s` SET OPTIONS (default_leader='%s')", dbName, leader)
+}
+
 func resourceSpannerDatabaseDecoder(d *schema.ResourceData, meta interface{}, res map[string]interface{}) (map[string]interface{}, error) {
 	config := meta.(*transport_tpg.Config)
 	d.SetId(res["name"].(string))
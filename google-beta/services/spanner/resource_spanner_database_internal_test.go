@@ -97,3 +97,31 @@ func TestDatabaseNameForApi(t *testing.T) {
 	expected := "projects/project123/instances/instance456/databases/db789"
 	expectEquals(t, expected, actual)
 }
+
+func TestSpannerDatabaseDefaultLeaderDdl(t *testing.T) {
+	t.Parallel()
+
+	cases := map[string]struct {
+		dialect  string
+		expected string
+	}{
+		"unset_dialect": {
+			dialect:  "",
+			expected: "ALTER DATABASE `db` SET OPTIONS (default_leader='us-central1')",
+		},
+		"google_standard_sql": {
+			dialect:  "GOOGLE_STANDARD_SQL",
+			expected: "ALTER DATABASE `db` SET OPTIONS (default_leader='us-central1')",
+		},
+		"postgresql": {
+			dialect:  "POSTGRESQL",
+			expected: "ALTER DATABASE \"db\" SET spanner.default_leader TO 'us-central1'",
+		},
+	}
+
+	for tn, tc := range cases {
+		if got := spannerDatabaseDefaultLeaderDdl("db", tc.dialect, "us-central1"); got != tc.expected {
+			t.Errorf("%s: expected %q, got %q", tn, tc.expected, got)
+		}
+	}
+}
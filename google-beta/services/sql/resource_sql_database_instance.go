@@ -30,6 +30,12 @@ import (
 // Match fully-qualified or relative URLs
 const privateNetworkLinkRegex = "^(?:http(?:s)?://.+/)?projects/(" + verify.ProjectRegex + ")/global/networks/((?:[a-z](?:[-a-z0-9]*[a-z0-9])?))$"
 
+// Match yyyy-mm-dd or mm-dd, the year is omitted for periods that recur every year
+const denyMaintenancePeriodDateRegex = `^(?:[0-9]{4}-)?[0-9]{1,2}-[0-9]{1,2}$`
+
+// Match HH:mm:SS
+const denyMaintenancePeriodTimeRegex = `^[0-9]{2}:[0-9]{2}:[0-9]{2}$`
+
 var sqlDatabaseAuthorizedNetWorkSchemaElem *schema.Resource = &schema.Resource{
 	Schema: map[string]*schema.Schema{
 		"expiration_time": {
@@ -142,6 +148,7 @@ func ResourceSqlDatabaseInstance() *schema.Resource {
 			customdiff.IfValueChange("instance_type", isReplicaPromoteRequested, checkPromoteConfigurationsAndUpdateDiff),
 			privateNetworkCustomizeDiff,
 			pitrSupportDbCustomizeDiff,
+			dataCacheEditionCustomizeDiff,
 		),
 
 		Schema: map[string]*schema.Schema{
@@ -201,7 +208,7 @@ func ResourceSqlDatabaseInstance() *schema.Resource {
 							Type:        schema.TypeList,
 							Optional:    true,
 							MaxItems:    1,
-							Description: `Data cache configurations.`,
+							Description: `Data cache configurations. Data cache is only supported by the ENTERPRISE_PLUS edition.`,
 							Elem: &schema.Resource{
 								Schema: map[string]*schema.Schema{
 									"data_cache_enabled": {
@@ -240,19 +247,22 @@ func ResourceSqlDatabaseInstance() *schema.Resource {
 							Elem: &schema.Resource{
 								Schema: map[string]*schema.Schema{
 									"end_date": {
-										Type:        schema.TypeString,
-										Required:    true,
-										Description: `End date before which maintenance will not take place. The date is in format yyyy-mm-dd i.e., 2020-11-01, or mm-dd, i.e., 11-01`,
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: verify.ValidateRegexp(denyMaintenancePeriodDateRegex),
+										Description:  `End date before which maintenance will not take place. The date is in format yyyy-mm-dd i.e., 2020-11-01, or mm-dd, i.e., 11-01`,
 									},
 									"start_date": {
-										Type:        schema.TypeString,
-										Required:    true,
-										Description: `Start date after which maintenance will not take place. The date is in format yyyy-mm-dd i.e., 2020-11-01, or mm-dd, i.e., 11-01`,
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: verify.ValidateRegexp(denyMaintenancePeriodDateRegex),
+										Description:  `Start date after which maintenance will not take place. The date is in format yyyy-mm-dd i.e., 2020-11-01, or mm-dd, i.e., 11-01`,
 									},
 									"time": {
-										Type:        schema.TypeString,
-										Required:    true,
-										Description: `Time in UTC when the "deny maintenance period" starts on start_date and ends on end_date. The time is in format: HH:mm:SS, i.e., 00:00:00`,
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: verify.ValidateRegexp(denyMaintenancePeriodTimeRegex),
+										Description:  `Time in UTC when the "deny maintenance period" starts on start_date and ends on end_date. The time is in format: HH:mm:SS, i.e., 00:00:00`,
 									},
 								},
 							},
@@ -1027,6 +1037,17 @@ func pitrSupportDbCustomizeDiff(_ context.Context, diff *schema.ResourceDiff, v
 	return nil
 }
 
+// Data cache is only available on Enterprise Plus instances. Catch this at plan
+// time instead of letting the API reject the create or update.
+func dataCacheEditionCustomizeDiff(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
+	dataCacheEnabled := diff.Get("settings.0.data_cache_config.0.data_cache_enabled").(bool)
+	edition := diff.Get("settings.0.edition").(string)
+	if dataCacheEnabled && edition != "ENTERPRISE_PLUS" {
+		return fmt.Errorf("data_cache_enabled is only supported for the ENTERPRISE_PLUS edition, got %s", edition)
+	}
+	return nil
+}
+
 func resourceSqlDatabaseInstanceCreate(d *schema.ResourceData, meta interface{}) error {
 	config := meta.(*transport_tpg.Config)
 	userAgent, err := tpgresource.GenerateUserAgentString(d, config.UserAgent)
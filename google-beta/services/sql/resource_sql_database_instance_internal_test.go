@@ -4,6 +4,8 @@ package sql
 
 import (
 	"testing"
+
+	"github.com/hashicorp/terraform-provider-google-beta/google-beta/verify"
 )
 
 func TestMaintenanceVersionDiffSuppress(t *testing.T) {
@@ -38,3 +40,38 @@ func TestMaintenanceVersionDiffSuppress(t *testing.T) {
 		})
 	}
 }
+
+func TestValidateDenyMaintenancePeriod(t *testing.T) {
+	dateCases := []verify.StringValidationTestCase{
+		// No errors
+		{TestName: "full date", Value: "2020-11-01"},
+		{TestName: "single digit day", Value: "2022-12-5"},
+		{TestName: "recurring date", Value: "11-01"},
+
+		// With errors
+		{TestName: "empty string", Value: "", ExpectError: true},
+		{TestName: "slashes", Value: "2020/11/01", ExpectError: true},
+		{TestName: "time", Value: "00:00:00", ExpectError: true},
+	}
+
+	es := verify.TestStringValidationCases(dateCases, verify.ValidateRegexp(denyMaintenancePeriodDateRegex))
+	if len(es) > 0 {
+		t.Errorf("Failed to validate deny maintenance period date: %v", es)
+	}
+
+	timeCases := []verify.StringValidationTestCase{
+		// No errors
+		{TestName: "midnight", Value: "00:00:00"},
+		{TestName: "afternoon", Value: "13:30:00"},
+
+		// With errors
+		{TestName: "empty string", Value: "", ExpectError: true},
+		{TestName: "no seconds", Value: "13:30", ExpectError: true},
+		{TestName: "date", Value: "2020-11-01", ExpectError: true},
+	}
+
+	es = verify.TestStringValidationCases(timeCases, verify.ValidateRegexp(denyMaintenancePeriodTimeRegex))
+	if len(es) > 0 {
+		t.Errorf("Failed to validate deny maintenance period time: %v", es)
+	}
+}
@@ -3,6 +3,7 @@
 package sql
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"strings"
@@ -10,6 +11,7 @@ import (
 
 	"github.com/hashicorp/terraform-provider-google-beta/google-beta/tpgresource"
 	transport_tpg "github.com/hashicorp/terraform-provider-google-beta/google-beta/transport"
+	"github.com/hashicorp/terraform-provider-google-beta/google-beta/verify"
 
 	"github.com/hashicorp/errwrap"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
@@ -43,6 +45,27 @@ func handleUserNotFoundError(err error, d *schema.ResourceData, resource string)
 		fmt.Sprintf("Error when reading or editing %s: {{err}}", resource), err)
 }
 
+func validateSqlUserIamAuthentication(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	// separate func to allow unit testing
+	return validateSqlUserIamAuthenticationFunc(d)
+}
+
+// IAM users authenticate with their Google identity, so the API rejects a
+// password or password policy for them.
+func validateSqlUserIamAuthenticationFunc(d tpgresource.TerraformResourceDiff) error {
+	userType := d.Get("type").(string)
+	if !strings.HasPrefix(userType, "CLOUD_IAM") {
+		return nil
+	}
+	if password, _ := d.Get("password").(string); password != "" {
+		return fmt.Errorf("password cannot be set for users of type %s", userType)
+	}
+	if policies, _ := d.Get("password_policy").([]interface{}); len(policies) > 0 {
+		return fmt.Errorf("password_policy cannot be set for users of type %s", userType)
+	}
+	return nil
+}
+
 func ResourceSqlUser() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceSqlUserCreate,
@@ -61,6 +84,7 @@ func ResourceSqlUser() *schema.Resource {
 
 		CustomizeDiff: customdiff.All(
 			tpgresource.DefaultProviderProject,
+			validateSqlUserIamAuthentication,
 		),
 
 		SchemaVersion: 1,
@@ -139,9 +163,10 @@ func ResourceSqlUser() *schema.Resource {
 							Description: `Number of failed attempts allowed before the user get locked.`,
 						},
 						"password_expiration_duration": {
-							Type:        schema.TypeString,
-							Optional:    true,
-							Description: `Password expiration duration with one week grace period.`,
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: verify.ValidateNonNegativeDuration(),
+							Description:  `Password expiration duration with one week grace period, e.g. "2592000s".`,
 						},
 						"enable_failed_attempts_check": {
 							Type:        schema.TypeBool,
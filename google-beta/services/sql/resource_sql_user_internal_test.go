@@ -0,0 +1,60 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+package sql
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-google-beta/google-beta/tpgresource"
+)
+
+func TestValidateSqlUserIamAuthentication(t *testing.T) {
+	t.Parallel()
+
+	cases := map[string]struct {
+		Type           string
+		Password       string
+		PasswordPolicy []interface{}
+		ExpectError    bool
+	}{
+		"built in user with password and policy": {
+			Type:           "BUILT_IN",
+			Password:       "changeme",
+			PasswordPolicy: []interface{}{map[string]interface{}{"allowed_failed_attempts": 6}},
+		},
+		"default user type with password": {
+			Password: "changeme",
+		},
+		"iam user without password": {
+			Type: "CLOUD_IAM_USER",
+		},
+		"iam service account with password": {
+			Type:        "CLOUD_IAM_SERVICE_ACCOUNT",
+			Password:    "changeme",
+			ExpectError: true,
+		},
+		"iam group with password policy": {
+			Type:           "CLOUD_IAM_GROUP",
+			PasswordPolicy: []interface{}{map[string]interface{}{"allowed_failed_attempts": 6}},
+			ExpectError:    true,
+		},
+	}
+
+	for tn, tc := range cases {
+		d := &tpgresource.ResourceDiffMock{
+			After: map[string]interface{}{
+				"type":            tc.Type,
+				"password":        tc.Password,
+				"password_policy": tc.PasswordPolicy,
+			},
+		}
+
+		err := validateSqlUserIamAuthenticationFunc(d)
+		if tc.ExpectError && err == nil {
+			t.Errorf("%s: expected an error", tn)
+		}
+		if !tc.ExpectError && err != nil {
+			t.Errorf("%s: unexpected error: %s", tn, err)
+		}
+	}
+}
@@ -74,6 +74,37 @@ func TestAccDataSourceGoogleStorageBucket_avoidComputeAPI(t *testing.T) {
 	})
 }
 
+// Check that the data source leaves project unset rather than calling the Resource Manager API
+// when skip_project_number_lookup is set and no project is available.
+func TestAccDataSourceGoogleStorageBucket_skipProjectNumberLookup(t *testing.T) {
+	// Cannot use t.Parallel() if using t.Setenv
+
+	context := map[string]interface{}{
+		"bucket_name":     "tf-bucket-" + acctest.RandString(t, 10),
+		"real_project_id": envvar.GetTestProjectFromEnv(),
+	}
+
+	// Unset ENV so no provider default is available to the data source
+	t.Setenv("GOOGLE_PROJECT", "")
+
+	acctest.VcrTest(t, resource.TestCase{
+		// Removed PreCheck because it wants to enforce GOOGLE_PROJECT being set
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories(t),
+		CheckDestroy:             testAccStorageBucketDestroyProducer(t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceGoogleStorageBucketConfig_skipProjectNumberLookup(context),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(
+						"data.google_storage_bucket.bar", "project_number"),
+					resource.TestCheckResourceAttr(
+						"data.google_storage_bucket.bar", "project", ""),
+				),
+			},
+		},
+	})
+}
+
 func testAccDataSourceGoogleStorageBucketConfig(context map[string]interface{}) string {
 	return acctest.Nprintf(`
 resource "google_storage_bucket" "foo" {
@@ -109,3 +140,21 @@ data "google_storage_bucket" "bar" {
 }
 `, context)
 }
+
+func testAccDataSourceGoogleStorageBucketConfig_skipProjectNumberLookup(context map[string]interface{}) string {
+	return acctest.Nprintf(`
+resource "google_storage_bucket" "foo" {
+  project  = "%{real_project_id}"
+  name     = "%{bucket_name}"
+  location = "US"
+}
+
+data "google_storage_bucket" "bar" {
+  name                       = google_storage_bucket.foo.name
+  skip_project_number_lookup = true
+  depends_on = [
+    google_storage_bucket.foo,
+  ]
+}
+`, context)
+}
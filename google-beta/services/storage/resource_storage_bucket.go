@@ -3,12 +3,12 @@
 package storage
 
 import (
-	"bytes"
 	"context"
 	"errors"
 	"fmt"
 	"log"
 	"math"
+	"regexp"
 	"runtime"
 	"strconv"
 	"strings"
@@ -37,8 +37,9 @@ func ResourceStorageBucket() *schema.Resource {
 			State: resourceStorageBucketStateImporter,
 		},
 		CustomizeDiff: customdiff.All(
-			customdiff.ForceNewIfChange("retention_policy.0.is_locked", isPolicyLocked),
+			resourceStorageBucketRetentionPolicyLockCustomizeDiff,
 			tpgresource.SetLabelsDiff,
+			tpgresource.SetEffectiveKmsKeyDiff("encryption.0.default_kms_key_name", storageBucketLocationFromDiff),
 		),
 
 		Timeouts: &schema.ResourceTimeout{
@@ -47,13 +48,18 @@ func ResourceStorageBucket() *schema.Resource {
 			Read:   schema.DefaultTimeout(4 * time.Minute),
 		},
 
-		SchemaVersion: 1,
+		SchemaVersion: 2,
 		StateUpgraders: []schema.StateUpgrader{
 			{
 				Type:    resourceStorageBucketV0().CoreConfigSchema().ImpliedType(),
 				Upgrade: ResourceStorageBucketStateUpgradeV0,
 				Version: 0,
 			},
+			{
+				Type:    resourceStorageBucketV1().CoreConfigSchema().ImpliedType(),
+				Upgrade: ResourceStorageBucketStateUpgradeV1,
+				Version: 1,
+			},
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -71,15 +77,22 @@ func ResourceStorageBucket() *schema.Resource {
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"default_kms_key_name": {
-							Type:        schema.TypeString,
-							Required:    true,
-							Description: `A Cloud KMS key that will be used to encrypt objects inserted into this bucket, if no encryption method is specified. You must pay attention to whether the crypto key is available in the location that this bucket is created in. See the docs for more details.`,
+							Type:             schema.TypeString,
+							Required:         true,
+							DiffSuppressFunc: tpgresource.CompareResourceReferences,
+							Description:      `A Cloud KMS key that will be used to encrypt objects inserted into this bucket, if no encryption method is specified. You must pay attention to whether the crypto key is available in the location that this bucket is created in. See the docs for more details.`,
 						},
 					},
 				},
 				Description: `The bucket's encryption configuration.`,
 			},
 
+			"effective_kms_key": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: `The Cloud KMS key used to encrypt objects inserted into this bucket, either configured in encryption or inherited from the provider-level default_kms_key for the bucket's location.`,
+			},
+
 			"requester_pays": {
 				Type:        schema.TypeBool,
 				Optional:    true,
@@ -93,6 +106,20 @@ func ResourceStorageBucket() *schema.Resource {
 				Description: `When deleting a bucket, this boolean option will delete all contained objects. If you try to delete a bucket that contains objects, Terraform will fail that run.`,
 			},
 
+			"deletion_protection": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: `Used to block Terraform from deleting the bucket, regardless of force_destroy. Defaults to false.`,
+			},
+
+			"skip_project_number_lookup": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: `If no project is configured, don't look up the ID of the bucket's project from the project number returned by the Storage API, and leave project unset. Defaults to false.`,
+			},
+
 			"labels": {
 				Type:        schema.TypeMap,
 				Optional:    true,
@@ -144,6 +171,12 @@ func ResourceStorageBucket() *schema.Resource {
 				Description: `The URI of the created resource.`,
 			},
 
+			"etag": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: `The HTTP 1.1 Entity tag of the bucket's metadata.`,
+			},
+
 			"url": {
 				Type:        schema.TypeString,
 				Computed:    true,
@@ -151,10 +184,11 @@ func ResourceStorageBucket() *schema.Resource {
 			},
 
 			"storage_class": {
-				Type:        schema.TypeString,
-				Optional:    true,
-				Default:     "STANDARD",
-				Description: `The Storage Class of the new bucket. Supported values include: STANDARD, MULTI_REGIONAL, REGIONAL, NEARLINE, COLDLINE, ARCHIVE.`,
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "STANDARD",
+				ValidateFunc: validation.StringInSlice([]string{"STANDARD", "MULTI_REGIONAL", "REGIONAL", "NEARLINE", "COLDLINE", "ARCHIVE"}, false),
+				Description:  `The Storage Class of the new bucket. Supported values include: STANDARD, MULTI_REGIONAL, REGIONAL, NEARLINE, COLDLINE, ARCHIVE. Changing it updates the default storage class of the bucket in place, and doesn't change the storage class of existing objects.`,
 			},
 
 			"lifecycle_rule": {
@@ -164,11 +198,10 @@ func ResourceStorageBucket() *schema.Resource {
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"action": {
-							Type:     schema.TypeSet,
+							Type:     schema.TypeList,
 							Required: true,
 							MinItems: 1,
 							MaxItems: 1,
-							Set:      resourceGCSBucketLifecycleRuleActionHash,
 							Elem: &schema.Resource{
 								Schema: map[string]*schema.Schema{
 									"type": {
@@ -186,11 +219,10 @@ func ResourceStorageBucket() *schema.Resource {
 							Description: `The Lifecycle Rule's action configuration. A single block of this type is supported.`,
 						},
 						"condition": {
-							Type:     schema.TypeSet,
+							Type:     schema.TypeList,
 							Required: true,
 							MinItems: 1,
 							MaxItems: 1,
-							Set:      resourceGCSBucketLifecycleRuleConditionHash,
 							Elem: &schema.Resource{
 								Schema: map[string]*schema.Schema{
 									"age": {
@@ -304,38 +336,40 @@ func ResourceStorageBucket() *schema.Resource {
 							Description: `While set to true, autoclass automatically transitions objects in your bucket to appropriate storage classes based on each object's access pattern.`,
 						},
 						"terminal_storage_class": {
-							Type:        schema.TypeString,
-							Optional:    true,
-							Computed:    true,
-							Description: `The storage class that objects in the bucket eventually transition to if they are not read for a certain length of time. Supported values include: NEARLINE, ARCHIVE.`,
+							Type:         schema.TypeString,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validation.StringInSlice([]string{"NEARLINE", "ARCHIVE"}, false),
+							Description:  `The storage class that objects in the bucket eventually transition to if they are not read for a certain length of time. Supported values include: NEARLINE, ARCHIVE.`,
 						},
 					},
 				},
 				Description: `The bucket's autoclass configuration.`,
 				DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
-					_, n := d.GetChange(strings.TrimSuffix(k, ".#"))
+					o, n := d.GetChange(strings.TrimSuffix(k, ".#"))
 					if !strings.HasSuffix(k, ".#") {
 						return false
 					}
+					// A disabled autoclass block is equivalent to no block at all
 					var l []interface{}
 					if new == "1" && old == "0" {
 						l = n.([]interface{})
-						contents, ok := l[0].(map[string]interface{})
-						if !ok {
-							return false
-						}
-						if contents["enabled"] == false {
-							return true
-						}
+					} else if new == "0" && old == "1" {
+						l = o.([]interface{})
+					} else {
+						return false
+					}
+					contents, ok := l[0].(map[string]interface{})
+					if !ok {
+						return false
 					}
-					return false
+					return contents["enabled"] == false
 				},
 			},
 			"website": {
 				Type:     schema.TypeList,
 				Optional: true,
 				MaxItems: 1,
-				Computed: true,
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"main_page_suffix": {
@@ -471,6 +505,10 @@ func ResourceStorageBucket() *schema.Resource {
 							Elem: &schema.Schema{
 								Type: schema.TypeString,
 							},
+							// The API returns regions in upper case
+							Set: func(v interface{}) int {
+								return tpgresource.Hashcode(strings.ToUpper(v.(string)))
+							},
 							Description: `The list of individual regions that comprise a dual-region bucket. See the docs for a list of acceptable regions. Note: If any of the data_locations changes, it will recreate the bucket.`,
 						},
 					},
@@ -478,16 +516,18 @@ func ResourceStorageBucket() *schema.Resource {
 				Description: `The bucket's custom location configuration, which specifies the individual regions that comprise a dual-region bucket. If the bucket is designated a single or multi-region, the parameters are empty.`,
 			},
 			"rpo": {
-				Type:        schema.TypeString,
-				Optional:    true,
-				Computed:    true,
-				Description: `Specifies the RPO setting of bucket. If set 'ASYNC_TURBO', The Turbo Replication will be enabled for the dual-region bucket. Value 'DEFAULT' will set RPO setting to default. Turbo Replication is only for buckets in dual-regions.See the docs for more details.`,
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringInSlice([]string{"DEFAULT", "ASYNC_TURBO"}, false),
+				Description:  `Specifies the RPO setting of bucket. If set 'ASYNC_TURBO', The Turbo Replication will be enabled for the dual-region bucket. Value 'DEFAULT' will set RPO setting to default. Turbo Replication is only for buckets in dual-regions.See the docs for more details.`,
 			},
 			"public_access_prevention": {
-				Type:        schema.TypeString,
-				Optional:    true,
-				Computed:    true,
-				Description: `Prevents public access to a bucket.`,
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringInSlice([]string{"enforced", "inherited", "unspecified"}, false),
+				Description:  `Prevents public access to a bucket.`,
 			},
 			"soft_delete_policy": {
 				Type:        schema.TypeList,
@@ -498,10 +538,11 @@ func ResourceStorageBucket() *schema.Resource {
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"retention_duration_seconds": {
-							Type:        schema.TypeInt,
-							Default:     604800,
-							Optional:    true,
-							Description: `The duration in seconds that soft-deleted objects in the bucket will be retained and cannot be permanently deleted. Default value is 604800.`,
+							Type:         schema.TypeInt,
+							Default:      604800,
+							Optional:     true,
+							ValidateFunc: validateSoftDeleteRetentionDuration,
+							Description:  `The duration in seconds that soft-deleted objects in the bucket will be retained and cannot be permanently deleted. Default value is 604800.`,
 						},
 						"effective_time": {
 							Type:        schema.TypeString,
@@ -533,18 +574,24 @@ func resourceDataplexLabelDiffSuppress(k, old, new string, d *schema.ResourceDat
 	return false
 }
 
-// Is the old bucket retention policy locked?
-func isPolicyLocked(_ context.Context, old, new, _ interface{}) bool {
+func resourceStorageBucketRetentionPolicyLockCustomizeDiff(_ context.Context, diff *schema.ResourceDiff, _ interface{}) error {
+	// separate func to allow unit testing
+	return validateRetentionPolicyLock(diff)
+}
+
+// A locked retention policy can never be unlocked or removed, so catch attempts
+// to do so at plan time instead of replacing a bucket that holds retained objects.
+func validateRetentionPolicyLock(d tpgresource.TerraformResourceDiff) error {
+	old, new := d.GetChange("retention_policy.0.is_locked")
 	if old == nil || new == nil {
-		return false
+		return nil
 	}
 
-	// if the old policy is locked, but the new policy is not
 	if old.(bool) && !new.(bool) {
-		return true
+		return fmt.Errorf("retention_policy is locked on bucket %q and cannot be unlocked or removed; keep is_locked = true, or remove the bucket from the Terraform state with `terraform state rm` to stop managing it", d.Get("name"))
 	}
 
-	return false
+	return nil
 }
 
 func resourceStorageBucketCreate(d *schema.ResourceData, meta interface{}) error {
@@ -625,6 +672,11 @@ func resourceStorageBucketCreate(d *schema.ResourceData, meta interface{}) error
 
 	if v, ok := d.GetOk("encryption"); ok {
 		sb.Encryption = expandBucketEncryption(v.([]interface{}))
+	} else if v, ok := d.GetOk("effective_kms_key"); ok {
+		// Fall back to the provider-level default_kms_key for the bucket's location
+		sb.Encryption = &storage.BucketEncryption{
+			DefaultKmsKeyName: v.(string),
+		}
 	}
 
 	if v, ok := d.GetOk("requester_pays"); ok {
@@ -669,14 +721,15 @@ func resourceStorageBucketCreate(d *schema.ResourceData, meta interface{}) error
 
 	// There seems to be some eventual consistency errors in some cases, so we want to check a few times
 	// to make sure it exists before moving on
-	err = transport_tpg.Retry(transport_tpg.RetryOptions{
-		RetryFunc: func() (operr error) {
-			_, retryErr := config.NewStorageClient(userAgent).Buckets.Get(res.Name).Do()
-			return retryErr
-		},
-		Timeout:              d.Timeout(schema.TimeoutCreate),
-		ErrorRetryPredicates: []transport_tpg.RetryErrorPredicateFunc{transport_tpg.IsNotFoundRetryableError("bucket creation")},
-	})
+	userProject := storageUserProject(d, config, res.Billing != nil && res.Billing.RequesterPays)
+	err = transport_tpg.PollReadAfterCreate(func() (map[string]interface{}, error) {
+		getCall := config.NewStorageClient(userAgent).Buckets.Get(res.Name)
+		if userProject != "" {
+			getCall.UserProject(userProject)
+		}
+		_, err := getCall.Do()
+		return nil, err
+	}, transport_tpg.PollCheckForExistence, "Creating Storage Bucket", d.Timeout(schema.TimeoutCreate))
 
 	if err != nil {
 		return fmt.Errorf("Error reading bucket after creation: %s", err)
@@ -738,11 +791,21 @@ func resourceStorageBucketUpdate(d *schema.ResourceData, meta interface{}) error
 	if d.HasChange("autoclass") {
 		if v, ok := d.GetOk("autoclass"); ok {
 			sb.Autoclass = expandBucketAutoclass(v)
+		} else {
+			// Removing the block disables autoclass rather than leaving it as is
+			sb.Autoclass = &storage.BucketAutoclass{
+				Enabled:         false,
+				ForceSendFields: []string{"Enabled"},
+			}
 		}
 	}
 
 	if d.HasChange("website") {
-		sb.Website = expandBucketWebsite(d.Get("website"))
+		if v, ok := d.GetOk("website"); ok {
+			sb.Website = expandBucketWebsite(v)
+		} else {
+			sb.NullFields = append(sb.NullFields, "Website")
+		}
 	}
 
 	if d.HasChange("retention_policy") {
@@ -823,7 +886,15 @@ func resourceStorageBucketUpdate(d *schema.ResourceData, meta interface{}) error
 		}
 	}
 
-	res, err := config.NewStorageClient(userAgent).Buckets.Patch(d.Get("name").(string), sb).Do()
+	// A bucket that was requester pays before this update must be billed to change it
+	oldRequesterPays, _ := d.GetChange("requester_pays")
+	userProject := storageUserProject(d, config, oldRequesterPays.(bool) || d.Get("requester_pays").(bool))
+
+	patchCall := config.NewStorageClient(userAgent).Buckets.Patch(d.Get("name").(string), sb)
+	if userProject != "" {
+		patchCall.UserProject(userProject)
+	}
+	res, err := patchCall.Do()
 	if err != nil {
 		return err
 	}
@@ -837,7 +908,11 @@ func resourceStorageBucketUpdate(d *schema.ResourceData, meta interface{}) error
 	// to make sure it exists before moving on
 	err = transport_tpg.Retry(transport_tpg.RetryOptions{
 		RetryFunc: func() (operr error) {
-			_, retryErr := config.NewStorageClient(userAgent).Buckets.Get(res.Name).Do()
+			getCall := config.NewStorageClient(userAgent).Buckets.Get(res.Name)
+			if userProject != "" {
+				getCall.UserProject(userProject)
+			}
+			_, retryErr := getCall.Do()
 			return retryErr
 		},
 		Timeout:              d.Timeout(schema.TimeoutUpdate),
@@ -872,6 +947,15 @@ func resourceStorageBucketUpdate(d *schema.ResourceData, meta interface{}) error
 	return nil
 }
 
+// storageBucketComputedFields are the computed fields that a full read of a
+// bucket sets. They're computed in init, as the schema refers to the read.
+var storageBucketComputedFields []string
+
+func init() {
+	// rpo is only set for dual-region and multi-region buckets
+	storageBucketComputedFields = tpgresource.ComputedFields(ResourceStorageBucket().Schema, "rpo")
+}
+
 func resourceStorageBucketRead(d *schema.ResourceData, meta interface{}) error {
 	config := meta.(*transport_tpg.Config)
 	userAgent, err := tpgresource.GenerateUserAgentString(d, config.UserAgent)
@@ -881,25 +965,45 @@ func resourceStorageBucketRead(d *schema.ResourceData, meta interface{}) error {
 
 	// Get the bucket and acl
 	bucket := d.Get("name").(string)
+	userProject := storageUserProject(d, config, d.Get("requester_pays").(bool))
+	etag := tpgresource.ConditionalRefreshEtag(d, config, "etag", storageBucketComputedFields)
 
 	var res *storage.Bucket
 	// There seems to be some eventual consistency errors in some cases, so we want to check a few times
 	// to make sure it exists before moving on
 	err = transport_tpg.Retry(transport_tpg.RetryOptions{
 		RetryFunc: func() (operr error) {
+			getCall := config.NewStorageClient(userAgent).Buckets.Get(bucket)
+			if userProject != "" {
+				getCall.UserProject(userProject)
+			}
+			if etag != "" {
+				getCall.IfNoneMatch(etag)
+			}
 			var retryErr error
-			res, retryErr = config.NewStorageClient(userAgent).Buckets.Get(bucket).Do()
+			res, retryErr = getCall.Do()
 			return retryErr
 		},
 		Timeout:              d.Timeout(schema.TimeoutRead),
 		ErrorRetryPredicates: []transport_tpg.RetryErrorPredicateFunc{transport_tpg.IsNotFoundRetryableError("bucket read")},
 	})
 
+	if googleapi.IsNotModified(err) {
+		log.Printf("[DEBUG] Bucket %q wasn't modified since etag %q, keeping its state", bucket, etag)
+		return nil
+	}
 	if err != nil {
 		return transport_tpg.HandleNotFoundError(err, d, fmt.Sprintf("Storage Bucket %q", d.Get("name").(string)))
 	}
 	log.Printf("[DEBUG] Read bucket %v at location %v\n\n", res.Name, res.SelfLink)
 
+	// Explicitly set virtual fields to default values if unset
+	if _, ok := d.GetOkExists("skip_project_number_lookup"); !ok {
+		if err := d.Set("skip_project_number_lookup", false); err != nil {
+			return fmt.Errorf("Error setting skip_project_number_lookup: %s", err)
+		}
+	}
+
 	return setStorageBucket(d, config, res, bucket, userAgent)
 }
 
@@ -913,9 +1017,19 @@ func resourceStorageBucketDelete(d *schema.ResourceData, meta interface{}) error
 	// Get the bucket
 	bucket := d.Get("name").(string)
 
+	if d.Get("deletion_protection").(bool) {
+		return fmt.Errorf("cannot destroy bucket %s without setting deletion_protection=false and running `terraform apply`", bucket)
+	}
+
+	userProject := storageUserProject(d, config, d.Get("requester_pays").(bool))
+
 	var listError, deleteObjectError error
 	for deleteObjectError == nil {
-		res, err := config.NewStorageClient(userAgent).Objects.List(bucket).Versions(true).Do()
+		listCall := config.NewStorageClient(userAgent).Objects.List(bucket).Versions(true)
+		if userProject != "" {
+			listCall.UserProject(userProject)
+		}
+		res, err := listCall.Do()
 		if err != nil {
 			log.Printf("Error listing contents of bucket %s: %v", bucket, err)
 			// If we can't list the contents, try deleting the bucket anyway in case it's empty
@@ -968,7 +1082,11 @@ func resourceStorageBucketDelete(d *schema.ResourceData, meta interface{}) error
 
 			wp.Submit(func() {
 				log.Printf("[TRACE] Attempting to delete %s", object.Name)
-				if err := config.NewStorageClient(userAgent).Objects.Delete(bucket, object.Name).Generation(object.Generation).Do(); err != nil {
+				deleteCall := config.NewStorageClient(userAgent).Objects.Delete(bucket, object.Name).Generation(object.Generation)
+				if userProject != "" {
+					deleteCall.UserProject(userProject)
+				}
+				if err := deleteCall.Do(); err != nil {
 					deleteObjectError = err
 					log.Printf("[ERR] Failed to delete storage object %s: %s", object.Name, err)
 				} else {
@@ -983,7 +1101,11 @@ func resourceStorageBucketDelete(d *schema.ResourceData, meta interface{}) error
 
 	// remove empty bucket
 	err = resource.Retry(1*time.Minute, func() *resource.RetryError {
-		err := config.NewStorageClient(userAgent).Buckets.Delete(bucket).Do()
+		deleteCall := config.NewStorageClient(userAgent).Buckets.Delete(bucket)
+		if userProject != "" {
+			deleteCall.UserProject(userProject)
+		}
+		err := deleteCall.Do()
 		if err == nil {
 			return nil
 		}
@@ -1008,30 +1130,85 @@ func resourceStorageBucketDelete(d *schema.ResourceData, meta interface{}) error
 }
 
 func resourceStorageBucketStateImporter(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
-	// We need to support project/bucket_name and bucket_name formats. This will allow
-	// importing a bucket that is in a different project than the provider default.
 	// ParseImportID can't be used because having no project will cause an error but it
 	// is a valid state as the project_id will be retrieved in READ
-	parts := strings.Split(d.Id(), "/")
-	if len(parts) == 1 {
-		if err := d.Set("name", parts[0]); err != nil {
-			return nil, fmt.Errorf("Error setting name: %s", err)
-		}
-	} else if len(parts) > 1 {
-		if err := d.Set("project", parts[0]); err != nil {
+	project, name, err := parseStorageBucketImportId(d.Id())
+	if err != nil {
+		return nil, err
+	}
+	if project != "" {
+		if err := d.Set("project", project); err != nil {
 			return nil, fmt.Errorf("Error setting project: %s", err)
 		}
-		if err := d.Set("name", parts[1]); err != nil {
-			return nil, fmt.Errorf("Error setting name: %s", err)
-		}
 	}
+	if err := d.Set("name", name); err != nil {
+		return nil, fmt.Errorf("Error setting name: %s", err)
+	}
+	// The bucket's id is its name, whichever format was used to import it
+	d.SetId(name)
 
 	if err := d.Set("force_destroy", false); err != nil {
 		return nil, fmt.Errorf("Error setting force_destroy: %s", err)
 	}
+	if err := d.Set("deletion_protection", false); err != nil {
+		return nil, fmt.Errorf("Error setting deletion_protection: %s", err)
+	}
+	if err := d.Set("skip_project_number_lookup", false); err != nil {
+		return nil, fmt.Errorf("Error setting skip_project_number_lookup: %s", err)
+	}
 	return []*schema.ResourceData{d}, nil
 }
 
+// storageUserProject returns the project billed for requests to a requester
+// pays bucket, or an empty string if requests shouldn't set one. This is the
+// provider's billing_project when user_project_override is enabled, or else
+// the bucket's own project if the bucket is known to be requester pays.
+func storageUserProject(d tpgresource.TerraformResourceData, config *transport_tpg.Config, requesterPays bool) string {
+	if config.UserProjectOverride && config.BillingProject != "" {
+		return config.BillingProject
+	}
+	if requesterPays {
+		if project, err := tpgresource.GetProject(d, config); err == nil {
+			return project
+		}
+	}
+	return ""
+}
+
+// storageBucketImportIdRegexes lists the supported import formats for a bucket.
+// This allows importing a bucket that is in a different project than the
+// provider default, as well as using the bucket's self_link or gsutil URI.
+var storageBucketImportIdRegexes = []*regexp.Regexp{
+	regexp.MustCompile("^https://[^/]+/storage/v1/b/(?P<name>[^/]+)$"),
+	regexp.MustCompile("^gs://(?P<name>[^/]+)/?$"),
+	regexp.MustCompile("^projects/(?P<project>[^/]+)/buckets/(?P<name>[^/]+)$"),
+	regexp.MustCompile("^b/(?P<name>[^/]+)$"),
+	regexp.MustCompile("^(?P<project>[^/]+)/(?P<name>[^/]+)$"),
+	regexp.MustCompile("^(?P<name>[^/]+)$"),
+}
+
+// parseStorageBucketImportId returns the project, if any, and the name of the
+// bucket identified by an import id.
+func parseStorageBucketImportId(id string) (string, string, error) {
+	for _, re := range storageBucketImportIdRegexes {
+		match := re.FindStringSubmatch(id)
+		if match == nil {
+			continue
+		}
+		var project, name string
+		for i, field := range re.SubexpNames() {
+			switch field {
+			case "project":
+				project = match[i]
+			case "name":
+				name = match[i]
+			}
+		}
+		return project, name, nil
+	}
+	return "", "", fmt.Errorf("Import id %q doesn't match any of the accepted formats: {{name}}, {{project}}/{{name}}, projects/{{project}}/buckets/{{name}}, gs://{{name}} or the bucket's self_link", id)
+}
+
 func expandCors(configured []interface{}) []*storage.BucketCors {
 	if len(configured) == 0 {
 		return nil
@@ -1096,6 +1273,10 @@ func flattenBucketEncryption(enc *storage.BucketEncryption) []map[string]interfa
 	return encryption
 }
 
+func storageBucketLocationFromDiff(d *schema.ResourceDiff, _ *transport_tpg.Config) (string, error) {
+	return d.Get("location").(string), nil
+}
+
 func expandBucketCustomPlacementConfig(configured interface{}) *storage.BucketCustomPlacementConfig {
 	cfcs := configured.([]interface{})
 	if len(cfcs) == 0 || cfcs[0] == nil {
@@ -1205,6 +1386,16 @@ func flattenBucketObjectRetention(bucketObjectRetention *storage.BucketObjectRet
 	return false
 }
 
+// validateSoftDeleteRetentionDuration checks that a soft delete retention
+// duration is between 7 and 90 days, or 0 to disable soft delete.
+func validateSoftDeleteRetentionDuration(v interface{}, k string) (ws []string, errs []error) {
+	value := v.(int)
+	if value != 0 && (value < 604800 || value > 7776000) {
+		errs = append(errs, fmt.Errorf("%q must be 0 or between 604800 (7 days) and 7776000 (90 days), got %d", k, value))
+	}
+	return
+}
+
 func expandBucketSoftDeletePolicy(configured interface{}) *storage.BucketSoftDeletePolicy {
 	configuredSoftDeletePolicies := configured.([]interface{})
 	if len(configuredSoftDeletePolicies) == 0 {
@@ -1304,8 +1495,8 @@ func flattenBucketLifecycle(d *schema.ResourceData, lifecycle *storage.BucketLif
 
 	for index, rule := range lifecycle.Rule {
 		rules = append(rules, map[string]interface{}{
-			"action":    schema.NewSet(resourceGCSBucketLifecycleRuleActionHash, []interface{}{flattenBucketLifecycleRuleAction(rule.Action)}),
-			"condition": schema.NewSet(resourceGCSBucketLifecycleRuleConditionHash, []interface{}{flattenBucketLifecycleRuleCondition(index, d, rule.Condition)}),
+			"action":    []interface{}{flattenBucketLifecycleRuleAction(rule.Action)},
+			"condition": []interface{}{flattenBucketLifecycleRuleCondition(index, d, rule.Condition)},
 		})
 	}
 
@@ -1345,7 +1536,7 @@ func flattenBucketLifecycleRuleCondition(index int, d *schema.ResourceData, cond
 	}
 	// setting no_age value from state config since it is terraform only variable and not getting value from backend.
 	if v, ok := d.GetOk(fmt.Sprintf("lifecycle_rule.%d.condition", index)); ok {
-		state_condition := v.(*schema.Set).List()[0].(map[string]interface{})
+		state_condition := v.([]interface{})[0].(map[string]interface{})
 		ruleCondition["no_age"] = state_condition["no_age"].(bool)
 	}
 
@@ -1466,8 +1657,8 @@ func expandStorageBucketLifecycleRuleAction(v interface{}) (*storage.BucketLifec
 		return nil, fmt.Errorf("exactly one action is required for lifecycle_rule")
 	}
 
-	actions := v.(*schema.Set).List()
-	if len(actions) != 1 {
+	actions := v.([]interface{})
+	if len(actions) != 1 || actions[0] == nil {
 		return nil, fmt.Errorf("exactly one action is required for lifecycle_rule")
 	}
 
@@ -1489,8 +1680,8 @@ func expandStorageBucketLifecycleRuleCondition(v interface{}) (*storage.BucketLi
 	if v == nil {
 		return nil, nil
 	}
-	conditions := v.(*schema.Set).List()
-	if len(conditions) != 1 {
+	conditions := v.([]interface{})
+	if len(conditions) != 1 || conditions[0] == nil {
 		return nil, fmt.Errorf("One and only one condition can be provided per lifecycle_rule")
 	}
 
@@ -1579,88 +1770,6 @@ func expandStorageBucketLifecycleRuleCondition(v interface{}) (*storage.BucketLi
 	return transformed, nil
 }
 
-func resourceGCSBucketLifecycleRuleActionHash(v interface{}) int {
-	if v == nil {
-		return 0
-	}
-
-	var buf bytes.Buffer
-	m := v.(map[string]interface{})
-
-	buf.WriteString(fmt.Sprintf("%s-", m["type"].(string)))
-
-	if v, ok := m["storage_class"]; ok {
-		buf.WriteString(fmt.Sprintf("%s-", v.(string)))
-	}
-
-	return tpgresource.Hashcode(buf.String())
-}
-
-func resourceGCSBucketLifecycleRuleConditionHash(v interface{}) int {
-	if v == nil {
-		return 0
-	}
-
-	var buf bytes.Buffer
-	m := v.(map[string]interface{})
-
-	if v, ok := m["no_age"]; ok && v.(bool) {
-		buf.WriteString(fmt.Sprintf("%t-", v.(bool)))
-	} else {
-		if v, ok := m["age"]; ok {
-			buf.WriteString(fmt.Sprintf("%d-", v.(int)))
-		}
-	}
-
-	if v, ok := m["days_since_custom_time"]; ok {
-		buf.WriteString(fmt.Sprintf("%d-", v.(int)))
-	}
-
-	if v, ok := m["days_since_noncurrent_time"]; ok {
-		buf.WriteString(fmt.Sprintf("%d-", v.(int)))
-	}
-
-	if v, ok := m["created_before"]; ok {
-		buf.WriteString(fmt.Sprintf("%s-", v.(string)))
-	}
-
-	withStateV, withStateOk := m["with_state"]
-	if withStateOk {
-		switch withStateV.(string) {
-		case "LIVE":
-			buf.WriteString(fmt.Sprintf("%t-", true))
-		case "ARCHIVED":
-			buf.WriteString(fmt.Sprintf("%t-", false))
-		}
-	}
-
-	if v, ok := m["matches_storage_class"]; ok {
-		matches_storage_classes := v.([]interface{})
-		for _, matches_storage_class := range matches_storage_classes {
-			buf.WriteString(fmt.Sprintf("%s-", matches_storage_class))
-		}
-	}
-
-	if v, ok := m["num_newer_versions"]; ok {
-		buf.WriteString(fmt.Sprintf("%d-", v.(int)))
-	}
-
-	if v, ok := m["matches_prefix"]; ok {
-		matches_prefixes := v.([]interface{})
-		for _, matches_prefix := range matches_prefixes {
-			buf.WriteString(fmt.Sprintf("%s-", matches_prefix))
-		}
-	}
-	if v, ok := m["matches_suffix"]; ok {
-		matches_suffixes := v.([]interface{})
-		for _, matches_suffix := range matches_suffixes {
-			buf.WriteString(fmt.Sprintf("%s-", matches_suffix))
-		}
-	}
-
-	return tpgresource.Hashcode(buf.String())
-}
-
 func lockRetentionPolicy(bucketsService *storage.BucketsService, bucketName string, metageneration int64) error {
 	lockPolicyCall := bucketsService.LockRetentionPolicy(bucketName, metageneration)
 	if _, err := lockPolicyCall.Do(); err != nil {
@@ -1696,25 +1805,35 @@ func setStorageBucket(d *schema.ResourceData, config *transport_tpg.Config, res
 	// unique but they are associated with projects internally, but some users want to use
 	// buckets in a project agnostic way. Thus we will check to see if the project ID has been
 	// explicitly set and use that first. However if no project is explicitly set, such as during
-	// import, we will look up the ID from the compute API using the project Number from the
+	// import, we will look up the ID from the Resource Manager API using the project Number from the
 	// bucket API response.
 	// If you are working in a project-agnostic way and have not set the project ID in the provider
-	// block, or the resource or an environment variable, we use the compute API to lookup the projectID
-	// from the projectNumber which is included in the bucket API response
+	// block, or the resource or an environment variable, we use the Resource Manager API to lookup the
+	// projectID from the projectNumber which is included in the bucket API response. Unlike the compute
+	// API, it doesn't need to be enabled in the bucket's project.
 	if d.Get("project") == "" {
 		project, _ := tpgresource.GetProject(d, config)
 		if err := d.Set("project", project); err != nil {
 			return fmt.Errorf("Error setting project: %s", err)
 		}
 	}
-	if d.Get("project") == "" {
-		proj, err := config.NewComputeClient(userAgent).Projects.Get(strconv.FormatUint(res.ProjectNumber, 10)).Do()
+	// The lookup is skipped when asked to, and it is allowed to fail when the Resource Manager API is
+	// disabled or the caller can't get the project, so that a refresh doesn't break on a project ID
+	// that only serves to populate state.
+	if d.Get("project") == "" && d.Get("skip_project_number_lookup").(bool) {
+		log.Printf("[DEBUG] Not looking up the ID of project number %d of bucket %s, as skip_project_number_lookup is set", res.ProjectNumber, res.Name)
+	} else if d.Get("project") == "" {
+		proj, err := config.NewResourceManagerClient(userAgent).Projects.Get(strconv.FormatUint(res.ProjectNumber, 10)).Do()
 		if err != nil {
-			return err
-		}
-		log.Printf("[DEBUG] Bucket %v is in project number %v, which is project ID %s.\n", res.Name, res.ProjectNumber, proj.Name)
-		if err := d.Set("project", proj.Name); err != nil {
-			return fmt.Errorf("Error setting project: %s", err)
+			if !transport_tpg.IsGoogleApiErrorWithCode(err, 403) {
+				return fmt.Errorf("Error looking up the ID of project number %d of bucket %s: %s", res.ProjectNumber, res.Name, err)
+			}
+			log.Printf("[WARN] Unable to look up the ID of project number %d of bucket %s, leaving project unset: %s", res.ProjectNumber, res.Name, err)
+		} else {
+			log.Printf("[DEBUG] Bucket %v is in project number %v, which is project ID %s.\n", res.Name, res.ProjectNumber, proj.ProjectId)
+			if err := d.Set("project", proj.ProjectId); err != nil {
+				return fmt.Errorf("Error setting project: %s", err)
+			}
 		}
 	}
 
@@ -1722,6 +1841,9 @@ func setStorageBucket(d *schema.ResourceData, config *transport_tpg.Config, res
 	if err := d.Set("self_link", res.SelfLink); err != nil {
 		return fmt.Errorf("Error setting self_link: %s", err)
 	}
+	if err := d.Set("etag", res.Etag); err != nil {
+		return fmt.Errorf("Error setting etag: %s", err)
+	}
 	if err := d.Set("url", fmt.Sprintf("gs://%s", bucket)); err != nil {
 		return fmt.Errorf("Error setting url: %s", err)
 	}
@@ -1731,8 +1853,17 @@ func setStorageBucket(d *schema.ResourceData, config *transport_tpg.Config, res
 	if err := d.Set("storage_class", res.StorageClass); err != nil {
 		return fmt.Errorf("Error setting storage_class: %s", err)
 	}
-	if err := d.Set("encryption", flattenBucketEncryption(res.Encryption)); err != nil {
-		return fmt.Errorf("Error setting encryption: %s", err)
+	effectiveKmsKey := ""
+	if res.Encryption != nil {
+		effectiveKmsKey = res.Encryption.DefaultKmsKeyName
+	}
+	if !tpgresource.IsDefaultKmsKeyInherited(d, "encryption", effectiveKmsKey) {
+		if err := d.Set("encryption", flattenBucketEncryption(res.Encryption)); err != nil {
+			return fmt.Errorf("Error setting encryption: %s", err)
+		}
+	}
+	if err := d.Set("effective_kms_key", effectiveKmsKey); err != nil {
+		return fmt.Errorf("Error setting effective_kms_key: %s", err)
 	}
 	if err := d.Set("location", res.Location); err != nil {
 		return fmt.Errorf("Error setting location: %s", err)
@@ -55,6 +55,14 @@ func ResourceStorageBucketAcl() *schema.Resource {
 				ConflictsWith: []string{"predefined_acl"},
 				Description:   `List of role/entity pairs in the form ROLE:entity. See GCS Bucket ACL documentation  for more details. Must be set if predefined_acl is not.`,
 			},
+
+			"authoritative": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+				Description: `Whether role_entity is the complete list of entities on the bucket's ACL. If false, entities that are not
+listed in role_entity are ignored instead of being removed. Defaults to true.`,
+			},
 		},
 		UseJSONNumber: true,
 	}
@@ -240,6 +248,7 @@ func resourceStorageBucketAclRead(d *schema.ResourceData, meta interface{}) erro
 		for _, item := range res.Items {
 			entities = append(entities, item.Role+":"+item.Entity)
 		}
+		entities = filterStorageBucketAclRoleEntities(entities, d.Get("role_entity").([]interface{}), d.Get("authoritative").(bool))
 
 		if err := d.Set("role_entity", entities); err != nil {
 			return fmt.Errorf("Error setting role_entity: %s", err)
@@ -258,6 +267,45 @@ func resourceStorageBucketAclRead(d *schema.ResourceData, meta interface{}) erro
 	return nil
 }
 
+// filterStorageBucketAclRoleEntities returns the role/entity pairs of a
+// bucket's ACL that are managed by the resource, given the configured pairs.
+//
+// GCS adds the project owners entity to every bucket and re-adds it if it is
+// removed, so it is only kept when configured. If the resource isn't
+// authoritative, only the entities that are configured are kept.
+func filterStorageBucketAclRoleEntities(remote []string, configured []interface{}, authoritative bool) []string {
+	configuredEntities := make(map[string]struct{}, len(configured))
+	for _, v := range configured {
+		if v == nil {
+			continue
+		}
+		if pair, err := GetRoleEntityPair(v.(string)); err == nil {
+			configuredEntities[pair.Entity] = struct{}{}
+		}
+	}
+
+	filtered := make([]string, 0, len(remote))
+	for _, re := range remote {
+		pair, err := GetRoleEntityPair(re)
+		if err != nil {
+			filtered = append(filtered, re)
+			continue
+		}
+		if _, ok := configuredEntities[pair.Entity]; ok {
+			filtered = append(filtered, re)
+			continue
+		}
+		if !authoritative {
+			continue
+		}
+		if pair.Role == "OWNER" && strings.HasPrefix(pair.Entity, "project-owners-") {
+			continue
+		}
+		filtered = append(filtered, re)
+	}
+	return filtered
+}
+
 func resourceStorageBucketAclUpdate(d *schema.ResourceData, meta interface{}) error {
 	config := meta.(*transport_tpg.Config)
 	userAgent, err := tpgresource.GenerateUserAgentString(d, config.UserAgent)
@@ -0,0 +1,60 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+package storage
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestFilterStorageBucketAclRoleEntities(t *testing.T) {
+	t.Parallel()
+
+	remote := []string{
+		"OWNER:project-owners-123456789",
+		"READER:user-a@example.com",
+		"WRITER:user-b@example.com",
+	}
+
+	cases := map[string]struct {
+		configured    []interface{}
+		authoritative bool
+		expected      []string
+	}{
+		"authoritative drops unconfigured project owners": {
+			configured:    []interface{}{"READER:user-a@example.com"},
+			authoritative: true,
+			expected: []string{
+				"READER:user-a@example.com",
+				"WRITER:user-b@example.com",
+			},
+		},
+		"authoritative keeps configured project owners": {
+			configured:    []interface{}{"OWNER:project-owners-123456789", "READER:user-a@example.com"},
+			authoritative: true,
+			expected:      remote,
+		},
+		"non-authoritative keeps configured entities only": {
+			configured:    []interface{}{"READER:user-a@example.com"},
+			authoritative: false,
+			expected:      []string{"READER:user-a@example.com"},
+		},
+		"non-authoritative reports role drift of configured entities": {
+			configured:    []interface{}{"OWNER:user-b@example.com"},
+			authoritative: false,
+			expected:      []string{"WRITER:user-b@example.com"},
+		},
+		"non-authoritative with nothing configured": {
+			configured:    []interface{}{},
+			authoritative: false,
+			expected:      []string{},
+		},
+	}
+
+	for tn, tc := range cases {
+		got := filterStorageBucketAclRoleEntities(remote, tc.configured, tc.authoritative)
+		if !reflect.DeepEqual(got, tc.expected) {
+			t.Errorf("%s: expected %v, got %v", tn, tc.expected, got)
+		}
+	}
+}
@@ -3,7 +3,12 @@
 package storage
 
 import (
+	"context"
+	"reflect"
 	"testing"
+
+	"github.com/hashicorp/terraform-provider-google-beta/google-beta/tpgresource"
+	transport_tpg "github.com/hashicorp/terraform-provider-google-beta/google-beta/transport"
 )
 
 func TestLabelDiffSuppress(t *testing.T) {
@@ -84,3 +89,282 @@ func TestLabelDiffSuppress(t *testing.T) {
 		}
 	}
 }
+
+func TestParseStorageBucketImportId(t *testing.T) {
+	cases := map[string]struct {
+		Id              string
+		ExpectedProject string
+		ExpectedName    string
+		ExpectError     bool
+	}{
+		"name": {
+			Id:           "my-bucket",
+			ExpectedName: "my-bucket",
+		},
+		"project and name": {
+			Id:              "my-project/my-bucket",
+			ExpectedProject: "my-project",
+			ExpectedName:    "my-bucket",
+		},
+		"resource name": {
+			Id:              "projects/my-project/buckets/my-bucket",
+			ExpectedProject: "my-project",
+			ExpectedName:    "my-bucket",
+		},
+		"relative self link": {
+			Id:           "b/my-bucket",
+			ExpectedName: "my-bucket",
+		},
+		"self link": {
+			Id:           "https://www.googleapis.com/storage/v1/b/my-bucket",
+			ExpectedName: "my-bucket",
+		},
+		"gsutil uri": {
+			Id:           "gs://my-bucket",
+			ExpectedName: "my-bucket",
+		},
+		"domain-named bucket": {
+			Id:              "my-project/www.example.com",
+			ExpectedProject: "my-project",
+			ExpectedName:    "www.example.com",
+		},
+		"too many parts": {
+			Id:          "my-project/my-bucket/extra",
+			ExpectError: true,
+		},
+	}
+
+	for tn, tc := range cases {
+		project, name, err := parseStorageBucketImportId(tc.Id)
+		if tc.ExpectError {
+			if err == nil {
+				t.Errorf("%s: expected an error", tn)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("%s: unexpected error: %s", tn, err)
+			continue
+		}
+		if project != tc.ExpectedProject || name != tc.ExpectedName {
+			t.Errorf("%s: expected project %q and name %q, got %q and %q", tn, tc.ExpectedProject, tc.ExpectedName, project, name)
+		}
+	}
+}
+
+func TestStorageUserProject(t *testing.T) {
+	cases := map[string]struct {
+		UserProjectOverride bool
+		BillingProject      string
+		RequesterPays       bool
+		Expected            string
+	}{
+		"not requester pays": {
+			Expected: "",
+		},
+		"requester pays bills the bucket's project": {
+			RequesterPays: true,
+			Expected:      "bucket-project",
+		},
+		"billing project without user_project_override": {
+			BillingProject: "billing-project",
+			RequesterPays:  true,
+			Expected:       "bucket-project",
+		},
+		"billing project with user_project_override": {
+			UserProjectOverride: true,
+			BillingProject:      "billing-project",
+			Expected:            "billing-project",
+		},
+	}
+
+	for tn, tc := range cases {
+		d := &tpgresource.ResourceDataMock{
+			FieldsInSchema: map[string]interface{}{
+				"project": "bucket-project",
+			},
+		}
+		config := &transport_tpg.Config{
+			UserProjectOverride: tc.UserProjectOverride,
+			BillingProject:      tc.BillingProject,
+		}
+		if got := storageUserProject(d, config, tc.RequesterPays); got != tc.Expected {
+			t.Errorf("%s: expected %q, got %q", tn, tc.Expected, got)
+		}
+	}
+}
+
+func TestValidateSoftDeleteRetentionDuration(t *testing.T) {
+	cases := map[string]struct {
+		Value       int
+		ExpectError bool
+	}{
+		"disabled":     {Value: 0},
+		"seven days":   {Value: 604800},
+		"ninety days":  {Value: 7776000},
+		"negative":     {Value: -1, ExpectError: true},
+		"under a week": {Value: 86400, ExpectError: true},
+		"over 90 days": {Value: 7776001, ExpectError: true},
+	}
+
+	for tn, tc := range cases {
+		_, errs := validateSoftDeleteRetentionDuration(tc.Value, "soft_delete_policy.0.retention_duration_seconds")
+		if tc.ExpectError && len(errs) == 0 {
+			t.Errorf("%s: expected an error for %d", tn, tc.Value)
+		}
+		if !tc.ExpectError && len(errs) > 0 {
+			t.Errorf("%s: unexpected errors for %d: %v", tn, tc.Value, errs)
+		}
+	}
+}
+
+func TestExpandStorageBucketLifecycleRule(t *testing.T) {
+	rule, err := expandStorageBucketLifecycleRule(map[string]interface{}{
+		"action": []interface{}{
+			map[string]interface{}{
+				"type":          "SetStorageClass",
+				"storage_class": "NEARLINE",
+			},
+		},
+		"condition": []interface{}{
+			map[string]interface{}{
+				"age":                   30,
+				"with_state":            "LIVE",
+				"matches_storage_class": []interface{}{"STANDARD"},
+			},
+		},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if rule.Action.Type != "SetStorageClass" || rule.Action.StorageClass != "NEARLINE" {
+		t.Errorf("unexpected action: %+v", rule.Action)
+	}
+	if rule.Condition.Age == nil || *rule.Condition.Age != 30 {
+		t.Errorf("expected age 30, got %v", rule.Condition.Age)
+	}
+	if rule.Condition.IsLive == nil || !*rule.Condition.IsLive {
+		t.Errorf("expected is_live to be true, got %v", rule.Condition.IsLive)
+	}
+
+	if _, err := expandStorageBucketLifecycleRule(map[string]interface{}{
+		"action":    []interface{}{},
+		"condition": []interface{}{map[string]interface{}{"age": 1}},
+	}); err == nil {
+		t.Errorf("expected an error for a rule without an action")
+	}
+}
+
+func TestExpandBucketAutoclass(t *testing.T) {
+	if got := expandBucketAutoclass([]interface{}{}); got != nil {
+		t.Errorf("expected nil for an empty block, got %+v", got)
+	}
+
+	autoclass := expandBucketAutoclass([]interface{}{
+		map[string]interface{}{
+			"enabled":                true,
+			"terminal_storage_class": "ARCHIVE",
+		},
+	})
+	if !autoclass.Enabled || autoclass.TerminalStorageClass != "ARCHIVE" {
+		t.Errorf("unexpected autoclass: %+v", autoclass)
+	}
+
+	// Enabled must always be sent so that autoclass can be turned off
+	autoclass = expandBucketAutoclass([]interface{}{
+		map[string]interface{}{
+			"enabled":                false,
+			"terminal_storage_class": "",
+		},
+	})
+	if autoclass.Enabled || autoclass.TerminalStorageClass != "" {
+		t.Errorf("unexpected autoclass: %+v", autoclass)
+	}
+	if !reflect.DeepEqual(autoclass.ForceSendFields, []string{"Enabled"}) {
+		t.Errorf("expected Enabled to be force sent, got %v", autoclass.ForceSendFields)
+	}
+}
+
+func TestValidateRetentionPolicyLock(t *testing.T) {
+	cases := map[string]struct {
+		Before, After map[string]interface{}
+		ExpectError   bool
+	}{
+		"new bucket": {
+			Before: map[string]interface{}{},
+			After:  map[string]interface{}{"retention_policy.0.is_locked": true},
+		},
+		"locking": {
+			Before: map[string]interface{}{"retention_policy.0.is_locked": false},
+			After:  map[string]interface{}{"retention_policy.0.is_locked": true},
+		},
+		"staying locked": {
+			Before: map[string]interface{}{"retention_policy.0.is_locked": true},
+			After:  map[string]interface{}{"retention_policy.0.is_locked": true},
+		},
+		"unlocking": {
+			Before:      map[string]interface{}{"retention_policy.0.is_locked": true},
+			After:       map[string]interface{}{"retention_policy.0.is_locked": false},
+			ExpectError: true,
+		},
+	}
+
+	for tn, tc := range cases {
+		d := &tpgresource.ResourceDiffMock{
+			Before: tc.Before,
+			After:  tc.After,
+		}
+		err := validateRetentionPolicyLock(d)
+		if tc.ExpectError && err == nil {
+			t.Errorf("%s: expected an error", tn)
+		}
+		if !tc.ExpectError && err != nil {
+			t.Errorf("%s: unexpected error: %s", tn, err)
+		}
+	}
+}
+
+func TestResourceStorageBucketStateUpgradeV1(t *testing.T) {
+	rawState := map[string]interface{}{
+		"name": "my-bucket",
+		"lifecycle_rule": []interface{}{
+			map[string]interface{}{
+				"action":    []interface{}{map[string]interface{}{"type": "Delete", "storage_class": ""}},
+				"condition": []interface{}{map[string]interface{}{"age": 30}},
+			},
+		},
+	}
+
+	upgraded, err := ResourceStorageBucketStateUpgradeV1(context.Background(), rawState, nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if !reflect.DeepEqual(upgraded, rawState) {
+		t.Errorf("expected the state to be unchanged, got %v", upgraded)
+	}
+}
+
+func TestStorageBucketPublicAccessPreventionValidation(t *testing.T) {
+	validate := ResourceStorageBucket().Schema["public_access_prevention"].ValidateFunc
+
+	cases := map[string]struct {
+		Value       string
+		ExpectError bool
+	}{
+		"enforced":    {Value: "enforced"},
+		"inherited":   {Value: "inherited"},
+		"unspecified": {Value: "unspecified"},
+		"upper case":  {Value: "ENFORCED", ExpectError: true},
+		"unknown":     {Value: "disabled", ExpectError: true},
+	}
+
+	for tn, tc := range cases {
+		_, errs := validate(tc.Value, "public_access_prevention")
+		if tc.ExpectError && len(errs) == 0 {
+			t.Errorf("%s: expected an error for %q", tn, tc.Value)
+		}
+		if !tc.ExpectError && len(errs) > 0 {
+			t.Errorf("%s: unexpected errors for %q: %v", tn, tc.Value, errs)
+		}
+	}
+}
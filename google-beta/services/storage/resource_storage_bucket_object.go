@@ -352,6 +352,9 @@ func resourceStorageBucketObjectCreate(d *schema.ResourceData, meta interface{})
 	insertCall := objectsService.Insert(bucket, object)
 	insertCall.Name(name)
 	insertCall.Media(media)
+	if userProject := storageUserProject(d, config, false); userProject != "" {
+		insertCall.UserProject(userProject)
+	}
 
 	// This is done late as we need to add headers to enable customer encryption
 	if v, ok := d.GetOk("customer_encryption"); ok {
@@ -380,6 +383,10 @@ func resourceStorageBucketObjectUpdate(d *schema.ResourceData, meta interface{})
 
 	objectsService := storage.NewObjectsService(config.NewStorageClientWithTimeoutOverride(userAgent, d.Timeout(schema.TimeoutUpdate)))
 	getCall := objectsService.Get(bucket, name)
+	userProject := storageUserProject(d, config, false)
+	if userProject != "" {
+		getCall.UserProject(userProject)
+	}
 
 	res, err := getCall.Do()
 	if err != nil {
@@ -407,6 +414,9 @@ func resourceStorageBucketObjectUpdate(d *schema.ResourceData, meta interface{})
 	}
 
 	updateCall := objectsService.Update(bucket, name, res)
+	if userProject != "" {
+		updateCall.UserProject(userProject)
+	}
 	if hasRetentionChanges {
 		updateCall.OverrideUnlockedRetention(true)
 	}
@@ -431,6 +441,9 @@ func resourceStorageBucketObjectRead(d *schema.ResourceData, meta interface{}) e
 
 	objectsService := storage.NewObjectsService(config.NewStorageClientWithTimeoutOverride(userAgent, d.Timeout(schema.TimeoutRead)))
 	getCall := objectsService.Get(bucket, name)
+	if userProject := storageUserProject(d, config, false); userProject != "" {
+		getCall.UserProject(userProject)
+	}
 
 	if v, ok := d.GetOk("customer_encryption"); ok {
 		customerEncryption := expandCustomerEncryption(v.([]interface{}))
@@ -513,6 +526,9 @@ func resourceStorageBucketObjectDelete(d *schema.ResourceData, meta interface{})
 	objectsService := storage.NewObjectsService(config.NewStorageClientWithTimeoutOverride(userAgent, d.Timeout(schema.TimeoutDelete)))
 
 	DeleteCall := objectsService.Delete(bucket, name)
+	if userProject := storageUserProject(d, config, false); userProject != "" {
+		DeleteCall.UserProject(userProject)
+	}
 	err = DeleteCall.Do()
 
 	if err != nil {
@@ -65,6 +65,7 @@ func testSweepStorageBucket(region string) error {
 	log.Printf("[INFO][SWEEPER_LOG] Found %d items in %s list response.", len(rl), resourceName)
 	// Count items that weren't sweeped.
 	nonPrefixCount := 0
+	var ids []string
 	for _, ri := range rl {
 		obj := ri.(map[string]interface{})
 
@@ -74,20 +75,22 @@ func testSweepStorageBucket(region string) error {
 			nonPrefixCount++
 			continue
 		}
+		ids = append(ids, id)
+	}
 
+	deleted, err := sweeper.DeleteInParallel(resourceName, ids, func(id string) error {
 		deleteUrl := fmt.Sprintf("https://storage.googleapis.com/storage/v1/b/%s", id)
-		_, err = transport_tpg.SendRequest(transport_tpg.SendRequestOptions{
+		_, err := transport_tpg.SendRequest(transport_tpg.SendRequestOptions{
 			Config:    config,
 			Method:    "DELETE",
 			Project:   config.Project,
 			RawURL:    deleteUrl,
 			UserAgent: config.UserAgent,
 		})
-		if err != nil {
-			log.Printf("[INFO][SWEEPER_LOG] Error deleting for url %s : %s", deleteUrl, err)
-		} else {
-			log.Printf("[INFO][SWEEPER_LOG] Deleted a %s resource: %s", resourceName, id)
-		}
+		return err
+	})
+	if err != nil {
+		log.Printf("[INFO][SWEEPER_LOG] Deleted %d of %d %s resources: %s", deleted, len(ids), resourceName, err)
 	}
 
 	if nonPrefixCount > 0 {
@@ -39,6 +39,8 @@ func TestAccStorageBucket_basic(t *testing.T) {
 						"google_storage_bucket.bucket", "project", envvar.GetTestProjectFromEnv()),
 					resource.TestCheckResourceAttrSet(
 						"google_storage_bucket.bucket", "project_number"),
+					resource.TestCheckResourceAttrSet(
+						"google_storage_bucket.bucket", "etag"),
 				),
 			},
 			{
@@ -54,6 +56,51 @@ func TestAccStorageBucket_basic(t *testing.T) {
 				ImportStateVerify:       true,
 				ImportStateVerifyIgnore: []string{"force_destroy"},
 			},
+			{
+				ResourceName:            "google_storage_bucket.bucket",
+				ImportStateId:           fmt.Sprintf("projects/%s/buckets/%s", envvar.GetTestProjectFromEnv(), bucketName),
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"force_destroy"},
+			},
+			{
+				ResourceName:            "google_storage_bucket.bucket",
+				ImportStateId:           fmt.Sprintf("https://www.googleapis.com/storage/v1/b/%s", bucketName),
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"force_destroy"},
+			},
+		},
+	})
+}
+
+func TestAccStorageBucket_deletionProtection(t *testing.T) {
+	t.Parallel()
+
+	bucketName := acctest.TestBucketName(t)
+
+	acctest.VcrTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.AccTestPreCheck(t) },
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories(t),
+		CheckDestroy:             testAccStorageBucketDestroyProducer(t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccStorageBucket_deletionProtection(bucketName, true),
+			},
+			{
+				ResourceName:            "google_storage_bucket.bucket",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"force_destroy", "deletion_protection"},
+			},
+			{
+				Config:      testAccStorageBucket_deletionProtection(bucketName, true),
+				Destroy:     true,
+				ExpectError: regexp.MustCompile("cannot destroy bucket .* without setting deletion_protection=false"),
+			},
+			{
+				Config: testAccStorageBucket_deletionProtection(bucketName, false),
+			},
 		},
 	})
 }
@@ -110,6 +157,35 @@ func TestAccStorageBucket_basicWithAutoclass(t *testing.T) {
 	})
 }
 
+func TestAccStorageBucket_autoclassRemoved(t *testing.T) {
+	t.Parallel()
+
+	bucketName := acctest.TestBucketName(t)
+
+	acctest.VcrTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.AccTestPreCheck(t) },
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories(t),
+		CheckDestroy:             testAccStorageBucketDestroyProducer(t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccStorageBucket_basicWithAutoclass(bucketName, true),
+			},
+			{
+				Config: testAccStorageBucket_basic(bucketName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"google_storage_bucket.bucket", "autoclass.0.enabled", "false"),
+				),
+			},
+			{
+				// The disabled autoclass returned by the API doesn't cause a diff
+				Config:   testAccStorageBucket_basic(bucketName),
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
 func TestAccStorageBucket_requesterPays(t *testing.T) {
 	t.Parallel()
 
@@ -183,6 +259,34 @@ func TestAccStorageBucket_dualLocation(t *testing.T) {
 	})
 }
 
+func TestAccStorageBucket_dualLocation_lowercase(t *testing.T) {
+	t.Parallel()
+
+	bucketName := acctest.TestBucketName(t)
+
+	acctest.VcrTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.AccTestPreCheck(t) },
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories(t),
+		CheckDestroy:             testAccStorageBucketDestroyProducer(t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccStorageBucket_dualLocationLowercase(bucketName),
+			},
+			{
+				// Regions are returned in upper case, which shouldn't cause a diff
+				Config:   testAccStorageBucket_dualLocationLowercase(bucketName),
+				PlanOnly: true,
+			},
+			{
+				ResourceName:            "google_storage_bucket.bucket",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"force_destroy"},
+			},
+		},
+	})
+}
+
 func TestAccStorageBucket_dualLocation_rpo(t *testing.T) {
 	t.Parallel()
 	bucketName := acctest.TestBucketName(t)
@@ -1294,6 +1398,9 @@ func TestAccStorageBucket_website(t *testing.T) {
 			},
 			{
 				Config: testAccStorageBucket_websiteRemoved(bucketSuffix),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("google_storage_bucket.website", "website.#", "0"),
+				),
 			},
 			{
 				ResourceName:            "google_storage_bucket.website",
@@ -1309,7 +1416,6 @@ func TestAccStorageBucket_retentionPolicyLocked(t *testing.T) {
 	t.Parallel()
 
 	var bucket storage.Bucket
-	var newBucket storage.Bucket
 	bucketName := fmt.Sprintf("tf-test-acc-bucket-%d", acctest.RandInt(t))
 
 	acctest.VcrTest(t, resource.TestCase{
@@ -1332,12 +1438,8 @@ func TestAccStorageBucket_retentionPolicyLocked(t *testing.T) {
 				ImportStateVerifyIgnore: []string{"force_destroy"},
 			},
 			{
-				Config: testAccStorageBucket_retentionPolicy(bucketName),
-				Check: resource.ComposeTestCheckFunc(
-					testAccCheckStorageBucketExists(
-						t, "google_storage_bucket.bucket", bucketName, &newBucket),
-					testAccCheckStorageBucketWasRecreated(&newBucket, &bucket),
-				),
+				Config:      testAccStorageBucket_retentionPolicy(bucketName),
+				ExpectError: regexp.MustCompile("cannot be unlocked or removed"),
 			},
 		},
 	})
@@ -1587,6 +1689,17 @@ resource "google_storage_bucket" "bucket" {
 `, bucketName)
 }
 
+func testAccStorageBucket_deletionProtection(bucketName string, deletionProtection bool) string {
+	return fmt.Sprintf(`
+resource "google_storage_bucket" "bucket" {
+  name                = "%s"
+  location            = "US"
+  force_destroy       = true
+  deletion_protection = %t
+}
+`, bucketName, deletionProtection)
+}
+
 func testAccStorageBucket_basicWithAutoclass(bucketName string, autoclass bool) string {
 	return fmt.Sprintf(`
 resource "google_storage_bucket" "bucket" {
@@ -1648,6 +1761,19 @@ resource "google_storage_bucket" "bucket" {
 `, bucketName)
 }
 
+func testAccStorageBucket_dualLocationLowercase(bucketName string) string {
+	return fmt.Sprintf(`
+resource "google_storage_bucket" "bucket" {
+  name          = "%s"
+  location      = "asia"
+  force_destroy = true
+  custom_placement_config {
+    data_locations = ["asia-east1", "asia-southeast1"]
+  }
+}
+`, bucketName)
+}
+
 func testAccStorageBucket_dualLocation_rpo(bucketName string, rpo string) string {
 	return fmt.Sprintf(`
 resource "google_storage_bucket" "bucket" {
@@ -0,0 +1,459 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+package storage
+
+import (
+	"fmt"
+	"log"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	"github.com/hashicorp/terraform-provider-google-beta/google-beta/services/pubsub"
+	"github.com/hashicorp/terraform-provider-google-beta/google-beta/tpgresource"
+	transport_tpg "github.com/hashicorp/terraform-provider-google-beta/google-beta/transport"
+
+	pubsubapi "google.golang.org/api/pubsub/v1"
+	"google.golang.org/api/storage/v1"
+)
+
+// storageNotificationWebhookIdRegex matches the id of a
+// google_storage_notification_webhook, which identifies both the notification
+// config of the bucket and the push subscription.
+var storageNotificationWebhookIdRegex = regexp.MustCompile(`^([^/]+)/notificationConfigs/([^/]+)/projects/([^/]+)/subscriptions/([^/]+)$`)
+
+func ResourceStorageNotificationWebhook() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceStorageNotificationWebhookCreate,
+		Read:   resourceStorageNotificationWebhookRead,
+		Update: resourceStorageNotificationWebhookUpdate,
+		Delete: resourceStorageNotificationWebhookDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceStorageNotificationWebhookImport,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(20 * time.Minute),
+			Update: schema.DefaultTimeout(20 * time.Minute),
+			Delete: schema.DefaultTimeout(20 * time.Minute),
+		},
+
+		CustomizeDiff: tpgresource.DefaultProviderProject,
+
+		Schema: map[string]*schema.Schema{
+			"bucket": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: `The name of the bucket.`,
+			},
+
+			"topic": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				DiffSuppressFunc: tpgresource.CompareSelfLinkOrResourceName,
+				Description:      `The Cloud Pub/Sub topic to which the bucket publishes notifications, and to which the push subscription is attached. Expects either the topic name, assumed to belong to the resource's project, or the project-level name, i.e. projects/my-gcp-project/topics/my-topic. The Cloud Storage service agent of the bucket's project needs roles/pubsub.publisher on the topic.`,
+			},
+
+			"subscription": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringDoesNotContainAny("/"),
+				Description:  `The name of the push subscription that delivers the notifications to push_endpoint, in the resource's project.`,
+			},
+
+			"push_endpoint": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.IsURLWithHTTPS,
+				Description:  `The HTTPS URL of the endpoint that the notifications are pushed to.`,
+			},
+
+			"service_account_email": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: `The email of the service account used to generate the OIDC token sent with each push request.`,
+			},
+
+			"audience": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: `The audience of the OIDC token sent with each push request. If unset, the audience is push_endpoint.`,
+			},
+
+			"ack_deadline_seconds": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IntBetween(10, 600),
+				Description:  `The number of seconds the endpoint has to acknowledge a notification before it is delivered again. Pub/Sub uses 10 seconds if unset.`,
+			},
+
+			"payload_format": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      "JSON_API_V1",
+				ValidateFunc: validation.StringInSlice([]string{"JSON_API_V1", "NONE"}, false),
+				Description:  `The desired content of the notification payload. One of "JSON_API_V1" or "NONE". Defaults to "JSON_API_V1".`,
+			},
+
+			"custom_attributes": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				ForceNew: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+				Description: `A set of key/value attribute pairs to attach to each notification.`,
+			},
+
+			"event_types": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				ForceNew: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+					ValidateFunc: validation.StringInSlice([]string{
+						"OBJECT_FINALIZE", "OBJECT_METADATA_UPDATE", "OBJECT_DELETE", "OBJECT_ARCHIVE"},
+						false),
+				},
+				Description: `List of event type filters for the notifications. If not specified, Cloud Storage sends notifications for all event types. The valid types are: "OBJECT_FINALIZE", "OBJECT_METADATA_UPDATE", "OBJECT_DELETE", "OBJECT_ARCHIVE"`,
+			},
+
+			"object_name_prefix": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Description: `Specifies a prefix path filter for the notifications. Cloud Storage only sends notifications for objects in the bucket whose names begin with the specified prefix.`,
+			},
+
+			"project": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+				Description: `The project of the push subscription, and of the topic if it is given by name. If it is not provided, the provider project is used.`,
+			},
+
+			"notification_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: `The ID of the notification config of the bucket.`,
+			},
+
+			"notification_self_link": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: `The URI of the notification config of the bucket.`,
+			},
+
+			"subscription_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: `The ID of the push subscription, in the format projects/{{project}}/subscriptions/{{subscription}}.`,
+			},
+		},
+		UseJSONNumber: true,
+	}
+}
+
+func resourceStorageNotificationWebhookCreate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*transport_tpg.Config)
+	userAgent, err := tpgresource.GenerateUserAgentString(d, config.UserAgent)
+	if err != nil {
+		return err
+	}
+
+	project, err := tpgresource.GetProject(d, config)
+	if err != nil {
+		return err
+	}
+
+	bucket := d.Get("bucket").(string)
+	topic := storageNotificationWebhookTopicName(project, d.Get("topic").(string))
+	subscription := pubsub.GetComputedSubscriptionName(project, d.Get("subscription").(string))
+
+	// The subscription is created first so that no notification is published
+	// to the topic before it can be delivered.
+	log.Printf("[DEBUG] Creating push subscription %s for bucket %s notifications", subscription, bucket)
+	if err := createStorageNotificationWebhookSubscription(d, config, userAgent, subscription, topic, d.Timeout(schema.TimeoutCreate)); err != nil {
+		return err
+	}
+
+	notification := &storage.Notification{
+		CustomAttributes: tpgresource.ExpandStringMap(d, "custom_attributes"),
+		EventTypes:       tpgresource.ConvertStringSet(d.Get("event_types").(*schema.Set)),
+		ObjectNamePrefix: d.Get("object_name_prefix").(string),
+		PayloadFormat:    d.Get("payload_format").(string),
+		Topic:            topic,
+	}
+	res, err := config.NewStorageClient(userAgent).Notifications.Insert(bucket, notification).Do()
+	if err != nil {
+		// Don't leave behind a subscription that nothing publishes to
+		if _, deleteErr := config.NewPubsubClient(userAgent).Projects.Subscriptions.Delete(subscription).Do(); deleteErr != nil {
+			log.Printf("[WARN] Error deleting push subscription %s after failing to create the notification config: %s", subscription, deleteErr)
+		}
+		return fmt.Errorf("Error creating notification config for bucket %s: %s", bucket, err)
+	}
+
+	d.SetId(fmt.Sprintf("%s/notificationConfigs/%s/%s", bucket, res.Id, subscription))
+
+	return resourceStorageNotificationWebhookRead(d, meta)
+}
+
+func resourceStorageNotificationWebhookRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*transport_tpg.Config)
+	userAgent, err := tpgresource.GenerateUserAgentString(d, config.UserAgent)
+	if err != nil {
+		return err
+	}
+
+	bucket, notificationID, project, subscription, err := parseStorageNotificationWebhookId(d.Id())
+	if err != nil {
+		return err
+	}
+	subscriptionID := fmt.Sprintf("projects/%s/subscriptions/%s", project, subscription)
+
+	notification, err := config.NewStorageClient(userAgent).Notifications.Get(bucket, notificationID).Do()
+	if err != nil {
+		return transport_tpg.HandleNotFoundError(err, d, fmt.Sprintf("Notification configuration %s for bucket %s", notificationID, bucket))
+	}
+
+	sub, err := config.NewPubsubClient(userAgent).Projects.Subscriptions.Get(subscriptionID).Do()
+	if err != nil {
+		if !transport_tpg.IsGoogleApiErrorWithCode(err, 404) {
+			return fmt.Errorf("Error reading push subscription %s: %s", subscriptionID, err)
+		}
+		// Keep the notification config in state, so that it isn't left
+		// behind, and clear the push settings so that the next apply
+		// creates the subscription again.
+		log.Printf("[WARN] Push subscription %s not found, it will be created again", subscriptionID)
+		sub = &pubsubapi.Subscription{}
+	}
+
+	if err := d.Set("bucket", bucket); err != nil {
+		return fmt.Errorf("Error setting bucket: %s", err)
+	}
+	if err := d.Set("topic", notification.Topic); err != nil {
+		return fmt.Errorf("Error setting topic: %s", err)
+	}
+	if err := d.Set("payload_format", notification.PayloadFormat); err != nil {
+		return fmt.Errorf("Error setting payload_format: %s", err)
+	}
+	if err := d.Set("custom_attributes", notification.CustomAttributes); err != nil {
+		return fmt.Errorf("Error setting custom_attributes: %s", err)
+	}
+	if err := d.Set("event_types", notification.EventTypes); err != nil {
+		return fmt.Errorf("Error setting event_types: %s", err)
+	}
+	if err := d.Set("object_name_prefix", notification.ObjectNamePrefix); err != nil {
+		return fmt.Errorf("Error setting object_name_prefix: %s", err)
+	}
+	if err := d.Set("notification_id", notificationID); err != nil {
+		return fmt.Errorf("Error setting notification_id: %s", err)
+	}
+	if err := d.Set("notification_self_link", notification.SelfLink); err != nil {
+		return fmt.Errorf("Error setting notification_self_link: %s", err)
+	}
+
+	if err := d.Set("project", project); err != nil {
+		return fmt.Errorf("Error setting project: %s", err)
+	}
+	if err := d.Set("subscription", subscription); err != nil {
+		return fmt.Errorf("Error setting subscription: %s", err)
+	}
+	if err := d.Set("subscription_id", subscriptionID); err != nil {
+		return fmt.Errorf("Error setting subscription_id: %s", err)
+	}
+	if err := d.Set("ack_deadline_seconds", sub.AckDeadlineSeconds); err != nil {
+		return fmt.Errorf("Error setting ack_deadline_seconds: %s", err)
+	}
+
+	pushEndpoint, serviceAccountEmail, audience := flattenStorageNotificationWebhookPushConfig(sub.PushConfig)
+	if err := d.Set("push_endpoint", pushEndpoint); err != nil {
+		return fmt.Errorf("Error setting push_endpoint: %s", err)
+	}
+	if err := d.Set("service_account_email", serviceAccountEmail); err != nil {
+		return fmt.Errorf("Error setting service_account_email: %s", err)
+	}
+	if err := d.Set("audience", audience); err != nil {
+		return fmt.Errorf("Error setting audience: %s", err)
+	}
+
+	return nil
+}
+
+func resourceStorageNotificationWebhookUpdate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*transport_tpg.Config)
+	userAgent, err := tpgresource.GenerateUserAgentString(d, config.UserAgent)
+	if err != nil {
+		return err
+	}
+
+	subscriptionID := d.Get("subscription_id").(string)
+
+	_, err = config.NewPubsubClient(userAgent).Projects.Subscriptions.Get(subscriptionID).Do()
+	if err != nil {
+		if !transport_tpg.IsGoogleApiErrorWithCode(err, 404) {
+			return fmt.Errorf("Error reading push subscription %s: %s", subscriptionID, err)
+		}
+		project, err := tpgresource.GetProject(d, config)
+		if err != nil {
+			return err
+		}
+		topic := storageNotificationWebhookTopicName(project, d.Get("topic").(string))
+		log.Printf("[DEBUG] Creating push subscription %s again", subscriptionID)
+		if err := createStorageNotificationWebhookSubscription(d, config, userAgent, subscriptionID, topic, d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return err
+		}
+		return resourceStorageNotificationWebhookRead(d, meta)
+	}
+
+	sub := &pubsubapi.Subscription{}
+	updateMask := []string{}
+	if d.HasChanges("push_endpoint", "service_account_email", "audience") {
+		sub.PushConfig = expandStorageNotificationWebhookPushConfig(d)
+		updateMask = append(updateMask, "pushConfig")
+	}
+	if d.HasChange("ack_deadline_seconds") {
+		sub.AckDeadlineSeconds = int64(d.Get("ack_deadline_seconds").(int))
+		updateMask = append(updateMask, "ackDeadlineSeconds")
+	}
+
+	if len(updateMask) > 0 {
+		req := &pubsubapi.UpdateSubscriptionRequest{
+			Subscription: sub,
+			UpdateMask:   strings.Join(updateMask, ","),
+		}
+		log.Printf("[DEBUG] Updating push subscription %s: %s", subscriptionID, req.UpdateMask)
+		if _, err := config.NewPubsubClient(userAgent).Projects.Subscriptions.Patch(subscriptionID, req).Do(); err != nil {
+			return fmt.Errorf("Error updating push subscription %s: %s", subscriptionID, err)
+		}
+	}
+
+	return resourceStorageNotificationWebhookRead(d, meta)
+}
+
+func resourceStorageNotificationWebhookDelete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*transport_tpg.Config)
+	userAgent, err := tpgresource.GenerateUserAgentString(d, config.UserAgent)
+	if err != nil {
+		return err
+	}
+
+	bucket, notificationID, project, subscription, err := parseStorageNotificationWebhookId(d.Id())
+	if err != nil {
+		return err
+	}
+	subscriptionID := fmt.Sprintf("projects/%s/subscriptions/%s", project, subscription)
+
+	// The notification config is deleted first so that nothing is published
+	// to the topic once the subscription is gone.
+	err = config.NewStorageClient(userAgent).Notifications.Delete(bucket, notificationID).Do()
+	if err != nil && !transport_tpg.IsGoogleApiErrorWithCode(err, 404) {
+		return fmt.Errorf("Error deleting notification configuration %s for bucket %s: %s", notificationID, bucket, err)
+	}
+
+	_, err = config.NewPubsubClient(userAgent).Projects.Subscriptions.Delete(subscriptionID).Do()
+	if err != nil && !transport_tpg.IsGoogleApiErrorWithCode(err, 404) {
+		return fmt.Errorf("Error deleting push subscription %s: %s", subscriptionID, err)
+	}
+
+	d.SetId("")
+	return nil
+}
+
+func resourceStorageNotificationWebhookImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	bucket, _, project, subscription, err := parseStorageNotificationWebhookId(d.Id())
+	if err != nil {
+		return nil, err
+	}
+
+	if err := d.Set("bucket", bucket); err != nil {
+		return nil, fmt.Errorf("Error setting bucket: %s", err)
+	}
+	if err := d.Set("project", project); err != nil {
+		return nil, fmt.Errorf("Error setting project: %s", err)
+	}
+	if err := d.Set("subscription", subscription); err != nil {
+		return nil, fmt.Errorf("Error setting subscription: %s", err)
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
+
+// createStorageNotificationWebhookSubscription creates the push subscription
+// of a google_storage_notification_webhook on topic.
+func createStorageNotificationWebhookSubscription(d *schema.ResourceData, config *transport_tpg.Config, userAgent, subscription, topic string, timeout time.Duration) error {
+	sub := &pubsubapi.Subscription{
+		Topic:              topic,
+		PushConfig:         expandStorageNotificationWebhookPushConfig(d),
+		AckDeadlineSeconds: int64(d.Get("ack_deadline_seconds").(int)),
+		// Subscriptions expire after 31 days without activity by default,
+		// which would silently stop the delivery of notifications to a
+		// bucket that rarely changes. An empty ttl means never expire.
+		ExpirationPolicy: &pubsubapi.ExpirationPolicy{
+			Ttl:             "",
+			ForceSendFields: []string{"Ttl"},
+		},
+	}
+
+	err := transport_tpg.Retry(transport_tpg.RetryOptions{
+		RetryFunc: func() error {
+			_, err := config.NewPubsubClient(userAgent).Projects.Subscriptions.Create(subscription, sub).Do()
+			return err
+		},
+		Timeout:              timeout,
+		ErrorRetryPredicates: []transport_tpg.RetryErrorPredicateFunc{transport_tpg.PubsubTopicProjectNotReady},
+	})
+	if err != nil {
+		return fmt.Errorf("Error creating push subscription %s: %s", subscription, err)
+	}
+	return nil
+}
+
+// parseStorageNotificationWebhookId returns the bucket, notification config
+// id, subscription project and subscription name in the id of a
+// google_storage_notification_webhook.
+func parseStorageNotificationWebhookId(id string) (bucket, notificationID, project, subscription string, err error) {
+	parts := storageNotificationWebhookIdRegex.FindStringSubmatch(id)
+	if parts == nil {
+		return "", "", "", "", fmt.Errorf("Invalid storage notification webhook id %q, expected {{bucket}}/notificationConfigs/{{notification_id}}/projects/{{project}}/subscriptions/{{subscription}}", id)
+	}
+	return parts[1], parts[2], parts[3], parts[4], nil
+}
+
+// storageNotificationWebhookTopicName returns the project-level name of topic,
+// which may be given as a name in project, a project-level name or a full
+// resource name such as //pubsub.googleapis.com/projects/p/topics/t.
+func storageNotificationWebhookTopicName(project, topic string) string {
+	topic = strings.TrimPrefix(topic, "//pubsub.googleapis.com/")
+	return pubsub.GetComputedTopicName(project, topic)
+}
+
+func expandStorageNotificationWebhookPushConfig(d tpgresource.TerraformResourceData) *pubsubapi.PushConfig {
+	return &pubsubapi.PushConfig{
+		PushEndpoint: d.Get("push_endpoint").(string),
+		OidcToken: &pubsubapi.OidcToken{
+			ServiceAccountEmail: d.Get("service_account_email").(string),
+			Audience:            d.Get("audience").(string),
+		},
+	}
+}
+
+func flattenStorageNotificationWebhookPushConfig(pushConfig *pubsubapi.PushConfig) (pushEndpoint, serviceAccountEmail, audience string) {
+	if pushConfig == nil {
+		return "", "", ""
+	}
+	if pushConfig.OidcToken != nil {
+		serviceAccountEmail = pushConfig.OidcToken.ServiceAccountEmail
+		audience = pushConfig.OidcToken.Audience
+	}
+	return pushConfig.PushEndpoint, serviceAccountEmail, audience
+}
@@ -0,0 +1,161 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+package storage
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	pubsubapi "google.golang.org/api/pubsub/v1"
+
+	transport_tpg "github.com/hashicorp/terraform-provider-google-beta/google-beta/transport"
+)
+
+func TestParseStorageNotificationWebhookId(t *testing.T) {
+	cases := map[string]struct {
+		Id                 string
+		ExpectBucket       string
+		ExpectNotification string
+		ExpectProject      string
+		ExpectSubscription string
+		ExpectError        bool
+	}{
+		"valid": {
+			Id:                 "my-bucket/notificationConfigs/12/projects/my-project/subscriptions/my-webhook",
+			ExpectBucket:       "my-bucket",
+			ExpectNotification: "12",
+			ExpectProject:      "my-project",
+			ExpectSubscription: "my-webhook",
+		},
+		"storage notification id": {
+			Id:          "my-bucket/notificationConfigs/12",
+			ExpectError: true,
+		},
+		"subscription id": {
+			Id:          "projects/my-project/subscriptions/my-webhook",
+			ExpectError: true,
+		},
+	}
+
+	for tn, tc := range cases {
+		bucket, notificationID, project, subscription, err := parseStorageNotificationWebhookId(tc.Id)
+		if tc.ExpectError {
+			if err == nil {
+				t.Errorf("%s: expected an error", tn)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("%s: unexpected error: %s", tn, err)
+			continue
+		}
+		if bucket != tc.ExpectBucket || notificationID != tc.ExpectNotification || project != tc.ExpectProject || subscription != tc.ExpectSubscription {
+			t.Errorf("%s: got bucket %q, notification %q, project %q, subscription %q", tn, bucket, notificationID, project, subscription)
+		}
+	}
+}
+
+func TestStorageNotificationWebhookTopicName(t *testing.T) {
+	cases := map[string]struct {
+		Topic  string
+		Expect string
+	}{
+		"name": {
+			Topic:  "my-topic",
+			Expect: "projects/my-project/topics/my-topic",
+		},
+		"project-level name": {
+			Topic:  "projects/other-project/topics/my-topic",
+			Expect: "projects/other-project/topics/my-topic",
+		},
+		"full resource name": {
+			Topic:  "//pubsub.googleapis.com/projects/other-project/topics/my-topic",
+			Expect: "projects/other-project/topics/my-topic",
+		},
+	}
+
+	for tn, tc := range cases {
+		if got := storageNotificationWebhookTopicName("my-project", tc.Topic); got != tc.Expect {
+			t.Errorf("%s: expected %q, got %q", tn, tc.Expect, got)
+		}
+	}
+}
+
+func TestStorageNotificationWebhookPushConfig(t *testing.T) {
+	d := ResourceStorageNotificationWebhook().TestResourceData()
+	for k, v := range map[string]interface{}{
+		"push_endpoint":         "https://hooks.example.com/gcs",
+		"service_account_email": "invoker@my-project.iam.gserviceaccount.com",
+		"audience":              "gcs-events",
+	} {
+		if err := d.Set(k, v); err != nil {
+			t.Fatalf("error setting %s: %s", k, err)
+		}
+	}
+
+	pushConfig := expandStorageNotificationWebhookPushConfig(d)
+	if pushConfig.PushEndpoint != "https://hooks.example.com/gcs" {
+		t.Errorf("unexpected push endpoint %q", pushConfig.PushEndpoint)
+	}
+	if pushConfig.OidcToken == nil || pushConfig.OidcToken.ServiceAccountEmail != "invoker@my-project.iam.gserviceaccount.com" || pushConfig.OidcToken.Audience != "gcs-events" {
+		t.Errorf("unexpected OIDC token %#v", pushConfig.OidcToken)
+	}
+
+	pushEndpoint, serviceAccountEmail, audience := flattenStorageNotificationWebhookPushConfig(pushConfig)
+	if pushEndpoint != "https://hooks.example.com/gcs" || serviceAccountEmail != "invoker@my-project.iam.gserviceaccount.com" || audience != "gcs-events" {
+		t.Errorf("push config didn't round trip, got %q, %q, %q", pushEndpoint, serviceAccountEmail, audience)
+	}
+
+	pushEndpoint, serviceAccountEmail, audience = flattenStorageNotificationWebhookPushConfig(&pubsubapi.PushConfig{PushEndpoint: "https://hooks.example.com/gcs"})
+	if pushEndpoint != "https://hooks.example.com/gcs" || serviceAccountEmail != "" || audience != "" {
+		t.Errorf("expected an unauthenticated push config to flatten without a service account, got %q, %q, %q", pushEndpoint, serviceAccountEmail, audience)
+	}
+}
+
+func TestStorageNotificationWebhookRead_subscriptionNotFound(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/b/my-bucket/notificationConfigs/12":
+			_ = json.NewEncoder(w).Encode(map[string]interface{}{
+				"id":             "12",
+				"topic":          "//pubsub.googleapis.com/projects/my-project/topics/my-topic",
+				"payload_format": "JSON_API_V1",
+			})
+		default:
+			w.WriteHeader(http.StatusNotFound)
+			_ = json.NewEncoder(w).Encode(map[string]interface{}{
+				"error": map[string]interface{}{"code": http.StatusNotFound, "message": "Resource not found"},
+			})
+		}
+	}))
+	defer server.Close()
+
+	config := &transport_tpg.Config{
+		Client:          server.Client(),
+		StorageBasePath: server.URL + "/",
+		PubsubBasePath:  server.URL + "/v1/",
+	}
+
+	d := ResourceStorageNotificationWebhook().TestResourceData()
+	d.SetId("my-bucket/notificationConfigs/12/projects/my-project/subscriptions/my-webhook")
+	if err := d.Set("push_endpoint", "https://hooks.example.com/gcs"); err != nil {
+		t.Fatalf("error setting push_endpoint: %s", err)
+	}
+
+	if err := resourceStorageNotificationWebhookRead(d, config); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if d.Id() == "" {
+		t.Fatalf("expected the notification config to be kept in state when only the subscription is gone")
+	}
+	if got := d.Get("push_endpoint").(string); got != "" {
+		t.Errorf("expected push_endpoint to be cleared so that the subscription is created again, got %q", got)
+	}
+	if got := d.Get("notification_id").(string); got != "12" {
+		t.Errorf("expected notification_id %q, got %q", "12", got)
+	}
+}
@@ -0,0 +1,132 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+package storage_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	"github.com/hashicorp/terraform-provider-google-beta/google-beta/acctest"
+)
+
+func TestAccStorageNotificationWebhook_update(t *testing.T) {
+	t.Parallel()
+
+	context := map[string]interface{}{
+		"bucket_name":   acctest.TestBucketName(t),
+		"random_suffix": acctest.RandString(t, 10),
+		"push_endpoint": "https://hooks.example.com/gcs",
+		"ack_deadline":  30,
+	}
+	updated := map[string]interface{}{
+		"bucket_name":   context["bucket_name"],
+		"random_suffix": context["random_suffix"],
+		"push_endpoint": "https://hooks.example.com/gcs/v2",
+		"ack_deadline":  60,
+	}
+
+	acctest.VcrTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.AccTestPreCheck(t) },
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories(t),
+		CheckDestroy:             testAccStorageNotificationWebhookDestroyProducer(t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccStorageNotificationWebhook(context),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("google_storage_notification_webhook.webhook", "notification_id"),
+					resource.TestCheckResourceAttr("google_storage_notification_webhook.webhook", "payload_format", "JSON_API_V1"),
+					resource.TestCheckResourceAttr("google_storage_notification_webhook.webhook", "ack_deadline_seconds", "30"),
+				),
+			},
+			{
+				ResourceName:      "google_storage_notification_webhook.webhook",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccStorageNotificationWebhook(updated),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("google_storage_notification_webhook.webhook", "push_endpoint", "https://hooks.example.com/gcs/v2"),
+					resource.TestCheckResourceAttr("google_storage_notification_webhook.webhook", "ack_deadline_seconds", "60"),
+				),
+			},
+			{
+				ResourceName:      "google_storage_notification_webhook.webhook",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccStorageNotificationWebhookDestroyProducer(t *testing.T) func(s *terraform.State) error {
+	return func(s *terraform.State) error {
+		config := acctest.GoogleProviderConfig(t)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "google_storage_notification_webhook" {
+				continue
+			}
+
+			bucket := rs.Primary.Attributes["bucket"]
+			notificationID := rs.Primary.Attributes["notification_id"]
+			if _, err := config.NewStorageClient(config.UserAgent).Notifications.Get(bucket, notificationID).Do(); err == nil {
+				return fmt.Errorf("Notification configuration %s for bucket %s still exists", notificationID, bucket)
+			}
+
+			subscriptionID := rs.Primary.Attributes["subscription_id"]
+			if _, err := config.NewPubsubClient(config.UserAgent).Projects.Subscriptions.Get(subscriptionID).Do(); err == nil {
+				return fmt.Errorf("Push subscription %s still exists", subscriptionID)
+			}
+		}
+
+		return nil
+	}
+}
+
+func testAccStorageNotificationWebhook(context map[string]interface{}) string {
+	return acctest.Nprintf(`
+resource "google_storage_bucket" "bucket" {
+  name                        = "%{bucket_name}"
+  location                    = "US"
+  uniform_bucket_level_access = true
+  force_destroy               = true
+}
+
+resource "google_pubsub_topic" "topic" {
+  name = "tf-test-webhook-%{random_suffix}"
+}
+
+data "google_storage_project_service_account" "gcs_account" {
+}
+
+resource "google_pubsub_topic_iam_member" "gcs_publisher" {
+  topic  = google_pubsub_topic.topic.id
+  role   = "roles/pubsub.publisher"
+  member = data.google_storage_project_service_account.gcs_account.member
+}
+
+resource "google_service_account" "invoker" {
+  account_id = "tf-test-wh-%{random_suffix}"
+}
+
+resource "google_storage_notification_webhook" "webhook" {
+  bucket       = google_storage_bucket.bucket.name
+  topic        = google_pubsub_topic.topic.id
+  subscription = "tf-test-webhook-%{random_suffix}"
+
+  push_endpoint         = "%{push_endpoint}"
+  service_account_email = google_service_account.invoker.email
+  audience              = "gcs-events"
+  ack_deadline_seconds  = %{ack_deadline}
+
+  event_types        = ["OBJECT_FINALIZE", "OBJECT_DELETE"]
+  object_name_prefix = "uploads/"
+
+  depends_on = [google_pubsub_topic_iam_member.gcs_publisher]
+}
+`, context)
+}
@@ -56,7 +56,7 @@ func ResourceStorageInsightsReportConfig() *schema.Resource {
 		Schema: map[string]*schema.Schema{
 			"csv_options": {
 				Type:        schema.TypeList,
-				Required:    true,
+				Optional:    true,
 				Description: `Options for configuring the format of the inventory report CSV file.`,
 				MaxItems:    1,
 				Elem: &schema.Resource{
@@ -78,6 +78,17 @@ func ResourceStorageInsightsReportConfig() *schema.Resource {
 						},
 					},
 				},
+				ExactlyOneOf: []string{"csv_options", "parquet_options"},
+			},
+			"parquet_options": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: `An option for outputting inventory reports as parquet files.`,
+				MaxItems:    1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{},
+				},
+				ExactlyOneOf: []string{"csv_options", "parquet_options"},
 			},
 			"location": {
 				Type:     schema.TypeString,
@@ -247,6 +258,12 @@ func resourceStorageInsightsReportConfigCreate(d *schema.ResourceData, meta inte
 	} else if v, ok := d.GetOkExists("csv_options"); !tpgresource.IsEmptyValue(reflect.ValueOf(csvOptionsProp)) && (ok || !reflect.DeepEqual(v, csvOptionsProp)) {
 		obj["csvOptions"] = csvOptionsProp
 	}
+	parquetOptionsProp, err := expandStorageInsightsReportConfigParquetOptions(d.Get("parquet_options"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("parquet_options"); parquetOptionsProp != nil && (ok || !reflect.DeepEqual(v, parquetOptionsProp)) {
+		obj["parquetOptions"] = parquetOptionsProp
+	}
 	objectMetadataReportOptionsProp, err := expandStorageInsightsReportConfigObjectMetadataReportOptions(d.Get("object_metadata_report_options"), d, config)
 	if err != nil {
 		return err
@@ -356,6 +373,9 @@ func resourceStorageInsightsReportConfigRead(d *schema.ResourceData, meta interf
 	if err := d.Set("csv_options", flattenStorageInsightsReportConfigCsvOptions(res["csvOptions"], d, config)); err != nil {
 		return fmt.Errorf("Error reading ReportConfig: %s", err)
 	}
+	if err := d.Set("parquet_options", flattenStorageInsightsReportConfigParquetOptions(res["parquetOptions"], d, config)); err != nil {
+		return fmt.Errorf("Error reading ReportConfig: %s", err)
+	}
 	if err := d.Set("object_metadata_report_options", flattenStorageInsightsReportConfigObjectMetadataReportOptions(res["objectMetadataReportOptions"], d, config)); err != nil {
 		return fmt.Errorf("Error reading ReportConfig: %s", err)
 	}
@@ -394,6 +414,12 @@ func resourceStorageInsightsReportConfigUpdate(d *schema.ResourceData, meta inte
 	} else if v, ok := d.GetOkExists("csv_options"); !tpgresource.IsEmptyValue(reflect.ValueOf(v)) && (ok || !reflect.DeepEqual(v, csvOptionsProp)) {
 		obj["csvOptions"] = csvOptionsProp
 	}
+	parquetOptionsProp, err := expandStorageInsightsReportConfigParquetOptions(d.Get("parquet_options"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("parquet_options"); parquetOptionsProp != nil && (ok || !reflect.DeepEqual(v, parquetOptionsProp)) {
+		obj["parquetOptions"] = parquetOptionsProp
+	}
 	objectMetadataReportOptionsProp, err := expandStorageInsightsReportConfigObjectMetadataReportOptions(d.Get("object_metadata_report_options"), d, config)
 	if err != nil {
 		return err
@@ -423,6 +449,10 @@ func resourceStorageInsightsReportConfigUpdate(d *schema.ResourceData, meta inte
 		updateMask = append(updateMask, "csvOptions")
 	}
 
+	if d.HasChange("parquet_options") {
+		updateMask = append(updateMask, "parquetOptions")
+	}
+
 	if d.HasChange("object_metadata_report_options") {
 		updateMask = append(updateMask, "objectMetadataReportOptions.metadataFields",
 			"objectMetadataReportOptions.storageDestinationOptions.bucket",
@@ -725,6 +755,14 @@ func flattenStorageInsightsReportConfigCsvOptionsHeaderRequired(v interface{}, d
 	return v
 }
 
+func flattenStorageInsightsReportConfigParquetOptions(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
+	if v == nil {
+		return nil
+	}
+	transformed := make(map[string]interface{})
+	return []interface{}{transformed}
+}
+
 func flattenStorageInsightsReportConfigObjectMetadataReportOptions(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
 	if v == nil {
 		return nil
@@ -962,6 +1000,21 @@ func expandStorageInsightsReportConfigCsvOptionsHeaderRequired(v interface{}, d
 	return v, nil
 }
 
+func expandStorageInsightsReportConfigParquetOptions(v interface{}, d tpgresource.TerraformResourceData, config *transport_tpg.Config) (interface{}, error) {
+	l := v.([]interface{})
+	if len(l) == 0 {
+		return nil, nil
+	}
+
+	if l[0] == nil {
+		transformed := make(map[string]interface{})
+		return transformed, nil
+	}
+	transformed := make(map[string]interface{})
+
+	return transformed, nil
+}
+
 func expandStorageInsightsReportConfigObjectMetadataReportOptions(v interface{}, d tpgresource.TerraformResourceData, config *transport_tpg.Config) (interface{}, error) {
 	l := v.([]interface{})
 	if len(l) == 0 || l[0] == nil {
@@ -0,0 +1,390 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+package tags
+
+import (
+	"fmt"
+	"log"
+	"reflect"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/hashicorp/terraform-provider-google-beta/google-beta/tpgresource"
+	transport_tpg "github.com/hashicorp/terraform-provider-google-beta/google-beta/transport"
+	"github.com/hashicorp/terraform-provider-google-beta/google-beta/verify"
+)
+
+func ResourceTagsTagHold() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceTagsTagHoldCreate,
+		Read:   resourceTagsTagHoldRead,
+		Delete: resourceTagsTagHoldDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: resourceTagsTagHoldImport,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(20 * time.Minute),
+			Delete: schema.DefaultTimeout(20 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"holder": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: `The name of the resource where the TagValue is being used. Must be less than 200 characters. E.g. //compute.googleapis.com/compute/projects/myproject/regions/us-east-1/instanceGroupManagers/instance-group`,
+			},
+			"parent": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidateRegexp(`^tagValues/[^/]+$`),
+				Description:  `The TagValue to hold. Must be of the form tagValues/456.`,
+			},
+			"help_link": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Description: `A URL where an end user can learn more about removing this hold. E.g. https://cloud.google.com/resource-manager/docs/tags/tags-creating-and-managing`,
+			},
+			"origin": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Description: `An optional string representing the origin of this request. This field should include human-understandable information to distinguish origins from each other. Must be less than 200 characters. E.g. migs-35678234`,
+			},
+			"create_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+				Description: `Output only. The time this TagHold was created.
+
+A timestamp in RFC3339 UTC "Zulu" format, with nanosecond resolution and up to nine fractional digits. Examples: "2014-10-02T15:01:23Z" and "2014-10-02T15:01:23.045123456Z".`,
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: `The generated id for the TagHold. This is a string of the form: 'tagValues/{tag-value-id}/tagHolds/{tag-hold-id}'`,
+			},
+		},
+		UseJSONNumber: true,
+	}
+}
+
+func resourceTagsTagHoldCreate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*transport_tpg.Config)
+	userAgent, err := tpgresource.GenerateUserAgentString(d, config.UserAgent)
+	if err != nil {
+		return err
+	}
+
+	obj := make(map[string]interface{})
+	holderProp, err := expandNestedTagsTagHoldHolder(d.Get("holder"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("holder"); !tpgresource.IsEmptyValue(reflect.ValueOf(holderProp)) && (ok || !reflect.DeepEqual(v, holderProp)) {
+		obj["holder"] = holderProp
+	}
+	originProp, err := expandNestedTagsTagHoldOrigin(d.Get("origin"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("origin"); !tpgresource.IsEmptyValue(reflect.ValueOf(originProp)) && (ok || !reflect.DeepEqual(v, originProp)) {
+		obj["origin"] = originProp
+	}
+	helpLinkProp, err := expandNestedTagsTagHoldHelpLink(d.Get("help_link"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("help_link"); !tpgresource.IsEmptyValue(reflect.ValueOf(helpLinkProp)) && (ok || !reflect.DeepEqual(v, helpLinkProp)) {
+		obj["helpLink"] = helpLinkProp
+	}
+
+	lockName, err := tpgresource.ReplaceVars(d, config, "{{parent}}")
+	if err != nil {
+		return err
+	}
+	transport_tpg.MutexStore.Lock(lockName)
+	defer transport_tpg.MutexStore.Unlock(lockName)
+
+	url, err := tpgresource.ReplaceVars(d, config, "{{TagsBasePath}}{{parent}}/tagHolds")
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Creating new TagHold: %#v", obj)
+	billingProject := ""
+
+	// err == nil indicates that the billing_project value was found
+	if bp, err := tpgresource.GetBillingProject(d, config); err == nil {
+		billingProject = bp
+	}
+
+	res, err := transport_tpg.SendRequest(transport_tpg.SendRequestOptions{
+		Config:    config,
+		Method:    "POST",
+		Project:   billingProject,
+		RawURL:    url,
+		UserAgent: userAgent,
+		Body:      obj,
+		Timeout:   d.Timeout(schema.TimeoutCreate),
+	})
+	if err != nil {
+		return fmt.Errorf("Error creating TagHold: %s", err)
+	}
+
+	// Use the resource in the operation response to populate
+	// identity fields and d.Id() before read
+	var opRes map[string]interface{}
+	err = TagsOperationWaitTimeWithResponse(
+		config, res, &opRes, "Creating TagHold", userAgent,
+		d.Timeout(schema.TimeoutCreate))
+	if err != nil {
+		return fmt.Errorf("Error waiting to create TagHold: %s", err)
+	}
+
+	if err := d.Set("name", flattenNestedTagsTagHoldName(opRes["name"], d, config)); err != nil {
+		return err
+	}
+
+	// Store the ID now
+	id, err := tpgresource.ReplaceVars(d, config, "{{name}}")
+	if err != nil {
+		return fmt.Errorf("Error constructing id: %s", err)
+	}
+	d.SetId(id)
+
+	log.Printf("[DEBUG] Finished creating TagHold %q: %#v", d.Id(), res)
+
+	return resourceTagsTagHoldRead(d, meta)
+}
+
+func resourceTagsTagHoldRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*transport_tpg.Config)
+	userAgent, err := tpgresource.GenerateUserAgentString(d, config.UserAgent)
+	if err != nil {
+		return err
+	}
+
+	url, err := tpgresource.ReplaceVars(d, config, "{{TagsBasePath}}{{parent}}/tagHolds?pageSize=300")
+	if err != nil {
+		return err
+	}
+
+	billingProject := ""
+
+	// err == nil indicates that the billing_project value was found
+	if bp, err := tpgresource.GetBillingProject(d, config); err == nil {
+		billingProject = bp
+	}
+
+	// The holds of a tag value are listed in pages, search them until the
+	// hold is found.
+	var res map[string]interface{}
+	pageToken := ""
+	for {
+		pageUrl := url
+		if pageToken != "" {
+			pageUrl = fmt.Sprintf("%s&pageToken=%s", url, pageToken)
+		}
+		page, err := transport_tpg.SendRequest(transport_tpg.SendRequestOptions{
+			Config:    config,
+			Method:    "GET",
+			Project:   billingProject,
+			RawURL:    pageUrl,
+			UserAgent: userAgent,
+		})
+		if err != nil {
+			return transport_tpg.HandleNotFoundError(err, d, fmt.Sprintf("TagsTagHold %q", d.Id()))
+		}
+
+		res, err = flattenNestedTagsTagHold(d, meta, page)
+		if err != nil {
+			return err
+		}
+
+		pageToken, _ = page["nextPageToken"].(string)
+		if res != nil || pageToken == "" {
+			break
+		}
+	}
+
+	if res == nil {
+		// Object isn't there any more - remove it from the state.
+		log.Printf("[DEBUG] Removing TagsTagHold because it couldn't be matched.")
+		d.SetId("")
+		return nil
+	}
+
+	if err := d.Set("name", flattenNestedTagsTagHoldName(res["name"], d, config)); err != nil {
+		return fmt.Errorf("Error reading TagHold: %s", err)
+	}
+	if err := d.Set("holder", flattenNestedTagsTagHoldHolder(res["holder"], d, config)); err != nil {
+		return fmt.Errorf("Error reading TagHold: %s", err)
+	}
+	if err := d.Set("origin", flattenNestedTagsTagHoldOrigin(res["origin"], d, config)); err != nil {
+		return fmt.Errorf("Error reading TagHold: %s", err)
+	}
+	if err := d.Set("help_link", flattenNestedTagsTagHoldHelpLink(res["helpLink"], d, config)); err != nil {
+		return fmt.Errorf("Error reading TagHold: %s", err)
+	}
+	if err := d.Set("create_time", flattenNestedTagsTagHoldCreateTime(res["createTime"], d, config)); err != nil {
+		return fmt.Errorf("Error reading TagHold: %s", err)
+	}
+
+	return nil
+}
+
+func resourceTagsTagHoldDelete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*transport_tpg.Config)
+	userAgent, err := tpgresource.GenerateUserAgentString(d, config.UserAgent)
+	if err != nil {
+		return err
+	}
+
+	billingProject := ""
+
+	lockName, err := tpgresource.ReplaceVars(d, config, "{{parent}}")
+	if err != nil {
+		return err
+	}
+	transport_tpg.MutexStore.Lock(lockName)
+	defer transport_tpg.MutexStore.Unlock(lockName)
+
+	url, err := tpgresource.ReplaceVars(d, config, "{{TagsBasePath}}{{name}}")
+	if err != nil {
+		return err
+	}
+
+	var obj map[string]interface{}
+
+	// err == nil indicates that the billing_project value was found
+	if bp, err := tpgresource.GetBillingProject(d, config); err == nil {
+		billingProject = bp
+	}
+
+	log.Printf("[DEBUG] Deleting TagHold %q", d.Id())
+	res, err := transport_tpg.SendRequest(transport_tpg.SendRequestOptions{
+		Config:    config,
+		Method:    "DELETE",
+		Project:   billingProject,
+		RawURL:    url,
+		UserAgent: userAgent,
+		Body:      obj,
+		Timeout:   d.Timeout(schema.TimeoutDelete),
+	})
+	if err != nil {
+		return transport_tpg.HandleNotFoundError(err, d, "TagHold")
+	}
+
+	err = TagsOperationWaitTime(
+		config, res, "Deleting TagHold", userAgent,
+		d.Timeout(schema.TimeoutDelete))
+
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Finished deleting TagHold %q: %#v", d.Id(), res)
+	return nil
+}
+
+func resourceTagsTagHoldImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	config := meta.(*transport_tpg.Config)
+	if err := tpgresource.ParseImportId([]string{
+		"^(?P<name>tagValues/[^/]+/tagHolds/[^/]+)$",
+	}, d, config); err != nil {
+		return nil, err
+	}
+
+	stringParts := strings.Split(d.Get("name").(string), "/")
+	if err := d.Set("parent", strings.Join(stringParts[:2], "/")); err != nil {
+		return nil, fmt.Errorf("Error setting parent, %s", err)
+	}
+
+	d.SetId(d.Get("name").(string))
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func flattenNestedTagsTagHoldName(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
+	return v
+}
+
+func flattenNestedTagsTagHoldHolder(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
+	return v
+}
+
+func flattenNestedTagsTagHoldOrigin(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
+	return v
+}
+
+func flattenNestedTagsTagHoldHelpLink(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
+	return v
+}
+
+func flattenNestedTagsTagHoldCreateTime(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
+	return v
+}
+
+func expandNestedTagsTagHoldHolder(v interface{}, d tpgresource.TerraformResourceData, config *transport_tpg.Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandNestedTagsTagHoldOrigin(v interface{}, d tpgresource.TerraformResourceData, config *transport_tpg.Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandNestedTagsTagHoldHelpLink(v interface{}, d tpgresource.TerraformResourceData, config *transport_tpg.Config) (interface{}, error) {
+	return v, nil
+}
+
+func flattenNestedTagsTagHold(d *schema.ResourceData, meta interface{}, res map[string]interface{}) (map[string]interface{}, error) {
+	var v interface{}
+	var ok bool
+
+	v, ok = res["tagHolds"]
+	if !ok || v == nil {
+		return nil, nil
+	}
+
+	switch v.(type) {
+	case []interface{}:
+		break
+	case map[string]interface{}:
+		// Construct list out of single nested resource
+		v = []interface{}{v}
+	default:
+		return nil, fmt.Errorf("expected list or map for value tagHolds. Actual value: %v", v)
+	}
+
+	_, item, err := resourceTagsTagHoldFindNestedObjectInList(d, meta, v.([]interface{}))
+	if err != nil {
+		return nil, err
+	}
+	return item, nil
+}
+
+func resourceTagsTagHoldFindNestedObjectInList(d *schema.ResourceData, meta interface{}, items []interface{}) (index int, item map[string]interface{}, err error) {
+	expectedName := d.Get("name")
+	expectedFlattenedName := flattenNestedTagsTagHoldName(expectedName, d, meta.(*transport_tpg.Config))
+
+	// Search list for this resource.
+	for idx, itemRaw := range items {
+		if itemRaw == nil {
+			continue
+		}
+		item := itemRaw.(map[string]interface{})
+
+		itemName := flattenNestedTagsTagHoldName(item["name"], d, meta.(*transport_tpg.Config))
+		// IsEmptyValue check so that if one is nil and the other is "", that's considered a match
+		if !(tpgresource.IsEmptyValue(reflect.ValueOf(itemName)) && tpgresource.IsEmptyValue(reflect.ValueOf(expectedFlattenedName))) && !reflect.DeepEqual(itemName, expectedFlattenedName) {
+			log.Printf("[DEBUG] Skipping item with name= %#v, looking for %#v)", itemName, expectedFlattenedName)
+			continue
+		}
+		log.Printf("[DEBUG] Found item for resource %q: %#v)", d.Id(), item)
+		return idx, item, nil
+	}
+	return -1, nil, nil
+}
@@ -0,0 +1,67 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+package tags
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	transport_tpg "github.com/hashicorp/terraform-provider-google-beta/google-beta/transport"
+)
+
+func TestResourceTagsTagHoldRead_paginated(t *testing.T) {
+	t.Parallel()
+
+	pages := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/tagValues/123/tagHolds" {
+			http.NotFound(w, r)
+			return
+		}
+		pages++
+		if r.URL.Query().Get("pageToken") == "" {
+			_ = json.NewEncoder(w).Encode(map[string]interface{}{
+				"tagHolds": []interface{}{
+					map[string]interface{}{"name": "tagValues/123/tagHolds/other", "holder": "//compute.googleapis.com/other"},
+				},
+				"nextPageToken": "page-2",
+			})
+			return
+		}
+		_ = json.NewEncoder(w).Encode(map[string]interface{}{
+			"tagHolds": []interface{}{
+				map[string]interface{}{"name": "tagValues/123/tagHolds/mine", "holder": "//compute.googleapis.com/mine"},
+			},
+		})
+	}))
+	defer server.Close()
+
+	config := &transport_tpg.Config{
+		Client:       server.Client(),
+		TagsBasePath: server.URL + "/",
+	}
+
+	d := ResourceTagsTagHold().TestResourceData()
+	d.SetId("tagValues/123/tagHolds/mine")
+	if err := d.Set("parent", "tagValues/123"); err != nil {
+		t.Fatalf("error setting parent: %s", err)
+	}
+	if err := d.Set("name", "tagValues/123/tagHolds/mine"); err != nil {
+		t.Fatalf("error setting name: %s", err)
+	}
+
+	if err := resourceTagsTagHoldRead(d, config); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if d.Id() == "" {
+		t.Fatalf("expected the hold on the second page to be found")
+	}
+	if got := d.Get("holder").(string); got != "//compute.googleapis.com/mine" {
+		t.Errorf("expected holder %q, got %q", "//compute.googleapis.com/mine", got)
+	}
+	if pages != 2 {
+		t.Errorf("expected 2 pages to be read, got %d", pages)
+	}
+}
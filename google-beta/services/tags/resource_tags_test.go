@@ -30,6 +30,7 @@ func TestAccTags(t *testing.T) {
 		"tagValueBasic":                     testAccTagsTagValue_tagValueBasic,
 		"tagValueUpdate":                    testAccTagsTagValue_tagValueUpdate,
 		"tagBindingBasic":                   testAccTagsTagBinding_tagBindingBasic,
+		"tagHoldBasic":                      testAccTagsTagHold_tagHoldBasic,
 		"tagValueIamBinding":                testAccTagsTagValueIamBinding,
 		"tagValueIamMember":                 testAccTagsTagValueIamMember,
 		"tagValueIamPolicy":                 testAccTagsTagValueIamPolicy,
@@ -440,6 +441,99 @@ func testAccCheckTagsTagBindingDestroyProducer(t *testing.T) func(s *terraform.S
 	}
 }
 
+func testAccTagsTagHold_tagHoldBasic(t *testing.T) {
+	context := map[string]interface{}{
+		"org_id":        envvar.GetTestOrgFromEnv(t),
+		"random_suffix": acctest.RandString(t, 10),
+	}
+
+	acctest.VcrTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.AccTestPreCheck(t) },
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories(t),
+		CheckDestroy:             testAccCheckTagsTagHoldDestroyProducer(t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTagsTagHold_tagHoldBasicExample(context),
+			},
+			{
+				ResourceName:      "google_tags_tag_hold.hold",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccTagsTagHold_tagHoldBasicExample(context map[string]interface{}) string {
+	return acctest.Nprintf(`
+resource "google_tags_tag_key" "key" {
+	parent = "organizations/%{org_id}"
+	short_name = "keyname%{random_suffix}"
+	description = "For a certain set of resources."
+}
+
+resource "google_tags_tag_value" "value" {
+	parent = "tagKeys/${google_tags_tag_key.key.name}"
+	short_name = "foo%{random_suffix}"
+	description = "For foo%{random_suffix} resources."
+}
+
+resource "google_tags_tag_hold" "hold" {
+	parent = "tagValues/${google_tags_tag_value.value.name}"
+	holder = "//compute.googleapis.com/projects/tf-test-holder/global/networks/tf-test-network-%{random_suffix}"
+	origin = "tf-test-%{random_suffix}"
+	help_link = "https://cloud.google.com/resource-manager/docs/tags/tags-creating-and-managing"
+}
+`, context)
+}
+
+func testAccCheckTagsTagHoldDestroyProducer(t *testing.T) func(s *terraform.State) error {
+	return func(s *terraform.State) error {
+		for name, rs := range s.RootModule().Resources {
+			if rs.Type != "google_tags_tag_hold" {
+				continue
+			}
+			if strings.HasPrefix(name, "data.") {
+				continue
+			}
+
+			config := acctest.GoogleProviderConfig(t)
+
+			url, err := tpgresource.ReplaceVarsForTest(config, rs, "{{TagsBasePath}}{{parent}}/tagHolds")
+			if err != nil {
+				return err
+			}
+
+			billingProject := ""
+
+			if config.BillingProject != "" {
+				billingProject = config.BillingProject
+			}
+
+			res, err := transport_tpg.SendRequest(transport_tpg.SendRequestOptions{
+				Config:    config,
+				Method:    "GET",
+				Project:   billingProject,
+				RawURL:    url,
+				UserAgent: config.UserAgent,
+			})
+			if err != nil {
+				// The tag value is gone, and its holds with it.
+				continue
+			}
+			if holds, ok := res["tagHolds"].([]interface{}); ok {
+				for _, h := range holds {
+					if hold, ok := h.(map[string]interface{}); ok && hold["name"] == rs.Primary.Attributes["name"] {
+						return fmt.Errorf("TagsTagHold still exists at %s", rs.Primary.Attributes["name"])
+					}
+				}
+			}
+		}
+
+		return nil
+	}
+}
+
 func testAccTagsTagKeyIamBinding(t *testing.T) {
 	t.Parallel()
 
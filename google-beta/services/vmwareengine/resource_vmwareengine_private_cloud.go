@@ -21,11 +21,13 @@ import (
 	"fmt"
 	"log"
 	"reflect"
+	"strconv"
 	"strings"
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
 	"github.com/hashicorp/terraform-provider-google-beta/google-beta/tpgresource"
 	transport_tpg "github.com/hashicorp/terraform-provider-google-beta/google-beta/transport"
@@ -278,6 +280,18 @@ the form: projects/{project_number}/locations/{location}/vmwareEngineNetworks/{v
 					},
 				},
 			},
+			"deletion_delay_hours": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      0,
+				ValidateFunc: validation.IntBetween(0, 8),
+				Description: `The number of hours to delay the deletion of this private cloud. With a
+non-zero value, the private cloud is marked DELETED and can be restored
+with the undelete API until the delay elapses. Its name stays reserved until
+then, so replacing it with a private cloud of the same name, such as after a
+change to a field that forces a new resource, fails. With 0, the private
+cloud is deleted and stops being billed immediately. Defaults to 0.`,
+			},
 			"project": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -426,6 +440,12 @@ func resourceVmwareenginePrivateCloudRead(d *schema.ResourceData, meta interface
 		return nil
 	}
 
+	// Explicitly set virtual fields to default values if unset
+	if _, ok := d.GetOkExists("deletion_delay_hours"); !ok {
+		if err := d.Set("deletion_delay_hours", 0); err != nil {
+			return fmt.Errorf("Error setting deletion_delay_hours: %s", err)
+		}
+	}
 	if err := d.Set("project", project); err != nil {
 		return fmt.Errorf("Error reading PrivateCloud: %s", err)
 	}
@@ -465,6 +485,12 @@ func resourceVmwareenginePrivateCloudUpdate(d *schema.ResourceData, meta interfa
 		return err
 	}
 
+	// deletion_delay_hours is only used on delete, and its new value is already in
+	// the state, so there is nothing to send if it is the only field that changed.
+	if !d.HasChangeExcept("deletion_delay_hours") {
+		return resourceVmwareenginePrivateCloudRead(d, meta)
+	}
+
 	billingProject := ""
 
 	project, err := tpgresource.GetProject(d, config)
@@ -601,7 +627,12 @@ func resourceVmwareenginePrivateCloudDelete(d *schema.ResourceData, meta interfa
 	}
 	billingProject = project
 
-	url, err := tpgresource.ReplaceVars(d, config, "{{VmwareengineBasePath}}projects/{{project}}/locations/{{location}}/privateClouds/{{name}}?delay_hours=0")
+	url, err := tpgresource.ReplaceVars(d, config, "{{VmwareengineBasePath}}projects/{{project}}/locations/{{location}}/privateClouds/{{name}}")
+	if err != nil {
+		return err
+	}
+	delayHours := d.Get("deletion_delay_hours").(int)
+	url, err = transport_tpg.AddQueryParams(url, map[string]string{"delay_hours": strconv.Itoa(delayHours)})
 	if err != nil {
 		return err
 	}
@@ -635,6 +666,12 @@ func resourceVmwareenginePrivateCloudDelete(d *schema.ResourceData, meta interfa
 	if err != nil {
 		return err
 	}
+
+	if delayHours > 0 {
+		// The private cloud is only marked DELETED until the delay elapses.
+		log.Printf("[DEBUG] PrivateCloud %q will be deleted in %d hours", d.Id(), delayHours)
+		return nil
+	}
 	privateCloudPollRead := func(d *schema.ResourceData, meta interface{}) transport_tpg.PollReadFunc {
 		return func() (map[string]interface{}, error) {
 			config := meta.(*transport_tpg.Config)
@@ -696,6 +733,11 @@ func resourceVmwareenginePrivateCloudImport(d *schema.ResourceData, meta interfa
 	}
 	d.SetId(id)
 
+	// Explicitly set virtual fields to default values on import
+	if err := d.Set("deletion_delay_hours", 0); err != nil {
+		return nil, fmt.Errorf("Error setting deletion_delay_hours: %s", err)
+	}
+
 	return []*schema.ResourceData{d}, nil
 }
 
@@ -1090,6 +1132,12 @@ func resourceVmwareenginePrivateCloudUpdateEncoder(d *schema.ResourceData, meta
 }
 
 func resourceVmwareenginePrivateCloudDecoder(d *schema.ResourceData, meta interface{}, res map[string]interface{}) (map[string]interface{}, error) {
+	// A private cloud deleted with a delay is kept in the DELETED state until
+	// the delay elapses, it's gone as far as Terraform is concerned.
+	if res["state"] == "DELETED" {
+		return nil, nil
+	}
+
 	config := meta.(*transport_tpg.Config)
 
 	userAgent, err := tpgresource.GenerateUserAgentString(d, config.UserAgent)
@@ -446,13 +446,14 @@ func resourceVPCAccessConnectorDelete(d *schema.ResourceData, meta interface{})
 
 	log.Printf("[DEBUG] Deleting Connector %q", d.Id())
 	res, err := transport_tpg.SendRequest(transport_tpg.SendRequestOptions{
-		Config:    config,
-		Method:    "DELETE",
-		Project:   billingProject,
-		RawURL:    url,
-		UserAgent: userAgent,
-		Body:      obj,
-		Timeout:   d.Timeout(schema.TimeoutDelete),
+		Config:               config,
+		Method:               "DELETE",
+		Project:              billingProject,
+		RawURL:               url,
+		UserAgent:            userAgent,
+		Body:                 obj,
+		Timeout:              d.Timeout(schema.TimeoutDelete),
+		ErrorRetryPredicates: []transport_tpg.RetryErrorPredicateFunc{transport_tpg.IsVpcAccessConnectorOperationInProgress},
 	})
 	if err != nil {
 		return transport_tpg.HandleNotFoundError(err, d, "Connector")
@@ -463,7 +464,19 @@ func resourceVPCAccessConnectorDelete(d *schema.ResourceData, meta interface{})
 		d.Timeout(schema.TimeoutDelete))
 
 	if err != nil {
-		return err
+		// The delete operation occasionally reports an error even though the
+		// connector was deleted, so only fail if the connector still exists.
+		_, getErr := transport_tpg.SendRequest(transport_tpg.SendRequestOptions{
+			Config:    config,
+			Method:    "GET",
+			Project:   billingProject,
+			RawURL:    url,
+			UserAgent: userAgent,
+		})
+		if !transport_tpg.IsGoogleApiErrorWithCode(getErr, 404) {
+			return err
+		}
+		log.Printf("[WARN] Connector %q was deleted despite the delete operation failing: %s", d.Id(), err)
 	}
 
 	log.Printf("[DEBUG] Finished deleting Connector %q: %#v", d.Id(), res)
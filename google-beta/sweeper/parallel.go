@@ -0,0 +1,49 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+package sweeper
+
+import (
+	"fmt"
+	"log"
+	"sync"
+
+	"github.com/hashicorp/go-multierror"
+)
+
+// Maximum number of delete requests a single sweeper sends at once
+const sweepParallelism = 10
+
+// DeleteInParallel calls deleteF for each of the given resource ids, with up
+// to sweepParallelism calls in flight at a time. A failure to delete one
+// resource doesn't stop the others from being swept. It returns the number of
+// resources that were deleted, and the errors of the deletes that failed.
+func DeleteInParallel(resourceName string, ids []string, deleteF func(id string) error) (int, error) {
+	var wg sync.WaitGroup
+	var mu sync.Mutex
+	deleted := 0
+	var allErrors error
+	sem := make(chan struct{}, sweepParallelism)
+
+	for _, id := range ids {
+		wg.Add(1)
+		sem <- struct{}{}
+		go func(id string) {
+			defer wg.Done()
+			defer func() { <-sem }()
+
+			if err := deleteF(id); err != nil {
+				mu.Lock()
+				allErrors = multierror.Append(allErrors, fmt.Errorf("error deleting %s resource %s: %s", resourceName, id, err))
+				mu.Unlock()
+				return
+			}
+			log.Printf("[INFO][SWEEPER_LOG] Deleted a %s resource: %s", resourceName, id)
+			mu.Lock()
+			deleted++
+			mu.Unlock()
+		}(id)
+	}
+	wg.Wait()
+
+	return deleted, allErrors
+}
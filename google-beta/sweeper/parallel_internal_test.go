@@ -0,0 +1,55 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+package sweeper
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestDeleteInParallel(t *testing.T) {
+	var ids []string
+	for i := 0; i < 3*sweepParallelism; i++ {
+		ids = append(ids, fmt.Sprintf("tf-test-%d", i))
+	}
+
+	var mu sync.Mutex
+	seen := map[string]bool{}
+	var inFlight, maxInFlight int32
+	deleted, err := DeleteInParallel("Thing", ids, func(id string) error {
+		n := atomic.AddInt32(&inFlight, 1)
+		defer atomic.AddInt32(&inFlight, -1)
+		for {
+			max := atomic.LoadInt32(&maxInFlight)
+			if n <= max || atomic.CompareAndSwapInt32(&maxInFlight, max, n) {
+				break
+			}
+		}
+		time.Sleep(time.Millisecond)
+
+		mu.Lock()
+		seen[id] = true
+		mu.Unlock()
+		if id == "tf-test-0" {
+			return fmt.Errorf("still in use")
+		}
+		return nil
+	})
+
+	if err == nil || !strings.Contains(err.Error(), "tf-test-0: still in use") {
+		t.Errorf("expected the failed delete to be returned as an error, got %v", err)
+	}
+	if deleted != len(ids)-1 {
+		t.Errorf("expected %d resources to be deleted, got %d", len(ids)-1, deleted)
+	}
+	if len(seen) != len(ids) {
+		t.Errorf("expected a delete for each of %d resources, got %d", len(ids), len(seen))
+	}
+	if maxInFlight > sweepParallelism {
+		t.Errorf("expected at most %d deletes in flight, got %d", sweepParallelism, maxInFlight)
+	}
+}
@@ -66,6 +66,12 @@ func iamPolicyReadWithRetry(updater ResourceIamUpdater) (*cloudresourcemanager.P
 	transport_tpg.MutexStore.Lock(mutexKey)
 	defer transport_tpg.MutexStore.Unlock(mutexKey)
 
+	return iamPolicyReadWithRetryUnlocked(updater)
+}
+
+// Read-only operation with retries, for callers that already hold the
+// updater's mutex.
+func iamPolicyReadWithRetryUnlocked(updater ResourceIamUpdater) (*cloudresourcemanager.Policy, error) {
 	log.Printf("[DEBUG] Retrieving policy for %s\n", updater.DescribeResource())
 	var policy *cloudresourcemanager.Policy
 	err := transport_tpg.Retry(transport_tpg.RetryOptions{
@@ -94,7 +100,7 @@ func iamPolicyReadModifyWrite(updater ResourceIamUpdater, modify iamPolicyModify
 		p, err := updater.GetResourceIamPolicy()
 		if transport_tpg.IsGoogleApiErrorWithCode(err, 429) {
 			log.Printf("[DEBUG] 429 while attempting to read policy for %s, waiting %v before attempting again", updater.DescribeResource(), backoff)
-			time.Sleep(backoff)
+			time.Sleep(transport_tpg.JitteredBackoff(backoff, backoff/2))
 			continue
 		} else if err != nil {
 			return err
@@ -114,7 +120,8 @@ func iamPolicyReadModifyWrite(updater ResourceIamUpdater, modify iamPolicyModify
 				if fetchBackoff > maxBackoffSeconds*time.Second {
 					return fmt.Errorf("Error applying IAM policy to %s: Waited too long for propagation.\n", updater.DescribeResource())
 				}
-				time.Sleep(fetchBackoff)
+				// Newly set bindings may not be visible to every reader yet, so space reads apart
+				time.Sleep(transport_tpg.JitteredBackoff(fetchBackoff, fetchBackoff/2))
 				log.Printf("[DEBUG]: Retrieving policy for %s\n", updater.DescribeResource())
 				new_p, err := updater.GetResourceIamPolicy()
 				if err != nil {
@@ -150,7 +157,7 @@ func iamPolicyReadModifyWrite(updater ResourceIamUpdater, modify iamPolicyModify
 		}
 		if tpgresource.IsConflictError(err) {
 			log.Printf("[DEBUG]: Concurrent policy changes, restarting read-modify-write after %s\n", backoff)
-			time.Sleep(backoff)
+			time.Sleep(transport_tpg.JitteredBackoff(backoff, backoff/2))
 			backoff = backoff * 2
 			if backoff > 30*time.Second {
 				return errwrap.Wrapf(fmt.Sprintf("Error applying IAM policy to %s: Too many conflicts.  Latest error: {{err}}", updater.DescribeResource()), err)
@@ -164,12 +171,13 @@ func iamPolicyReadModifyWrite(updater ResourceIamUpdater, modify iamPolicyModify
 			// calling a retryable function within a retry loop is not
 			// strictly the _best_ idea, but this error only happens in
 			// high-traffic projects anyways
-			currentPolicy, rerr := iamPolicyReadWithRetry(updater)
-			if rerr != nil {
+			// the mutex is already held by this read-modify-write cycle
+			currentPolicy, rerr := iamPolicyReadWithRetryUnlocked(updater)
+			if rerr == nil {
 				if p.Etag != currentPolicy.Etag {
 					// not matching indicates that there is a new state to attempt to apply
 					log.Printf("current and old etag did not match for %s, retrying", updater.DescribeResource())
-					time.Sleep(backoff)
+					time.Sleep(transport_tpg.JitteredBackoff(backoff, backoff/2))
 					backoff = backoff * 2
 					continue
 				}
@@ -3,11 +3,14 @@
 package tpgiamresource
 
 import (
+	"fmt"
 	"reflect"
 	"testing"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"google.golang.org/api/cloudresourcemanager/v1"
+	"google.golang.org/api/googleapi"
 )
 
 func TestIamMergeBindings(t *testing.T) {
@@ -1325,3 +1328,94 @@ func TestIamListFromIamAuditConfigsMap(t *testing.T) {
 		}
 	}
 }
+
+// fakeIamUpdater returns a policy with the etags in etags, one per read, and
+// the errors in setErrors, one per write. The last value is repeated.
+type fakeIamUpdater struct {
+	mutexKey  string
+	etags     []string
+	setErrors []error
+	gets      int
+	sets      int
+}
+
+func (u *fakeIamUpdater) GetResourceIamPolicy() (*cloudresourcemanager.Policy, error) {
+	etag := u.etags[len(u.etags)-1]
+	if u.gets < len(u.etags) {
+		etag = u.etags[u.gets]
+	}
+	u.gets++
+	return &cloudresourcemanager.Policy{Etag: etag}, nil
+}
+
+func (u *fakeIamUpdater) SetResourceIamPolicy(_ *cloudresourcemanager.Policy) error {
+	err := u.setErrors[len(u.setErrors)-1]
+	if u.sets < len(u.setErrors) {
+		err = u.setErrors[u.sets]
+	}
+	u.sets++
+	return err
+}
+
+func (u *fakeIamUpdater) GetMutexKey() string      { return u.mutexKey }
+func (u *fakeIamUpdater) GetResourceId() string    { return "my-resource" }
+func (u *fakeIamUpdater) DescribeResource() string { return "my-resource" }
+
+func TestIamPolicyReadModifyWrite_serviceAccountNotFound(t *testing.T) {
+	serviceAccountNotFound := &googleapi.Error{
+		Code: 400,
+		Body: "Service account sa@my-project.iam.gserviceaccount.com does not exist.",
+	}
+	forbidden := &googleapi.Error{Code: 403, Body: "forbidden"}
+
+	cases := map[string]struct {
+		Etags      []string
+		SetErrors  []error
+		ExpectGets int
+		ExpectSets int
+	}{
+		"policy changed since the read": {
+			// The write is retried with the new policy, and fails for another reason
+			Etags:      []string{"a", "b"},
+			SetErrors:  []error{serviceAccountNotFound, forbidden},
+			ExpectGets: 3,
+			ExpectSets: 2,
+		},
+		"policy unchanged since the read": {
+			// The config refers to a deleted service account, retrying won't help
+			Etags:      []string{"a"},
+			SetErrors:  []error{serviceAccountNotFound},
+			ExpectGets: 2,
+			ExpectSets: 1,
+		},
+	}
+
+	for tn, tc := range cases {
+		updater := &fakeIamUpdater{
+			mutexKey:  fmt.Sprintf("iam-test-%s", tn),
+			etags:     tc.Etags,
+			setErrors: tc.SetErrors,
+		}
+
+		done := make(chan error, 1)
+		go func() {
+			done <- iamPolicyReadModifyWrite(updater, func(p *cloudresourcemanager.Policy) error { return nil })
+		}()
+
+		select {
+		case err := <-done:
+			if err == nil {
+				t.Errorf("%s: expected an error", tn)
+			}
+		case <-time.After(30 * time.Second):
+			t.Fatalf("%s: read-modify-write didn't return, the policy mutex is likely locked twice", tn)
+		}
+
+		if updater.gets != tc.ExpectGets {
+			t.Errorf("%s: expected %d policy reads, got %d", tn, tc.ExpectGets, updater.gets)
+		}
+		if updater.sets != tc.ExpectSets {
+			t.Errorf("%s: expected %d policy writes, got %d", tn, tc.ExpectSets, updater.sets)
+		}
+	}
+}
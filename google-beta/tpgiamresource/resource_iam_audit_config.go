@@ -12,6 +12,7 @@ import (
 	transport_tpg "github.com/hashicorp/terraform-provider-google-beta/google-beta/transport"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"google.golang.org/api/cloudresourcemanager/v1"
 )
 
@@ -28,9 +29,10 @@ var iamAuditConfigSchema = map[string]*schema.Schema{
 		Elem: &schema.Resource{
 			Schema: map[string]*schema.Schema{
 				"log_type": {
-					Type:        schema.TypeString,
-					Required:    true,
-					Description: `Permission type for which logging is to be configured. Must be one of DATA_READ, DATA_WRITE, or ADMIN_READ.`,
+					Type:         schema.TypeString,
+					Required:     true,
+					ValidateFunc: validation.StringInSlice([]string{"DATA_READ", "DATA_WRITE", "ADMIN_READ"}, false),
+					Description:  `Permission type for which logging is to be configured. Must be one of DATA_READ, DATA_WRITE, or ADMIN_READ.`,
 				},
 				"exempted_members": {
 					Type:        schema.TypeSet,
@@ -147,8 +149,18 @@ func resourceIamAuditConfigCreateUpdate(newUpdaterFunc NewResourceIamUpdaterFunc
 		}
 
 		ac := getResourceIamAuditConfig(d)
+		// When the service changes, the audit config of the previous service
+		// is owned by this resource and must not be left behind in the policy.
+		var oldService string
+		if !d.IsNewResource() && d.HasChange("service") {
+			o, _ := d.GetChange("service")
+			oldService = o.(string)
+		}
 		modifyF := func(ep *cloudresourcemanager.Policy) error {
 			cleaned := removeAllAuditConfigsWithService(ep.AuditConfigs, ac.Service)
+			if oldService != "" {
+				cleaned = removeAllAuditConfigsWithService(cleaned, oldService)
+			}
 			ep.AuditConfigs = append(cleaned, ac)
 			return nil
 		}
@@ -5,10 +5,143 @@ package tpgresource
 import (
 	"context"
 	"fmt"
+	"strconv"
+	"strings"
 
+	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	transport_tpg "github.com/hashicorp/terraform-provider-google-beta/google-beta/transport"
 )
 
+// DefaultIgnoredAnnotationPrefixes are the prefixes of annotation and label
+// keys that Google services add to resources on their own. More prefixes can
+// be added with the provider field "ignore_annotation_prefixes".
+var DefaultIgnoredAnnotationPrefixes = []string{
+	"goog-",
+	"cloud.googleapis.com/location",
+	"run.googleapis.com/ingress-status",
+	"run.googleapis.com/operation-id",
+	"serving.knative.dev/creator",
+	"serving.knative.dev/lastModifier",
+}
+
+// IsIgnoredAnnotationKey reports whether an annotation or label key was
+// injected by the server, based on the default ignored prefixes and the ones
+// configured on the provider.
+func IsIgnoredAnnotationKey(key string, config *transport_tpg.Config) bool {
+	for _, prefix := range DefaultIgnoredAnnotationPrefixes {
+		if strings.HasPrefix(key, prefix) {
+			return true
+		}
+	}
+	if config == nil {
+		return false
+	}
+	for _, prefix := range config.IgnoreAnnotationPrefixes {
+		if prefix != "" && strings.HasPrefix(key, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+// FilterIgnoredAnnotations is called in the READ method of resources with
+// authoritative annotation or label maps, such as the ones nested in a
+// template. Keys injected by the server are dropped from the value read from
+// the API, unless they are managed under lineage, so they don't cause a
+// perpetual diff. Keys in the prior state are kept, so that removing one of
+// them from the configuration shows up as a diff.
+func FilterIgnoredAnnotations(v interface{}, d *schema.ResourceData, lineage string, config *transport_tpg.Config) interface{} {
+	annotations, ok := v.(map[string]interface{})
+	if !ok {
+		return v
+	}
+
+	managed := managedAnnotationKeys(d, lineage)
+
+	transformed := make(map[string]interface{})
+	for k, val := range annotations {
+		if !managed[k] && IsIgnoredAnnotationKey(k, config) {
+			continue
+		}
+		transformed[k] = val
+	}
+	return transformed
+}
+
+// MergeIgnoredAnnotations returns the annotations or labels configured under
+// lineage, plus the keys injected by the server in current that were never
+// managed. It is used when updating an authoritative map, so the update
+// doesn't remove the keys that FilterIgnoredAnnotations hides, but still
+// removes the ones dropped from the configuration.
+func MergeIgnoredAnnotations(d *schema.ResourceData, lineage string, current map[string]string, config *transport_tpg.Config) map[string]string {
+	o, n := d.GetChange(lineage)
+	prior, _ := o.(map[string]interface{})
+	configured, _ := n.(map[string]interface{})
+
+	merged := make(map[string]string)
+	for k, v := range current {
+		if _, ok := configured[k]; ok {
+			continue
+		}
+		if _, ok := prior[k]; ok {
+			continue
+		}
+		if IsIgnoredAnnotationKey(k, config) {
+			merged[k] = v
+		}
+	}
+	for k, v := range configured {
+		merged[k] = v.(string)
+	}
+	return merged
+}
+
+// managedAnnotationKeys returns the keys set under lineage in the
+// configuration, when it is available and known, and in the state.
+func managedAnnotationKeys(d *schema.ResourceData, lineage string) map[string]bool {
+	keys := make(map[string]bool)
+	if v, ok := rawConfigValueAtPath(d.GetRawConfig(), lineage); ok && v.IsWhollyKnown() {
+		if !v.IsNull() && v.CanIterateElements() {
+			for it := v.ElementIterator(); it.Next(); {
+				k, _ := it.Element()
+				keys[k.AsString()] = true
+			}
+		}
+	}
+
+	if m, ok := d.GetOk(lineage); ok {
+		for k := range m.(map[string]interface{}) {
+			keys[k] = true
+		}
+	}
+	return keys
+}
+
+// rawConfigValueAtPath walks a raw configuration along a flatmap-style path
+// such as "template.0.labels". It returns false if the configuration is null
+// or unknown, or if the path can't be followed.
+func rawConfigValueAtPath(v cty.Value, path string) (cty.Value, bool) {
+	for _, part := range strings.Split(path, ".") {
+		if v.IsNull() || !v.IsKnown() {
+			return cty.NilVal, false
+		}
+		ty := v.Type()
+		if i, err := strconv.Atoi(part); err == nil && (ty.IsListType() || ty.IsTupleType()) {
+			if i >= v.LengthInt() {
+				return cty.NilVal, false
+			}
+			v = v.Index(cty.NumberIntVal(int64(i)))
+			continue
+		}
+		if !ty.IsObjectType() || !ty.HasAttribute(part) {
+			return cty.NilVal, false
+		}
+		v = v.GetAttr(part)
+	}
+	return v, true
+}
+
 func SetAnnotationsDiff(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
 	raw := d.Get("annotations")
 	if raw == nil {
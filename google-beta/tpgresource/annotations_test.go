@@ -0,0 +1,239 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+package tpgresource
+
+import (
+	"context"
+	"reflect"
+	"strconv"
+	"testing"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	transport_tpg "github.com/hashicorp/terraform-provider-google-beta/google-beta/transport"
+)
+
+func TestIsIgnoredAnnotationKey(t *testing.T) {
+	cases := map[string]struct {
+		Key      string
+		Prefixes []string
+		Expected bool
+	}{
+		"user key": {
+			Key:      "app",
+			Expected: false,
+		},
+		"default goog prefix": {
+			Key:      "goog-terraform-provisioned",
+			Expected: true,
+		},
+		"default cloud run key": {
+			Key:      "run.googleapis.com/ingress-status",
+			Expected: true,
+		},
+		"configured prefix": {
+			Key:      "autopilot.gke.io/resource-adjustment",
+			Prefixes: []string{"autopilot.gke.io/"},
+			Expected: true,
+		},
+		"empty configured prefix": {
+			Key:      "app",
+			Prefixes: []string{""},
+			Expected: false,
+		},
+	}
+
+	for tn, tc := range cases {
+		config := &transport_tpg.Config{IgnoreAnnotationPrefixes: tc.Prefixes}
+		if got := IsIgnoredAnnotationKey(tc.Key, config); got != tc.Expected {
+			t.Errorf("bad: %s, expected %t for %q, got %t", tn, tc.Expected, tc.Key, got)
+		}
+	}
+}
+
+func TestFilterIgnoredAnnotations(t *testing.T) {
+	resourceSchema := map[string]*schema.Schema{
+		"annotations": {
+			Type:     schema.TypeMap,
+			Optional: true,
+			Elem:     &schema.Schema{Type: schema.TypeString},
+		},
+	}
+
+	cases := map[string]struct {
+		Managed  map[string]interface{}
+		Read     map[string]interface{}
+		Expected map[string]interface{}
+	}{
+		"drops server injected keys": {
+			Managed: map[string]interface{}{"app": "web"},
+			Read: map[string]interface{}{
+				"app":                               "web",
+				"goog-managed-by":                   "cloudfunctions",
+				"run.googleapis.com/ingress-status": "all",
+			},
+			Expected: map[string]interface{}{"app": "web"},
+		},
+		"keeps managed ignored keys": {
+			Managed: map[string]interface{}{"goog-managed-by": "me"},
+			Read: map[string]interface{}{
+				"goog-managed-by":                   "me",
+				"run.googleapis.com/ingress-status": "all",
+			},
+			Expected: map[string]interface{}{"goog-managed-by": "me"},
+		},
+	}
+
+	for tn, tc := range cases {
+		d := schema.TestResourceDataRaw(t, resourceSchema, map[string]interface{}{"annotations": tc.Managed})
+		got := FilterIgnoredAnnotations(tc.Read, d, "annotations", &transport_tpg.Config{})
+		if !reflect.DeepEqual(got, tc.Expected) {
+			t.Errorf("bad: %s, expected %v, got %v", tn, tc.Expected, got)
+		}
+	}
+}
+
+func TestFilterIgnoredAnnotations_rawConfig(t *testing.T) {
+	resource := &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"template": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"labels": {
+							Type:     schema.TypeMap,
+							Optional: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+		},
+	}
+	read := map[string]interface{}{
+		"app":             "web",
+		"goog-managed-by": "me",
+		"goog-other":      "server",
+	}
+
+	cases := map[string]struct {
+		RawConfig cty.Value
+		Expected  map[string]interface{}
+	}{
+		"managed in config": {
+			// goog-managed-by is kept from the state
+			RawConfig: cty.ObjectVal(map[string]cty.Value{
+				"template": cty.ListVal([]cty.Value{cty.ObjectVal(map[string]cty.Value{
+					"labels": cty.MapVal(map[string]cty.Value{"goog-other": cty.StringVal("server")}),
+				})}),
+			}),
+			Expected: map[string]interface{}{"app": "web", "goog-managed-by": "me", "goog-other": "server"},
+		},
+		"removed from config": {
+			// Kept from the state, so the removal shows up as a diff
+			RawConfig: cty.ObjectVal(map[string]cty.Value{
+				"template": cty.ListVal([]cty.Value{cty.ObjectVal(map[string]cty.Value{
+					"labels": cty.NullVal(cty.Map(cty.String)),
+				})}),
+			}),
+			Expected: map[string]interface{}{"app": "web", "goog-managed-by": "me"},
+		},
+		"unknown in config": {
+			RawConfig: cty.ObjectVal(map[string]cty.Value{
+				"template": cty.ListVal([]cty.Value{cty.ObjectVal(map[string]cty.Value{
+					"labels": cty.UnknownVal(cty.Map(cty.String)),
+				})}),
+			}),
+			Expected: map[string]interface{}{"app": "web", "goog-managed-by": "me"},
+		},
+		"no config": {
+			RawConfig: cty.NullVal(cty.DynamicPseudoType),
+			Expected:  map[string]interface{}{"app": "web", "goog-managed-by": "me"},
+		},
+	}
+
+	for tn, tc := range cases {
+		// The state manages goog-managed-by
+		d := resource.Data(&terraform.InstanceState{
+			ID: "id",
+			Attributes: map[string]string{
+				"template.#":                        "1",
+				"template.0.labels.%":               "1",
+				"template.0.labels.goog-managed-by": "me",
+			},
+			RawConfig: tc.RawConfig,
+		})
+		got := FilterIgnoredAnnotations(read, d, "template.0.labels", &transport_tpg.Config{})
+		if !reflect.DeepEqual(got, tc.Expected) {
+			t.Errorf("bad: %s, expected %v, got %v", tn, tc.Expected, got)
+		}
+	}
+}
+
+func TestMergeIgnoredAnnotations(t *testing.T) {
+	resource := &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"labels": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+	current := map[string]string{
+		"app":             "old",
+		"team":            "removed",
+		"goog-managed-by": "server",
+		"goog-removed":    "me",
+		"goog-other":      "server",
+	}
+
+	cases := map[string]struct {
+		Prior      map[string]string
+		Configured map[string]interface{}
+		Expected   map[string]string
+	}{
+		"keeps unmanaged server keys": {
+			Prior:      map[string]string{"app": "old", "team": "removed"},
+			Configured: map[string]interface{}{"app": "web", "goog-managed-by": "me"},
+			Expected: map[string]string{
+				"app":             "web",
+				"goog-managed-by": "me",
+				"goog-removed":    "me",
+				"goog-other":      "server",
+			},
+		},
+		"removes a managed key dropped from the config": {
+			Prior:      map[string]string{"app": "old", "goog-removed": "me"},
+			Configured: map[string]interface{}{"app": "web"},
+			Expected: map[string]string{
+				"app":             "web",
+				"goog-managed-by": "server",
+				"goog-other":      "server",
+			},
+		},
+	}
+
+	for tn, tc := range cases {
+		attributes := map[string]string{"labels.%": strconv.Itoa(len(tc.Prior))}
+		for k, v := range tc.Prior {
+			attributes["labels."+k] = v
+		}
+		state := &terraform.InstanceState{ID: "id", Attributes: attributes}
+		diff, err := resource.Diff(context.Background(), state, terraform.NewResourceConfigRaw(map[string]interface{}{"labels": tc.Configured}), nil)
+		if err != nil {
+			t.Fatalf("%s: error computing diff: %s", tn, err)
+		}
+		d, err := schema.InternalMap(resource.Schema).Data(state, diff)
+		if err != nil {
+			t.Fatalf("%s: error building resource data: %s", tn, err)
+		}
+
+		if got := MergeIgnoredAnnotations(d, "labels", current, &transport_tpg.Config{}); !reflect.DeepEqual(got, tc.Expected) {
+			t.Errorf("bad: %s, expected %v, got %v", tn, tc.Expected, got)
+		}
+	}
+}
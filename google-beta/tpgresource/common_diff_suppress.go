@@ -156,6 +156,12 @@ func LocationDiffSuppressHelper(a, b string) bool {
 		strings.Replace(a, "/locations/", "/zones/", 1) == b
 }
 
+// CompareLocations suppresses diffs between values naming the same location,
+// such as "US" and "us", or a zone's name and its self_link.
+func CompareLocations(_, old, new string, _ *schema.ResourceData) bool {
+	return NormalizeLocation(old) == NormalizeLocation(new)
+}
+
 // For managed SSL certs, if new is an absolute FQDN (trailing '.') but old isn't, treat them as equals.
 func AbsoluteDomainSuppress(k, old, new string, _ *schema.ResourceData) bool {
 	if strings.HasPrefix(k, "managed.0.domains.") {
@@ -176,7 +182,8 @@ func TimestampDiffSuppress(format string) schema.SchemaDiffSuppressFunc {
 			return false
 		}
 
-		return oldT == newT
+		// Timestamps in different time zones are equal if they are the same instant
+		return oldT.Equal(newT)
 	}
 }
 
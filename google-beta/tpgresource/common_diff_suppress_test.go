@@ -4,7 +4,10 @@
 
 package tpgresource
 
-import "testing"
+import (
+	"testing"
+	"time"
+)
 
 func TestOptionalPrefixSuppress(t *testing.T) {
 	cases := map[string]struct {
@@ -214,6 +217,50 @@ func TestLocationDiffSuppress(t *testing.T) {
 	}
 }
 
+func TestCompareLocations(t *testing.T) {
+	cases := map[string]struct {
+		Old, New           string
+		ExpectDiffSuppress bool
+	}{
+		"same location": {
+			Old:                "US",
+			New:                "US",
+			ExpectDiffSuppress: true,
+		},
+		"different case": {
+			Old:                "US",
+			New:                "us",
+			ExpectDiffSuppress: true,
+		},
+		"zone self_link": {
+			Old:                "https://www.googleapis.com/compute/v1/projects/my-project/zones/europe-west1-b",
+			New:                "europe-west1-b",
+			ExpectDiffSuppress: true,
+		},
+		"multi-region name of another API": {
+			Old:                "EU",
+			New:                "europe",
+			ExpectDiffSuppress: false,
+		},
+		"different location": {
+			Old:                "US",
+			New:                "us-central1",
+			ExpectDiffSuppress: false,
+		},
+		"different zone": {
+			Old:                "https://www.googleapis.com/compute/v1/projects/my-project/zones/europe-west1-b",
+			New:                "europe-west1-c",
+			ExpectDiffSuppress: false,
+		},
+	}
+
+	for tn, tc := range cases {
+		if CompareLocations("location", tc.Old, tc.New, nil) != tc.ExpectDiffSuppress {
+			t.Errorf("bad: %s, '%s' => '%s' expect %t", tn, tc.Old, tc.New, tc.ExpectDiffSuppress)
+		}
+	}
+}
+
 func TestAbsoluteDomainSuppress(t *testing.T) {
 	cases := map[string]struct {
 		Old, New           string
@@ -529,3 +576,37 @@ func TestEmptyOrUnsetBlockDiffSuppress(t *testing.T) {
 		}
 	}
 }
+
+func TestTimestampDiffSuppress(t *testing.T) {
+	cases := map[string]struct {
+		Old, New           string
+		ExpectDiffSuppress bool
+	}{
+		"same timestamp": {
+			Old:                "2024-01-02T03:04:05Z",
+			New:                "2024-01-02T03:04:05Z",
+			ExpectDiffSuppress: true,
+		},
+		"same instant in another time zone": {
+			Old:                "2024-01-01T19:04:05-08:00",
+			New:                "2024-01-02T03:04:05Z",
+			ExpectDiffSuppress: true,
+		},
+		"different instants": {
+			Old:                "2024-01-02T03:04:05Z",
+			New:                "2024-01-02T03:04:06Z",
+			ExpectDiffSuppress: false,
+		},
+		"unparseable": {
+			Old:                "",
+			New:                "2024-01-02T03:04:05Z",
+			ExpectDiffSuppress: false,
+		},
+	}
+
+	for tn, tc := range cases {
+		if TimestampDiffSuppress(time.RFC3339)("key", tc.Old, tc.New, nil) != tc.ExpectDiffSuppress {
+			t.Fatalf("bad: %s, '%s' => '%s' expect %t", tn, tc.Old, tc.New, tc.ExpectDiffSuppress)
+		}
+	}
+}
@@ -3,8 +3,10 @@
 package tpgresource
 
 import (
+	"context"
 	"fmt"
 	"log"
+	"strings"
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
@@ -138,20 +140,39 @@ func CommonRefreshFunc(w Waiter) resource.StateRefreshFunc {
 	}
 }
 
+// Operations are first polled at this interval, which doubles after every poll
+// up to the poll interval given to OperationWait.
+const minOperationPollInterval = time.Second
+
+// The number of consecutive polls that may not find the operation before
+// giving up, matching resource.StateChangeConf.
+const operationNotFoundChecks = 20
+
+// OperationTimeoutError is returned when an operation doesn't finish within
+// the timeout given to OperationWait.
+type OperationTimeoutError struct {
+	OpName    string
+	LastState string
+	Timeout   time.Duration
+}
+
+func (e *OperationTimeoutError) Error() string {
+	return fmt.Sprintf("timeout after %s waiting for operation %q to finish (last state: %q)", e.Timeout, e.OpName, e.LastState)
+}
+
 func OperationWait(w Waiter, activity string, timeout time.Duration, pollInterval time.Duration) error {
+	return OperationWaitContext(context.Background(), w, activity, timeout, pollInterval)
+}
+
+// OperationWaitContext polls the operation of w until it reaches a target
+// state, the timeout expires or ctx is cancelled. Polls back off exponentially
+// from minOperationPollInterval up to pollInterval.
+func OperationWaitContext(ctx context.Context, w Waiter, activity string, timeout time.Duration, pollInterval time.Duration) error {
 	if OperationDone(w) {
 		return w.Error()
 	}
 
-	c := &resource.StateChangeConf{
-		Pending:      w.PendingStates(),
-		Target:       w.TargetStates(),
-		Refresh:      CommonRefreshFunc(w),
-		Timeout:      timeout,
-		MinTimeout:   2 * time.Second,
-		PollInterval: pollInterval,
-	}
-	opRaw, err := c.WaitForState()
+	opRaw, err := waitForOperationState(ctx, w, timeout, pollInterval)
 	if err != nil {
 		return fmt.Errorf("Error waiting for %s: %w", activity, err)
 	}
@@ -164,6 +185,75 @@ func OperationWait(w Waiter, activity string, timeout time.Duration, pollInterva
 	return w.Error()
 }
 
+func waitForOperationState(ctx context.Context, w Waiter, timeout time.Duration, pollInterval time.Duration) (interface{}, error) {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	refresh := CommonRefreshFunc(w)
+	deadline := time.Now().Add(timeout)
+	interval := OperationPollBackoff(0, pollInterval)
+	lastState := ""
+	notFound := 0
+
+	for attempt := 1; ; attempt++ {
+		res, state, err := refresh()
+		if err != nil {
+			return nil, err
+		}
+		lastState = state
+
+		if res == nil {
+			notFound++
+			if notFound > operationNotFoundChecks {
+				return nil, fmt.Errorf("operation %q not found after %d attempts", w.OpName(), notFound)
+			}
+		} else {
+			notFound = 0
+			if StringInSlice(w.TargetStates(), state) {
+				return res, nil
+			}
+			if !StringInSlice(w.PendingStates(), state) {
+				return nil, fmt.Errorf("unexpected state %q of operation %q, wanted target %q", state, w.OpName(), strings.Join(w.TargetStates(), ", "))
+			}
+		}
+
+		remaining := time.Until(deadline)
+		if remaining <= 0 {
+			return nil, &OperationTimeoutError{OpName: w.OpName(), LastState: lastState, Timeout: timeout}
+		}
+		if interval > remaining {
+			interval = remaining
+		}
+
+		timer := time.NewTimer(interval)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return nil, fmt.Errorf("stopped waiting for operation %q: %w", w.OpName(), ctx.Err())
+		case <-timer.C:
+		}
+
+		interval = OperationPollBackoff(attempt, pollInterval)
+	}
+}
+
+// OperationPollBackoff returns how long to wait before the next poll of an
+// operation after the given number of polls, doubling from
+// minOperationPollInterval and capped at pollInterval.
+func OperationPollBackoff(polls int, pollInterval time.Duration) time.Duration {
+	if pollInterval <= minOperationPollInterval {
+		return pollInterval
+	}
+	interval := minOperationPollInterval
+	for i := 0; i < polls && interval < pollInterval; i++ {
+		interval *= 2
+	}
+	if interval > pollInterval {
+		return pollInterval
+	}
+	return interval
+}
+
 // The cloud resource manager API operation is an example of one of many
 // interchangeable API operations. Choose it somewhat arbitrarily to represent
 // the "common" operation.
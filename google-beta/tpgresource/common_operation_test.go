@@ -3,7 +3,10 @@
 package tpgresource
 
 import (
+	"context"
+	"errors"
 	"net/url"
+	"strings"
 	"testing"
 	"time"
 
@@ -69,3 +72,71 @@ func TestOperationWait_TimeoutsShouldRetry(t *testing.T) {
 			expectedRunCount, testWaiter.runCount)
 	}
 }
+
+type pendingWaiter struct {
+	CommonOperationWaiter
+	runCount int
+}
+
+func (w *pendingWaiter) QueryOp() (interface{}, error) {
+	w.runCount++
+	return map[string]interface{}{"name": "operations/my-operation", "done": false}, nil
+}
+
+func TestOperationWait_TimeoutIncludesOperationName(t *testing.T) {
+	w := &pendingWaiter{}
+	w.Op.Name = "operations/my-operation"
+
+	err := OperationWait(w, "my-activity", 50*time.Millisecond, 10*time.Millisecond)
+	if err == nil {
+		t.Fatal("expected a timeout error, got nil")
+	}
+	var timeoutErr *OperationTimeoutError
+	if !errors.As(err, &timeoutErr) {
+		t.Fatalf("expected an OperationTimeoutError, got %T: %s", err, err)
+	}
+	if !strings.Contains(err.Error(), "operations/my-operation") || !strings.Contains(err.Error(), "my-activity") {
+		t.Errorf("expected the error to name the activity and operation, got %q", err)
+	}
+	if w.runCount < 2 {
+		t.Errorf("expected the operation to be polled more than once, was polled %d time(s)", w.runCount)
+	}
+}
+
+func TestOperationWaitContext_Cancelled(t *testing.T) {
+	w := &pendingWaiter{}
+	w.Op.Name = "operations/my-operation"
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	err := OperationWaitContext(ctx, w, "my-activity", time.Minute, time.Second)
+	if !errors.Is(err, context.Canceled) {
+		t.Fatalf("expected a context cancellation error, got %v", err)
+	}
+	if w.runCount != 1 {
+		t.Errorf("expected the operation to be polled once, was polled %d time(s)", w.runCount)
+	}
+}
+
+func TestOperationPollBackoff(t *testing.T) {
+	cases := []struct {
+		Polls        int
+		PollInterval time.Duration
+		Expected     time.Duration
+	}{
+		{Polls: 0, PollInterval: 10 * time.Second, Expected: time.Second},
+		{Polls: 1, PollInterval: 10 * time.Second, Expected: 2 * time.Second},
+		{Polls: 3, PollInterval: 10 * time.Second, Expected: 8 * time.Second},
+		{Polls: 4, PollInterval: 10 * time.Second, Expected: 10 * time.Second},
+		{Polls: 100, PollInterval: 10 * time.Second, Expected: 10 * time.Second},
+		{Polls: 0, PollInterval: 10 * time.Millisecond, Expected: 10 * time.Millisecond},
+		{Polls: 5, PollInterval: 0, Expected: 0},
+	}
+
+	for _, tc := range cases {
+		if got := OperationPollBackoff(tc.Polls, tc.PollInterval); got != tc.Expected {
+			t.Errorf("OperationPollBackoff(%d, %s): expected %s, got %s", tc.Polls, tc.PollInterval, tc.Expected, got)
+		}
+	}
+}
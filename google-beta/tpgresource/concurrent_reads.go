@@ -0,0 +1,36 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+package tpgresource
+
+import (
+	"context"
+
+	"golang.org/x/sync/errgroup"
+)
+
+// MaxConcurrentReads is the maximum number of requests a single resource
+// sends at once when reading with ConcurrentReads.
+const MaxConcurrentReads = 4
+
+// ConcurrentReads runs independent reads, such as GET calls to different
+// APIs, concurrently and waits for all of them to finish. It returns the
+// first error returned by a read, if any.
+//
+// The context passed to each read is cancelled once a read fails, so reads
+// whose result is only useful when the others succeed should send their
+// requests with it, and skip them once it's done.
+//
+// schema.ResourceData isn't safe for concurrent use, so reads shouldn't call
+// Get or Set on it. Compute their inputs beforehand, and set the results once
+// ConcurrentReads returns.
+func ConcurrentReads(ctx context.Context, reads ...func(context.Context) error) error {
+	g, ctx := errgroup.WithContext(ctx)
+	g.SetLimit(MaxConcurrentReads)
+	for _, read := range reads {
+		read := read
+		g.Go(func() error {
+			return read(ctx)
+		})
+	}
+	return g.Wait()
+}
@@ -0,0 +1,101 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+package tpgresource
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestConcurrentReads(t *testing.T) {
+	t.Parallel()
+
+	var calls, running, maxRunning int32
+	read := func(context.Context) error {
+		atomic.AddInt32(&calls, 1)
+		n := atomic.AddInt32(&running, 1)
+		for {
+			m := atomic.LoadInt32(&maxRunning)
+			if n <= m || atomic.CompareAndSwapInt32(&maxRunning, m, n) {
+				break
+			}
+		}
+		time.Sleep(10 * time.Millisecond)
+		atomic.AddInt32(&running, -1)
+		return nil
+	}
+
+	reads := make([]func(context.Context) error, 2*MaxConcurrentReads)
+	for i := range reads {
+		reads[i] = read
+	}
+	if err := ConcurrentReads(context.Background(), reads...); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if int(calls) != len(reads) {
+		t.Errorf("expected %d reads, got %d", len(reads), calls)
+	}
+	if maxRunning < 2 || maxRunning > MaxConcurrentReads {
+		t.Errorf("expected between 2 and %d concurrent reads, got %d", MaxConcurrentReads, maxRunning)
+	}
+}
+
+func TestConcurrentReads_error(t *testing.T) {
+	t.Parallel()
+
+	readErr := errors.New("read failed")
+	var done int32
+	err := ConcurrentReads(context.Background(),
+		func(context.Context) error {
+			return readErr
+		},
+		func(context.Context) error {
+			time.Sleep(10 * time.Millisecond)
+			atomic.AddInt32(&done, 1)
+			return nil
+		},
+	)
+	if err != readErr {
+		t.Errorf("expected %v, got %v", readErr, err)
+	}
+	if done != 1 {
+		t.Errorf("expected all reads to finish before returning")
+	}
+}
+
+func TestConcurrentReads_cancelledOnError(t *testing.T) {
+	t.Parallel()
+
+	readErr := errors.New("read failed")
+	var ctxErr error
+	err := ConcurrentReads(context.Background(),
+		func(context.Context) error {
+			return readErr
+		},
+		func(ctx context.Context) error {
+			select {
+			case <-ctx.Done():
+				ctxErr = ctx.Err()
+			case <-time.After(10 * time.Second):
+			}
+			return nil
+		},
+	)
+	if err != readErr {
+		t.Errorf("expected %v, got %v", readErr, err)
+	}
+	if ctxErr != context.Canceled {
+		t.Errorf("expected the other reads to be cancelled, got %v", ctxErr)
+	}
+}
+
+func TestConcurrentReads_none(t *testing.T) {
+	t.Parallel()
+
+	if err := ConcurrentReads(context.Background()); err != nil {
+		t.Errorf("unexpected error: %s", err)
+	}
+}
@@ -0,0 +1,73 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+package tpgresource
+
+import (
+	"log"
+	"sort"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	transport_tpg "github.com/hashicorp/terraform-provider-google-beta/google-beta/transport"
+)
+
+// ConditionalRefreshEtag returns the etag that the previous read of d
+// recorded in field, to be sent in an If-None-Match header, when the provider
+// field "conditional_refresh" is set. It returns "" otherwise, and when the
+// resource hasn't been read yet, in which case it must be read in full.
+//
+// It also returns "" when one of computedFields is missing from the prior
+// state, such as a field added by a newer provider version, so that the
+// resource is read in full once before its reads can be skipped. Build
+// computedFields once with ComputedFields.
+//
+// A read that gets a 304 Not Modified response should return without setting
+// any field, so that the prior state is kept.
+func ConditionalRefreshEtag(d TerraformResourceData, config *transport_tpg.Config, field string, computedFields []string) string {
+	if config == nil || !config.ConditionalRefresh || d.Id() == "" {
+		return ""
+	}
+	etag, _ := d.Get(field).(string)
+	if etag == "" {
+		return ""
+	}
+
+	if rs, ok := d.(interface{ GetRawState() cty.Value }); ok {
+		rawState := rs.GetRawState()
+		if rawState.IsNull() || !rawState.IsKnown() || !rawState.Type().IsObjectType() {
+			log.Printf("[DEBUG] No prior state of %q to compare, reading it in full", d.Id())
+			return ""
+		}
+		if missing := missingField(rawState, computedFields); missing != "" {
+			log.Printf("[DEBUG] %s is missing from the state of %q, reading it in full", missing, d.Id())
+			return ""
+		}
+	}
+	return etag
+}
+
+// ComputedFields returns the sorted names of the top-level computed fields of
+// resourceSchema, other than the ones in mayBeUnset, which the read leaves
+// unset in some cases.
+func ComputedFields(resourceSchema map[string]*schema.Schema, mayBeUnset ...string) []string {
+	var fields []string
+	for k, s := range resourceSchema {
+		if s.Computed && !StringInSlice(mayBeUnset, k) {
+			fields = append(fields, k)
+		}
+	}
+	sort.Strings(fields)
+	return fields
+}
+
+// missingField returns the first of fields that is null in the raw state, or
+// "" if there is none.
+func missingField(rawState cty.Value, fields []string) string {
+	for _, k := range fields {
+		if !rawState.Type().HasAttribute(k) || rawState.GetAttr(k).IsNull() {
+			return k
+		}
+	}
+	return ""
+}
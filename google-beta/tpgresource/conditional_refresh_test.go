@@ -0,0 +1,140 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+package tpgresource
+
+import (
+	"testing"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	transport_tpg "github.com/hashicorp/terraform-provider-google-beta/google-beta/transport"
+)
+
+func TestConditionalRefreshEtag(t *testing.T) {
+	t.Parallel()
+
+	cases := map[string]struct {
+		conditionalRefresh bool
+		id                 string
+		etag               interface{}
+		expected           string
+	}{
+		"enabled": {
+			conditionalRefresh: true,
+			id:                 "my-bucket",
+			etag:               "CAE=",
+			expected:           "CAE=",
+		},
+		"disabled": {
+			id:       "my-bucket",
+			etag:     "CAE=",
+			expected: "",
+		},
+		"not read yet": {
+			conditionalRefresh: true,
+			etag:               "CAE=",
+			expected:           "",
+		},
+		"no etag recorded": {
+			conditionalRefresh: true,
+			id:                 "my-bucket",
+			expected:           "",
+		},
+	}
+
+	for tn, tc := range cases {
+		d := &ResourceDataMock{
+			FieldsInSchema: map[string]interface{}{},
+		}
+		if tc.etag != nil {
+			d.FieldsInSchema["etag"] = tc.etag
+		}
+		d.SetId(tc.id)
+		config := &transport_tpg.Config{ConditionalRefresh: tc.conditionalRefresh}
+
+		if got := ConditionalRefreshEtag(d, config, "etag", nil); got != tc.expected {
+			t.Errorf("%s: expected %q, got %q", tn, tc.expected, got)
+		}
+	}
+}
+
+// A 304 Not Modified response skips setting every field, so a field missing
+// from the prior state, such as one added by a newer provider version, would
+// stay unset. The etag isn't sent then, so that the resource is read in full.
+func TestConditionalRefreshEtag_missingField(t *testing.T) {
+	t.Parallel()
+
+	resource := &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"name":           {Type: schema.TypeString, Required: true},
+			"description":    {Type: schema.TypeString, Optional: true},
+			"etag":           {Type: schema.TypeString, Computed: true},
+			"project_number": {Type: schema.TypeString, Computed: true},
+			"rpo":            {Type: schema.TypeString, Optional: true, Computed: true},
+		},
+	}
+	attributes := map[string]string{
+		"id":   "my-bucket",
+		"name": "my-bucket",
+		"etag": "CAE=",
+	}
+
+	cases := map[string]struct {
+		rawState   cty.Value
+		mayBeUnset []string
+		expected   string
+	}{
+		"all fields read": {
+			rawState: cty.ObjectVal(map[string]cty.Value{
+				"id":             cty.StringVal("my-bucket"),
+				"name":           cty.StringVal("my-bucket"),
+				"description":    cty.NullVal(cty.String),
+				"etag":           cty.StringVal("CAE="),
+				"project_number": cty.StringVal("123"),
+				"rpo":            cty.StringVal("DEFAULT"),
+			}),
+			expected: "CAE=",
+		},
+		"field added by a newer provider version": {
+			rawState: cty.ObjectVal(map[string]cty.Value{
+				"id":             cty.StringVal("my-bucket"),
+				"name":           cty.StringVal("my-bucket"),
+				"description":    cty.NullVal(cty.String),
+				"etag":           cty.StringVal("CAE="),
+				"project_number": cty.NullVal(cty.String),
+				"rpo":            cty.StringVal("DEFAULT"),
+			}),
+			expected: "",
+		},
+		"field that may be unset": {
+			rawState: cty.ObjectVal(map[string]cty.Value{
+				"id":             cty.StringVal("my-bucket"),
+				"name":           cty.StringVal("my-bucket"),
+				"description":    cty.NullVal(cty.String),
+				"etag":           cty.StringVal("CAE="),
+				"project_number": cty.StringVal("123"),
+				"rpo":            cty.NullVal(cty.String),
+			}),
+			mayBeUnset: []string{"rpo"},
+			expected:   "CAE=",
+		},
+		"no prior state": {
+			rawState: cty.NullVal(cty.DynamicPseudoType),
+			expected: "",
+		},
+	}
+
+	config := &transport_tpg.Config{ConditionalRefresh: true}
+	for tn, tc := range cases {
+		d := resource.Data(&terraform.InstanceState{
+			ID:         "my-bucket",
+			Attributes: attributes,
+			RawState:   tc.rawState,
+		})
+		if got := ConditionalRefreshEtag(d, config, "etag", ComputedFields(resource.Schema, tc.mayBeUnset...)); got != tc.expected {
+			t.Errorf("%s: expected %q, got %q", tn, tc.expected, got)
+		}
+	}
+}
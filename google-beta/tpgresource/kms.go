@@ -0,0 +1,144 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+package tpgresource
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	transport_tpg "github.com/hashicorp/terraform-provider-google-beta/google-beta/transport"
+)
+
+// kmsLocationAliases maps the names that other APIs use for a multi-region to
+// the name Cloud KMS uses, such as Cloud Storage and BigQuery's "eu" and
+// Cloud KMS's "europe".
+var kmsLocationAliases = map[string]string{
+	"eu": "europe",
+}
+
+// normalizeKmsLocation returns NormalizeLocation(location), with multi-region
+// names mapped to the ones used by Cloud KMS.
+func normalizeKmsLocation(location string) string {
+	location = NormalizeLocation(location)
+	if alias, ok := kmsLocationAliases[location]; ok {
+		return alias
+	}
+	return location
+}
+
+// GetDefaultKmsKey returns the provider-level default KMS key configured for the
+// given location, or an empty string if there is none. Locations are matched
+// with normalizeKmsLocation, and a zone falls back to the key configured for
+// its region.
+func GetDefaultKmsKey(config *transport_tpg.Config, location string) string {
+	if len(config.DefaultKmsKeys) == 0 || location == "" {
+		return ""
+	}
+
+	location = normalizeKmsLocation(location)
+	if key, ok := config.DefaultKmsKeys[location]; ok {
+		return key
+	}
+	// Sort the configured locations so that the same key is picked when
+	// several of them name the location, such as "EU" and "europe".
+	locations := make([]string, 0, len(config.DefaultKmsKeys))
+	for k := range config.DefaultKmsKeys {
+		locations = append(locations, k)
+	}
+	sort.Strings(locations)
+	for _, k := range locations {
+		if normalizeKmsLocation(k) == location {
+			return config.DefaultKmsKeys[k]
+		}
+	}
+
+	if region := GetRegionFromZone(location); region != "" {
+		if key, ok := config.DefaultKmsKeys[region]; ok {
+			return key
+		}
+	}
+
+	return ""
+}
+
+// SetEffectiveKmsKeyDiff returns a CustomizeDiffFunc that sets the field
+// "effective_kms_key" on new resources. It holds the KMS key configured in
+// keyField, or the provider-level default_kms_key for the location returned by
+// locationFunc if the block holding keyField isn't configured at all. A block
+// configured with another kind of key, such as a customer-supplied raw_key,
+// leaves effective_kms_key unset. If the location isn't known until apply,
+// effective_kms_key is left computed. Existing resources are never re-keyed by
+// a change to the provider-level defaults.
+func SetEffectiveKmsKeyDiff(keyField string, locationFunc func(*schema.ResourceDiff, *transport_tpg.Config) (string, error)) schema.CustomizeDiffFunc {
+	return func(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
+		if d.Id() != "" {
+			if d.HasChange(keyField) {
+				if err := d.SetNewComputed("effective_kms_key"); err != nil {
+					return fmt.Errorf("error setting effective_kms_key to computed: %w", err)
+				}
+			}
+			return nil
+		}
+
+		if !d.NewValueKnown(keyField) {
+			return nil
+		}
+
+		if v, ok := d.GetOk(keyField); ok {
+			if err := d.SetNew("effective_kms_key", v.(string)); err != nil {
+				return fmt.Errorf("error setting new effective_kms_key diff: %w", err)
+			}
+			return nil
+		}
+
+		if block := strings.SplitN(keyField, ".", 2)[0]; block != keyField {
+			if v, ok := d.GetOk(block); ok && len(v.([]interface{})) > 0 {
+				return nil
+			}
+		}
+
+		config := meta.(*transport_tpg.Config)
+		if len(config.DefaultKmsKeys) == 0 {
+			return nil
+		}
+
+		location, err := locationFunc(d, config)
+		if err != nil {
+			return err
+		}
+		if location == "" {
+			if err := d.SetNewComputed("effective_kms_key"); err != nil {
+				return fmt.Errorf("error setting effective_kms_key to computed: %w", err)
+			}
+			return nil
+		}
+
+		if key := GetDefaultKmsKey(config, location); key != "" {
+			if err := d.SetNew("effective_kms_key", key); err != nil {
+				return fmt.Errorf("error setting new effective_kms_key diff: %w", err)
+			}
+		}
+
+		return nil
+	}
+}
+
+// IsDefaultKmsKeyInherited returns whether kmsKeyName, as returned by the API,
+// was inherited from the provider-level default_kms_key rather than configured
+// in the block at blockField. Inherited keys are only tracked in
+// "effective_kms_key" so that they don't show up as a diff on blockField.
+func IsDefaultKmsKeyInherited(d *schema.ResourceData, blockField, kmsKeyName string) bool {
+	if kmsKeyName == "" || len(d.Get(blockField).([]interface{})) > 0 {
+		return false
+	}
+	effectiveKmsKey := d.Get("effective_kms_key").(string)
+	if effectiveKmsKey == "" {
+		return false
+	}
+	// The API may return the key version in use, which isn't part of the configured key
+	kmsKeyName = strings.Split(kmsKeyName, "/cryptoKeyVersions")[0]
+	return effectiveKmsKey == kmsKeyName || CompareSelfLinkRelativePaths("", effectiveKmsKey, kmsKeyName, nil)
+}
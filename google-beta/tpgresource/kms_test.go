@@ -0,0 +1,191 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+package tpgresource
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	transport_tpg "github.com/hashicorp/terraform-provider-google-beta/google-beta/transport"
+)
+
+func TestGetDefaultKmsKey(t *testing.T) {
+	config := &transport_tpg.Config{
+		DefaultKmsKeys: map[string]string{
+			"us-central1":   "projects/p/locations/us-central1/keyRings/r/cryptoKeys/regional",
+			"us-central1-a": "projects/p/locations/us-central1/keyRings/r/cryptoKeys/zonal",
+			"us":            "projects/p/locations/us/keyRings/r/cryptoKeys/multi",
+			"europe":        "projects/p/locations/europe/keyRings/r/cryptoKeys/multi",
+		},
+	}
+
+	cases := map[string]struct {
+		Location string
+		Expect   string
+	}{
+		"exact zone": {
+			Location: "us-central1-a",
+			Expect:   "projects/p/locations/us-central1/keyRings/r/cryptoKeys/zonal",
+		},
+		"zone falls back to region": {
+			Location: "us-central1-b",
+			Expect:   "projects/p/locations/us-central1/keyRings/r/cryptoKeys/regional",
+		},
+		"zone self link": {
+			Location: "https://www.googleapis.com/compute/v1/projects/p/zones/us-central1-c",
+			Expect:   "projects/p/locations/us-central1/keyRings/r/cryptoKeys/regional",
+		},
+		"upper case multi-region": {
+			Location: "US",
+			Expect:   "projects/p/locations/us/keyRings/r/cryptoKeys/multi",
+		},
+		"storage multi-region alias": {
+			Location: "EU",
+			Expect:   "projects/p/locations/europe/keyRings/r/cryptoKeys/multi",
+		},
+		"unknown location": {
+			Location: "europe-west1",
+			Expect:   "",
+		},
+		"empty location": {
+			Location: "",
+			Expect:   "",
+		},
+	}
+
+	for tn, tc := range cases {
+		if got := GetDefaultKmsKey(config, tc.Location); got != tc.Expect {
+			t.Errorf("%s: expected %q, got %q", tn, tc.Expect, got)
+		}
+	}
+
+	if got := GetDefaultKmsKey(&transport_tpg.Config{}, "us-central1"); got != "" {
+		t.Errorf("no defaults: expected no key, got %q", got)
+	}
+
+	// When several configured locations name the location, the first one in
+	// sorted order wins every time.
+	ambiguous := &transport_tpg.Config{
+		DefaultKmsKeys: map[string]string{
+			"EU": "projects/p/locations/europe/keyRings/r/cryptoKeys/upper",
+			"Eu": "projects/p/locations/europe/keyRings/r/cryptoKeys/mixed",
+		},
+	}
+	for i := 0; i < 20; i++ {
+		if got := GetDefaultKmsKey(ambiguous, "eu"); got != "projects/p/locations/europe/keyRings/r/cryptoKeys/upper" {
+			t.Fatalf("ambiguous locations: expected the key configured for %q, got %q", "EU", got)
+		}
+	}
+}
+
+func TestSetEffectiveKmsKeyDiff(t *testing.T) {
+	config := &transport_tpg.Config{
+		DefaultKmsKeys: map[string]string{
+			"us-central1": "projects/p/locations/us-central1/keyRings/r/cryptoKeys/default",
+		},
+	}
+
+	r := &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"zone": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"disk_encryption_key": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"raw_key": {
+							Type:      schema.TypeString,
+							Optional:  true,
+							Sensitive: true,
+						},
+						"kms_key_self_link": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+					},
+				},
+			},
+			"effective_kms_key": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+		CustomizeDiff: SetEffectiveKmsKeyDiff("disk_encryption_key.0.kms_key_self_link", func(d *schema.ResourceDiff, _ *transport_tpg.Config) (string, error) {
+			return d.Get("zone").(string), nil
+		}),
+	}
+
+	cases := map[string]struct {
+		Config         map[string]interface{}
+		Expect         string
+		ExpectComputed bool
+	}{
+		"no key configured": {
+			Config: map[string]interface{}{
+				"zone": "us-central1-a",
+			},
+			Expect: "projects/p/locations/us-central1/keyRings/r/cryptoKeys/default",
+		},
+		"kms key configured": {
+			Config: map[string]interface{}{
+				"zone": "us-central1-a",
+				"disk_encryption_key": []interface{}{
+					map[string]interface{}{
+						"kms_key_self_link": "projects/p/locations/us-central1/keyRings/r/cryptoKeys/configured",
+					},
+				},
+			},
+			Expect: "projects/p/locations/us-central1/keyRings/r/cryptoKeys/configured",
+		},
+		"customer-supplied key configured": {
+			Config: map[string]interface{}{
+				"zone": "us-central1-a",
+				"disk_encryption_key": []interface{}{
+					map[string]interface{}{
+						"raw_key": "SGVsbG8gZnJvbSBHb29nbGUgQ2xvdWQgUGxhdGZvcm0=",
+					},
+				},
+			},
+			Expect: "",
+		},
+		"no default for location": {
+			Config: map[string]interface{}{
+				"zone": "europe-west1-b",
+			},
+			Expect: "",
+		},
+		"location unknown at plan time": {
+			Config: map[string]interface{}{
+				// The value the SDK uses for unknown values in raw configs
+				"zone": "74D93920-ED26-11E3-AC10-0800200C9A66",
+			},
+			ExpectComputed: true,
+		},
+	}
+
+	for tn, tc := range cases {
+		diff, err := r.Diff(context.Background(), nil, terraform.NewResourceConfigRaw(tc.Config), config)
+		if err != nil {
+			t.Fatalf("%s: unexpected error: %s", tn, err)
+		}
+		attr, ok := diff.Attributes["effective_kms_key"]
+		if tc.ExpectComputed && !(ok && attr.NewComputed) {
+			t.Errorf("%s: expected effective_kms_key to be computed", tn)
+			continue
+		}
+		got := ""
+		if ok && !attr.NewComputed {
+			got = attr.New
+		}
+		if got != tc.Expect {
+			t.Errorf("%s: expected effective_kms_key %q, got %q", tn, tc.Expect, got)
+		}
+	}
+}
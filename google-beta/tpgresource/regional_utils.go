@@ -15,6 +15,23 @@ func IsZone(location string) bool {
 	return len(strings.Split(location, "-")) == 3
 }
 
+// NormalizeLocation returns the canonical form of a location, used to compare
+// locations that are written differently: its lowercase name without any
+// self_link or resource name prefix.
+func NormalizeLocation(location string) string {
+	return strings.ToLower(GetResourceNameFromSelfLink(strings.TrimSpace(location)))
+}
+
+// PreserveLocation returns the configured location if it refers to the same
+// location as the one returned by the API, so that the format used in the
+// configuration is kept in state, and the API's location otherwise.
+func PreserveLocation(configured, fromAPI string) string {
+	if configured != "" && NormalizeLocation(configured) == NormalizeLocation(fromAPI) {
+		return configured
+	}
+	return fromAPI
+}
+
 // GetLocation attempts to get values in this order (if they exist):
 // - location argument in the resource config
 // - region argument in the resource config
@@ -56,6 +56,45 @@ func CompareSelfLinkOrResourceName(_, old, new string, _ *schema.ResourceData) b
 	return CompareSelfLinkRelativePaths("", old, new, nil)
 }
 
+// CompareResourceReferences checks if two references point to the same resource.
+//
+// Either value can be a self_link, a full resource name such as
+// `//cloudkms.googleapis.com/projects/...`, a relative path or a short name.
+// When one of them has fewer path segments than the other, they are equal if
+// it matches the end of the other one, so `a-network` and
+// `global/networks/a-network` both match the self_link of a-network.
+func CompareResourceReferences(_, old, new string, _ *schema.ResourceData) bool {
+	if old == new {
+		return true
+	}
+	if old == "" || new == "" {
+		return false
+	}
+
+	longer := resourceReferenceSegments(old)
+	shorter := resourceReferenceSegments(new)
+	if len(longer) < len(shorter) {
+		longer, shorter = shorter, longer
+	}
+
+	offset := len(longer) - len(shorter)
+	for i, segment := range shorter {
+		if longer[offset+i] != segment {
+			return false
+		}
+	}
+	return true
+}
+
+// resourceReferenceSegments returns the path segments of a resource reference,
+// starting from "projects" when the reference contains a project.
+func resourceReferenceSegments(ref string) []string {
+	if path, err := GetRelativePath(ref); err == nil {
+		ref = path
+	}
+	return strings.Split(strings.Trim(ref, "/"), "/")
+}
+
 // Hash the relative path of a self link.
 func SelfLinkRelativePathHash(selfLink interface{}) int {
 	path, _ := GetRelativePath(selfLink.(string))
@@ -68,6 +68,75 @@ func TestCompareSelfLinkOrResourceName(t *testing.T) {
 	}
 }
 
+func TestCompareResourceReferences(t *testing.T) {
+	cases := map[string]struct {
+		Old, New string
+		Expect   bool
+	}{
+		"name only, same": {
+			Old:    "https://www.googleapis.com/compute/v1/projects/your-project/global/networks/a-network",
+			New:    "a-network",
+			Expect: true,
+		},
+		"name only, different": {
+			Old:    "https://www.googleapis.com/compute/v1/projects/your-project/global/networks/a-network",
+			New:    "another-network",
+			Expect: false,
+		},
+		"global path, same": {
+			Old:    "https://www.googleapis.com/compute/v1/projects/your-project/global/networks/a-network",
+			New:    "global/networks/a-network",
+			Expect: true,
+		},
+		"regional path, different collection": {
+			Old:    "https://www.googleapis.com/compute/v1/projects/your-project/regions/us-central1/subnetworks/a-network",
+			New:    "global/networks/a-network",
+			Expect: false,
+		},
+		"relative path, same": {
+			Old:    "https://www.googleapis.com/compute/v1/projects/your-project/regions/us-central1/subnetworks/a-subnetwork",
+			New:    "projects/your-project/regions/us-central1/subnetworks/a-subnetwork",
+			Expect: true,
+		},
+		"relative path, different project": {
+			Old:    "https://www.googleapis.com/compute/v1/projects/your-project/global/networks/a-network",
+			New:    "projects/another-project/global/networks/a-network",
+			Expect: false,
+		},
+		"beta self_link, same": {
+			Old:    "https://www.googleapis.com/compute/v1/projects/your-project/global/images/an-image",
+			New:    "https://www.googleapis.com/compute/beta/projects/your-project/global/images/an-image",
+			Expect: true,
+		},
+		"stored name, self_link in config": {
+			Old:    "a-network",
+			New:    "https://www.googleapis.com/compute/v1/projects/your-project/global/networks/a-network",
+			Expect: true,
+		},
+		"kms key, full resource name": {
+			Old:    "projects/your-project/locations/us/keyRings/a-ring/cryptoKeys/a-key",
+			New:    "//cloudkms.googleapis.com/projects/your-project/locations/us/keyRings/a-ring/cryptoKeys/a-key",
+			Expect: true,
+		},
+		"kms key, different key ring": {
+			Old:    "projects/your-project/locations/us/keyRings/a-ring/cryptoKeys/a-key",
+			New:    "projects/your-project/locations/us/keyRings/another-ring/cryptoKeys/a-key",
+			Expect: false,
+		},
+		"empty new value": {
+			Old:    "projects/your-project/global/networks/a-network",
+			New:    "",
+			Expect: false,
+		},
+	}
+
+	for tn, tc := range cases {
+		if CompareResourceReferences("", tc.Old, tc.New, nil) != tc.Expect {
+			t.Errorf("bad: %s, expected %t for old = %q and new = %q", tn, tc.Expect, tc.Old, tc.New)
+		}
+	}
+}
+
 func TestGetResourceNameFromSelfLink(t *testing.T) {
 	cases := map[string]struct {
 		SelfLink, ExpectedName string
@@ -0,0 +1,55 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+package tpgresource
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	transport_tpg "github.com/hashicorp/terraform-provider-google-beta/google-beta/transport"
+)
+
+// IgnoreServerSideChangesDiff drops the planned changes to the fields of
+// resourceType listed in the provider field "ignore_server_side_changes", so
+// that values updated out-of-band, such as the size of an autoscaled group,
+// aren't reverted on the next apply. The configured value is still used when
+// the resource is created.
+func IgnoreServerSideChangesDiff(resourceType string) schema.CustomizeDiffFunc {
+	return func(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
+		config, ok := meta.(*transport_tpg.Config)
+		if !ok || config == nil || d.Id() == "" {
+			return nil
+		}
+		return ignoreServerSideChanges(d, resourceType, config.IgnoreServerSideChanges[resourceType])
+	}
+}
+
+func ignoreServerSideChanges(d TerraformResourceDiff, resourceType string, fields []string) error {
+	for _, field := range fields {
+		if !d.HasChange(field) {
+			continue
+		}
+		log.Printf("[DEBUG] Ignoring the planned change to %s.%s, which is managed server-side", resourceType, field)
+		// Clear fails for fields that aren't computed, as there is no value to
+		// keep in state other than the configured one.
+		if err := d.Clear(field); err != nil {
+			return fmt.Errorf("cannot ignore server-side changes to %s.%s: %s", resourceType, field, err)
+		}
+	}
+	return nil
+}
+
+// WithIgnoreServerSideChanges returns a copy of r whose CustomizeDiff also
+// applies IgnoreServerSideChangesDiff for resourceType.
+func WithIgnoreServerSideChanges(resourceType string, r *schema.Resource) *schema.Resource {
+	wrapped := *r
+	if r.CustomizeDiff == nil {
+		wrapped.CustomizeDiff = IgnoreServerSideChangesDiff(resourceType)
+	} else {
+		wrapped.CustomizeDiff = customdiff.All(r.CustomizeDiff, IgnoreServerSideChangesDiff(resourceType))
+	}
+	return &wrapped
+}
@@ -0,0 +1,72 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+package tpgresource
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestIgnoreServerSideChanges(t *testing.T) {
+	cases := map[string]struct {
+		Before   map[string]interface{}
+		After    map[string]interface{}
+		Fields   []string
+		Expected map[string]interface{}
+	}{
+		"ignored field changed": {
+			Before:   map[string]interface{}{"target_size": 3, "name": "a"},
+			After:    map[string]interface{}{"target_size": 5, "name": "a"},
+			Fields:   []string{"target_size"},
+			Expected: map[string]interface{}{"target_size": true},
+		},
+		"ignored field unchanged": {
+			Before: map[string]interface{}{"target_size": 3},
+			After:  map[string]interface{}{"target_size": 3},
+			Fields: []string{"target_size"},
+		},
+		"other field changed": {
+			Before: map[string]interface{}{"target_size": 3, "name": "a"},
+			After:  map[string]interface{}{"target_size": 3, "name": "b"},
+			Fields: []string{"target_size"},
+		},
+		"no ignored fields": {
+			Before: map[string]interface{}{"target_size": 3},
+			After:  map[string]interface{}{"target_size": 5},
+		},
+	}
+
+	for tn, tc := range cases {
+		d := &ResourceDiffMock{
+			Before: tc.Before,
+			After:  tc.After,
+		}
+		if err := ignoreServerSideChanges(d, "google_compute_instance_group_manager", tc.Fields); err != nil {
+			t.Fatalf("%s: unexpected error: %s", tn, err)
+		}
+		if !reflect.DeepEqual(d.Cleared, tc.Expected) {
+			t.Errorf("%s: expected cleared fields %v, got %v", tn, tc.Expected, d.Cleared)
+		}
+	}
+}
+
+func TestWithIgnoreServerSideChanges(t *testing.T) {
+	r := &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"name": {Type: schema.TypeString, Required: true},
+		},
+	}
+
+	wrapped := WithIgnoreServerSideChanges("google_test_resource", r)
+	if wrapped == r {
+		t.Errorf("expected a copy of the resource")
+	}
+	if wrapped.CustomizeDiff == nil {
+		t.Errorf("expected the copy to have a CustomizeDiff")
+	}
+	if r.CustomizeDiff != nil {
+		t.Errorf("expected the original resource to be left unchanged")
+	}
+}
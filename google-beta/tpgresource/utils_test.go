@@ -616,6 +616,23 @@ func TestGetRegionFromZone(t *testing.T) {
 	}
 }
 
+func TestPreserveLocation(t *testing.T) {
+	cases := map[string]struct {
+		Configured, FromAPI, Expected string
+	}{
+		"same case":           {Configured: "US", FromAPI: "US", Expected: "US"},
+		"configured in lower": {Configured: "us", FromAPI: "US", Expected: "us"},
+		"not configured":      {Configured: "", FromAPI: "US", Expected: "US"},
+		"different location":  {Configured: "EU", FromAPI: "US", Expected: "US"},
+	}
+
+	for tn, tc := range cases {
+		if actual := tpgresource.PreserveLocation(tc.Configured, tc.FromAPI); actual != tc.Expected {
+			t.Errorf("%s: expected %q, got %q", tn, tc.Expected, actual)
+		}
+	}
+}
+
 func TestDatasourceSchemaFromResourceSchema(t *testing.T) {
 	type args struct {
 		rs map[string]*schema.Schema
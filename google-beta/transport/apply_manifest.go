@@ -0,0 +1,262 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+package transport
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+	"sync"
+	"time"
+)
+
+// ApplyManifestRecord is a single entry of the apply manifest, describing one
+// request that may have mutated a GCP resource.
+type ApplyManifestRecord struct {
+	Time          string `json:"time"`
+	CorrelationId string `json:"correlation_id"`
+	Method        string `json:"method"`
+	Resource      string `json:"resource"`
+	RequestSha256 string `json:"request_sha256,omitempty"`
+	StatusCode    int    `json:"status_code,omitempty"`
+	OperationId   string `json:"operation_id,omitempty"`
+	Error         string `json:"error,omitempty"`
+}
+
+// Custom methods that are sent as POST requests but never mutate a resource.
+var applyManifestReadOnlyMethods = []string{
+	":getIamPolicy",
+	":testIamPermissions",
+}
+
+// ApplyManifest writes a JSON record of every mutating request made by the
+// provider to a local file as JSON lines, to a Pub/Sub topic, or to both.
+type ApplyManifest struct {
+	path       string
+	publishUrl string
+	client     *http.Client
+
+	mu sync.Mutex
+}
+
+// NewApplyManifest returns an ApplyManifest writing to the file at path and
+// publishing to topic (in the format projects/{project}/topics/{topic}) using
+// the Pub/Sub API at pubsubBasePath. Either destination may be empty, and nil
+// is returned if both are. client must not record to the manifest itself.
+func NewApplyManifest(path, topic, pubsubBasePath string, client *http.Client) *ApplyManifest {
+	if path == "" && topic == "" {
+		return nil
+	}
+
+	m := &ApplyManifest{
+		path:   path,
+		client: client,
+	}
+	if topic != "" {
+		m.publishUrl = fmt.Sprintf("%s%s:publish", pubsubBasePath, topic)
+	}
+	return m
+}
+
+// Write records r to every destination of the manifest. Failures are logged
+// rather than returned so that recording never fails the request itself.
+func (m *ApplyManifest) Write(r ApplyManifestRecord) {
+	b, err := json.Marshal(r)
+	if err != nil {
+		log.Printf("[WARN] Error encoding apply manifest record: %s", err)
+		return
+	}
+
+	if m.path != "" {
+		if err := m.appendToFile(b); err != nil {
+			log.Printf("[WARN] Error writing apply manifest record to %s: %s", m.path, err)
+		}
+	}
+
+	if m.publishUrl != "" {
+		if err := m.publish(b); err != nil {
+			log.Printf("[WARN] Error publishing apply manifest record: %s", err)
+		}
+	}
+}
+
+func (m *ApplyManifest) appendToFile(b []byte) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	f, err := os.OpenFile(m.path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0600)
+	if err != nil {
+		return err
+	}
+	if _, err := f.Write(append(b, '\n')); err != nil {
+		f.Close()
+		return err
+	}
+	return f.Close()
+}
+
+func (m *ApplyManifest) publish(b []byte) error {
+	body, err := json.Marshal(map[string]interface{}{
+		"messages": []interface{}{
+			map[string]interface{}{
+				"data": base64.StdEncoding.EncodeToString(b),
+				"attributes": map[string]string{
+					"correlation_id": CorrelationId(),
+				},
+			},
+		},
+	})
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequest("POST", m.publishUrl, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	res, err := m.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+	if res.StatusCode >= 300 {
+		msg, _ := io.ReadAll(res.Body)
+		return fmt.Errorf("got HTTP response code %d with body: %s", res.StatusCode, msg)
+	}
+	return nil
+}
+
+type applyManifestTransport struct {
+	manifest  *ApplyManifest
+	transport http.RoundTripper
+}
+
+// NewApplyManifestTransport returns a transport that records every mutating
+// request made through t to manifest. If manifest is nil, t is returned as is.
+func NewApplyManifestTransport(t http.RoundTripper, manifest *ApplyManifest) http.RoundTripper {
+	if manifest == nil {
+		return t
+	}
+	return &applyManifestTransport{manifest: manifest, transport: t}
+}
+
+func (t *applyManifestTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if !IsApplyManifestMutation(req.Method, req.URL) {
+		return t.transport.RoundTrip(req)
+	}
+
+	record := ApplyManifestRecord{
+		Time:          time.Now().UTC().Format(time.RFC3339Nano),
+		CorrelationId: CorrelationId(),
+		Method:        req.Method,
+		Resource:      applyManifestResource(req.URL),
+	}
+
+	body, err := readRequestBody(req)
+	if err != nil {
+		return nil, err
+	}
+	if len(body) > 0 {
+		sum := sha256.Sum256(body)
+		record.RequestSha256 = hex.EncodeToString(sum[:])
+	}
+
+	resp, err := t.transport.RoundTrip(req)
+	if err != nil {
+		record.Error = err.Error()
+		t.manifest.Write(record)
+		return resp, err
+	}
+
+	record.StatusCode = resp.StatusCode
+	if resp.Body != nil {
+		respBody, err := io.ReadAll(resp.Body)
+		resp.Body.Close()
+		resp.Body = io.NopCloser(bytes.NewReader(respBody))
+		if err != nil {
+			return resp, err
+		}
+		record.OperationId = ApplyManifestOperationId(respBody)
+	}
+
+	t.manifest.Write(record)
+	return resp, nil
+}
+
+// IsApplyManifestMutation returns whether a request with the given method and
+// URL may mutate a resource, and so should be recorded in the apply manifest.
+func IsApplyManifestMutation(method string, u *url.URL) bool {
+	switch method {
+	case "GET", "HEAD", "OPTIONS":
+		return false
+	}
+	for _, m := range applyManifestReadOnlyMethods {
+		if strings.HasSuffix(u.Path, m) {
+			return false
+		}
+	}
+	return true
+}
+
+// ApplyManifestOperationId returns the name of the long-running operation in
+// a response body, or "" if the response is not an operation.
+func ApplyManifestOperationId(body []byte) string {
+	var res map[string]interface{}
+	if err := json.Unmarshal(body, &res); err != nil {
+		return ""
+	}
+	name, _ := res["name"].(string)
+	if name == "" {
+		return ""
+	}
+	if kind, _ := res["kind"].(string); strings.HasSuffix(kind, "#operation") {
+		return name
+	}
+	if strings.Contains(name, "operations/") {
+		return name
+	}
+	return ""
+}
+
+// applyManifestResource returns the URL of the request without its query, as
+// query parameters only carry request options such as alt=json.
+func applyManifestResource(u *url.URL) string {
+	r := *u
+	r.RawQuery = ""
+	r.Fragment = ""
+	return r.String()
+}
+
+// readRequestBody returns the body of req, leaving it readable again.
+func readRequestBody(req *http.Request) ([]byte, error) {
+	if req.Body == nil || req.Body == http.NoBody {
+		return nil, nil
+	}
+	if req.GetBody != nil {
+		rc, err := req.GetBody()
+		if err != nil {
+			return nil, err
+		}
+		defer rc.Close()
+		return io.ReadAll(rc)
+	}
+
+	b, err := io.ReadAll(req.Body)
+	req.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	req.Body = io.NopCloser(bytes.NewReader(b))
+	return b, nil
+}
@@ -0,0 +1,141 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+package transport
+
+import (
+	"bufio"
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+type roundTripperFunc func(*http.Request) (*http.Response, error)
+
+func (f roundTripperFunc) RoundTrip(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
+
+func TestApplyManifestTransport(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "manifest.jsonl")
+	manifest := NewApplyManifest(path, "", "", nil)
+
+	base := roundTripperFunc(func(req *http.Request) (*http.Response, error) {
+		// The body must still be readable after being hashed.
+		if req.Body != nil {
+			b, _ := io.ReadAll(req.Body)
+			if string(b) != `{"name":"addr"}` {
+				t.Errorf("unexpected request body %q", b)
+			}
+		}
+		body := `{"id":"1"}`
+		if req.Method == "POST" {
+			body = `{"kind":"compute#operation","name":"operation-123"}`
+		}
+		return &http.Response{StatusCode: 200, Body: io.NopCloser(bytes.NewBufferString(body))}, nil
+	})
+	client := &http.Client{Transport: NewApplyManifestTransport(base, manifest)}
+
+	res, err := client.Get("https://compute.googleapis.com/compute/v1/projects/p/regions/r/addresses/addr?alt=json")
+	if err != nil {
+		t.Fatal(err)
+	}
+	res.Body.Close()
+
+	res, err = client.Post("https://compute.googleapis.com/compute/v1/projects/p/regions/r/addresses?alt=json", "application/json", bytes.NewBufferString(`{"name":"addr"}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	b, _ := io.ReadAll(res.Body)
+	res.Body.Close()
+	if string(b) != `{"kind":"compute#operation","name":"operation-123"}` {
+		t.Errorf("unexpected response body %q", b)
+	}
+
+	f, err := os.Open(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+
+	var records []ApplyManifestRecord
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		var r ApplyManifestRecord
+		if err := json.Unmarshal(scanner.Bytes(), &r); err != nil {
+			t.Fatal(err)
+		}
+		records = append(records, r)
+	}
+
+	if len(records) != 1 {
+		t.Fatalf("expected 1 record, got %d: %v", len(records), records)
+	}
+	sum := sha256.Sum256([]byte(`{"name":"addr"}`))
+	r := records[0]
+	if r.Method != "POST" ||
+		r.Resource != "https://compute.googleapis.com/compute/v1/projects/p/regions/r/addresses" ||
+		r.RequestSha256 != hex.EncodeToString(sum[:]) ||
+		r.StatusCode != 200 ||
+		r.OperationId != "operation-123" ||
+		r.CorrelationId != CorrelationId() {
+		t.Errorf("unexpected record %#v", r)
+	}
+}
+
+func TestNewApplyManifest_noDestination(t *testing.T) {
+	if m := NewApplyManifest("", "", "https://pubsub.googleapis.com/v1/", nil); m != nil {
+		t.Errorf("expected no manifest, got %#v", m)
+	}
+}
+
+func TestIsApplyManifestMutation(t *testing.T) {
+	cases := map[string]struct {
+		Method   string
+		Url      string
+		Expected bool
+	}{
+		"get":                  {Method: "GET", Url: "https://example.com/v1/projects/p/topics/t", Expected: false},
+		"create":               {Method: "POST", Url: "https://example.com/v1/projects/p/topics", Expected: true},
+		"patch":                {Method: "PATCH", Url: "https://example.com/v1/projects/p/topics/t", Expected: true},
+		"delete":               {Method: "DELETE", Url: "https://example.com/v1/projects/p/topics/t", Expected: true},
+		"set iam policy":       {Method: "POST", Url: "https://example.com/v1/projects/p/topics/t:setIamPolicy", Expected: true},
+		"get iam policy":       {Method: "POST", Url: "https://example.com/v1/projects/p/topics/t:getIamPolicy", Expected: false},
+		"test iam permissions": {Method: "POST", Url: "https://example.com/v1/projects/p:testIamPermissions", Expected: false},
+	}
+
+	for tn, tc := range cases {
+		u, err := url.Parse(tc.Url)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got := IsApplyManifestMutation(tc.Method, u); got != tc.Expected {
+			t.Errorf("%s: expected %v, got %v", tn, tc.Expected, got)
+		}
+	}
+}
+
+func TestApplyManifestOperationId(t *testing.T) {
+	cases := map[string]struct {
+		Body     string
+		Expected string
+	}{
+		"compute operation":   {Body: `{"kind":"compute#operation","name":"operation-123"}`, Expected: "operation-123"},
+		"long-running op":     {Body: `{"name":"projects/p/locations/l/operations/op-1","done":false}`, Expected: "projects/p/locations/l/operations/op-1"},
+		"resource":            {Body: `{"name":"projects/p/topics/t"}`, Expected: ""},
+		"empty body":          {Body: ``, Expected: ""},
+		"non-object response": {Body: `[]`, Expected: ""},
+	}
+
+	for tn, tc := range cases {
+		if got := ApplyManifestOperationId([]byte(tc.Body)); got != tc.Expected {
+			t.Errorf("%s: expected %q, got %q", tn, tc.Expected, got)
+		}
+	}
+}
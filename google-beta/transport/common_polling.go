@@ -5,6 +5,7 @@ package transport
 import (
 	"fmt"
 	"log"
+	"math/rand"
 	"sync"
 	"time"
 
@@ -50,6 +51,38 @@ func PollingWaitTime(pollF PollReadFunc, checkResponse PollCheckResponseFunc, ac
 	})
 }
 
+// ReadAfterCreateOccurrences is the number of consecutive successful reads
+// required before a newly created resource is considered visible, as reads may
+// be served by replicas that haven't observed the creation yet.
+const ReadAfterCreateOccurrences = 2
+
+// PollReadAfterCreate polls a newly created resource with pollF until it's
+// consistently visible, so that eventually consistent APIs don't cause
+// spurious 404 or 403 errors when it's read or used later in the same apply.
+// Responses are handled by checkResponse, such as PollCheckForExistence, and
+// polls are spaced by a jittered backoff.
+func PollReadAfterCreate(pollF PollReadFunc, checkResponse PollCheckResponseFunc, activity string, timeout time.Duration) error {
+	first := true
+	jitteredPollF := func() (map[string]interface{}, error) {
+		if !first {
+			time.Sleep(JitteredBackoff(0, 500*time.Millisecond))
+		}
+		first = false
+		return pollF()
+	}
+	return PollingWaitTime(jitteredPollF, checkResponse, activity, timeout, ReadAfterCreateOccurrences)
+}
+
+// JitteredBackoff returns backoff plus a random jitter of up to maxJitter, so
+// that concurrent operations retrying against the same API don't do so in
+// lockstep.
+func JitteredBackoff(backoff, maxJitter time.Duration) time.Duration {
+	if maxJitter <= 0 {
+		return backoff
+	}
+	return backoff + time.Duration(rand.Int63n(int64(maxJitter)))
+}
+
 // RetryWithTargetOccurrences is a basic wrapper around StateChangeConf that will retry
 // a function until it returns the specified amount of target occurrences continuously.
 // Adapted from the Retry function in the go SDK.
@@ -0,0 +1,56 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+package transport
+
+import (
+	"testing"
+	"time"
+
+	"google.golang.org/api/googleapi"
+)
+
+func TestPollReadAfterCreate(t *testing.T) {
+	i := 0
+	pollF := func() (map[string]interface{}, error) {
+		i++
+		// The resource is visible, then briefly isn't, as reads hit lagging replicas
+		if i == 1 || i == 3 {
+			return nil, &googleapi.Error{Code: 404}
+		}
+		return nil, nil
+	}
+
+	if err := PollReadAfterCreate(pollF, PollCheckForExistence, "Creating Thing", 30*time.Second); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	// 404, success, 404, success, success
+	if i != 5 {
+		t.Errorf("expected 5 reads, got %d", i)
+	}
+}
+
+func TestPollReadAfterCreate_error(t *testing.T) {
+	i := 0
+	pollF := func() (map[string]interface{}, error) {
+		i++
+		return nil, &googleapi.Error{Code: 400}
+	}
+
+	if err := PollReadAfterCreate(pollF, PollCheckForExistence, "Creating Thing", 30*time.Second); err == nil {
+		t.Fatalf("expected an error")
+	}
+	if i != 1 {
+		t.Errorf("expected a single read, got %d", i)
+	}
+}
+
+func TestJitteredBackoff(t *testing.T) {
+	for i := 0; i < 100; i++ {
+		if got := JitteredBackoff(time.Second, 500*time.Millisecond); got < time.Second || got >= 1500*time.Millisecond {
+			t.Fatalf("expected backoff in [1s, 1.5s), got %s", got)
+		}
+	}
+	if got := JitteredBackoff(time.Second, 0); got != time.Second {
+		t.Errorf("expected no jitter, got %s", got)
+	}
+}
@@ -175,10 +175,30 @@ type Config struct {
 	RequestReason                             string
 	RequestTimeout                            time.Duration
 	DefaultLabels                             map[string]string
+	DefaultKmsKeys                            map[string]string
+	DefaultTags                               []string
+	DefaultMetadata                           map[string]string
 	AddTerraformAttributionLabel              bool
 	TerraformAttributionLabelAdditionStrategy string
-	// PollInterval is passed to resource.StateChangeConf in common_operation.go
-	// It controls the interval at which we poll for successful operations
+	// IgnoreAnnotationPrefixes lists additional prefixes of server-injected
+	// annotation and label keys that are dropped from state unless configured.
+	IgnoreAnnotationPrefixes []string
+	// IgnoreServerSideChanges lists, by resource type, the fields whose
+	// planned changes are dropped on existing resources because they are
+	// updated out-of-band, such as by an autoscaler.
+	IgnoreServerSideChanges map[string][]string
+	// ConditionalRefresh makes resources that record an etag read with
+	// If-None-Match, keeping their prior state when it's not modified.
+	ConditionalRefresh bool
+	// ApplyManifestPath and ApplyManifestPubsubTopic are the destinations of
+	// the apply manifest, a JSON record of every mutating request.
+	ApplyManifestPath        string
+	ApplyManifestPubsubTopic string
+	// ApiRateLimits is the maximum number of requests per second to each API,
+	// keyed by API host or DefaultRateLimitKey.
+	ApiRateLimits map[string]float64
+	// PollInterval is passed to OperationWait in common_operation.go
+	// It controls the maximum interval at which we poll for successful operations
 	PollInterval time.Duration
 
 	Client             *http.Client
@@ -198,6 +218,7 @@ type Config struct {
 	ApphubBasePath                   string
 	ArtifactRegistryBasePath         string
 	BackupDRBasePath                 string
+	BareMetalSolutionBasePath        string
 	BeyondcorpBasePath               string
 	BiglakeBasePath                  string
 	BigQueryBasePath                 string
@@ -347,6 +368,7 @@ const AppEngineBasePathKey = "AppEngine"
 const ApphubBasePathKey = "Apphub"
 const ArtifactRegistryBasePathKey = "ArtifactRegistry"
 const BackupDRBasePathKey = "BackupDR"
+const BareMetalSolutionBasePathKey = "BareMetalSolution"
 const BeyondcorpBasePathKey = "Beyondcorp"
 const BiglakeBasePathKey = "Biglake"
 const BigQueryBasePathKey = "BigQuery"
@@ -490,6 +512,7 @@ var DefaultBasePaths = map[string]string{
 	ApphubBasePathKey:                   "https://apphub.googleapis.com/v1/",
 	ArtifactRegistryBasePathKey:         "https://artifactregistry.googleapis.com/v1/",
 	BackupDRBasePathKey:                 "https://backupdr.googleapis.com/v1/",
+	BareMetalSolutionBasePathKey:        "https://baremetalsolution.googleapis.com/v2/",
 	BeyondcorpBasePathKey:               "https://beyondcorp.googleapis.com/v1/",
 	BiglakeBasePathKey:                  "https://biglake.googleapis.com/v1/",
 	BigQueryBasePathKey:                 "https://bigquery.googleapis.com/bigquery/v2/",
@@ -744,6 +767,11 @@ func SetEndpointDefaults(d *schema.ResourceData) error {
 			"GOOGLE_BACKUP_DR_CUSTOM_ENDPOINT",
 		}, DefaultBasePaths[BackupDRBasePathKey]))
 	}
+	if d.Get("bare_metal_solution_custom_endpoint") == "" {
+		d.Set("bare_metal_solution_custom_endpoint", MultiEnvDefault([]string{
+			"GOOGLE_BARE_METAL_SOLUTION_CUSTOM_ENDPOINT",
+		}, DefaultBasePaths[BareMetalSolutionBasePathKey]))
+	}
 	if d.Get("beyondcorp_custom_endpoint") == "" {
 		d.Set("beyondcorp_custom_endpoint", MultiEnvDefault([]string{
 			"GOOGLE_BEYONDCORP_CUSTOM_ENDPOINT",
@@ -1042,7 +1070,7 @@ func SetEndpointDefaults(d *schema.ResourceData) error {
 	if d.Get("firestore_custom_endpoint") == "" {
 		d.Set("firestore_custom_endpoint", MultiEnvDefault([]string{
 			"GOOGLE_FIRESTORE_CUSTOM_ENDPOINT",
-		}, DefaultBasePaths[FirestoreBasePathKey]))
+		}, EmulatorBasePath("FIRESTORE_EMULATOR_HOST", "/v1/", DefaultBasePaths[FirestoreBasePathKey])))
 	}
 	if d.Get("gke_backup_custom_endpoint") == "" {
 		d.Set("gke_backup_custom_endpoint", MultiEnvDefault([]string{
@@ -1197,7 +1225,7 @@ func SetEndpointDefaults(d *schema.ResourceData) error {
 	if d.Get("pubsub_custom_endpoint") == "" {
 		d.Set("pubsub_custom_endpoint", MultiEnvDefault([]string{
 			"GOOGLE_PUBSUB_CUSTOM_ENDPOINT",
-		}, DefaultBasePaths[PubsubBasePathKey]))
+		}, EmulatorBasePath("PUBSUB_EMULATOR_HOST", "/v1/", DefaultBasePaths[PubsubBasePathKey])))
 	}
 	if d.Get("pubsub_lite_custom_endpoint") == "" {
 		d.Set("pubsub_lite_custom_endpoint", MultiEnvDefault([]string{
@@ -1277,7 +1305,7 @@ func SetEndpointDefaults(d *schema.ResourceData) error {
 	if d.Get("storage_custom_endpoint") == "" {
 		d.Set("storage_custom_endpoint", MultiEnvDefault([]string{
 			"GOOGLE_STORAGE_CUSTOM_ENDPOINT",
-		}, DefaultBasePaths[StorageBasePathKey]))
+		}, EmulatorBasePath("STORAGE_EMULATOR_HOST", "/storage/v1/", DefaultBasePaths[StorageBasePathKey])))
 	}
 	if d.Get("storage_insights_custom_endpoint") == "" {
 		d.Set("storage_insights_custom_endpoint", MultiEnvDefault([]string{
@@ -1439,20 +1467,29 @@ func (c *Config) LoadAndValidate(ctx context.Context) error {
 	}
 
 	// 2. Logging Transport - ensure we log HTTP requests to GCP APIs.
-	loggingTransport := logging.NewTransport("Google", client.Transport)
+	loggingTransport := NewLoggingTransport("Google", client.Transport)
+
+	// 3. Rate Limit Transport - limits the requests made to each API, retries included.
+	rateLimitTransport := NewRateLimitTransport(loggingTransport, c.ApiRateLimits)
 
-	// 3. Retry Transport - retries common temporary errors
+	// 4. Retry Transport - retries common temporary errors
 	// Keep order for wrapping logging so we log each retried request as well.
 	// This value should be used if needed to create shallow copies with additional retry predicates.
 	// See ClientWithAdditionalRetries
-	retryTransport := NewTransportWithDefaultRetries(loggingTransport)
+	retryTransport := NewTransportWithDefaultRetries(rateLimitTransport)
+
+	// 5. Apply Manifest Transport - records mutating requests once, after retries.
+	// Records are published with the unwrapped client so that they aren't recorded themselves.
+	manifest := NewApplyManifest(c.ApplyManifestPath, c.ApplyManifestPubsubTopic, c.PubsubBasePath, &http.Client{Transport: client.Transport})
+	manifestTransport := NewApplyManifestTransport(retryTransport, manifest)
 
-	// 4. Header Transport - outer wrapper to inject additional headers we want to apply
+	// 6. Header Transport - outer wrapper to inject additional headers we want to apply
 	// before making requests
-	headerTransport := NewTransportWithHeaders(retryTransport)
+	headerTransport := NewTransportWithHeaders(manifestTransport)
 	if c.RequestReason != "" {
 		headerTransport.Set("X-Goog-Request-Reason", c.RequestReason)
 	}
+	log.Printf("[INFO] Correlation ID for requests to GCP APIs: %s", CorrelationId())
 
 	// Ensure $userProject is set for all HTTP requests using the client if specified by the provider config
 	// See https://cloud.google.com/apis/docs/system-parameters
@@ -1471,7 +1508,9 @@ func (c *Config) LoadAndValidate(ctx context.Context) error {
 	c.Region = GetRegionFromRegionSelfLink(c.Region)
 	c.RequestBatcherServiceUsage = NewRequestBatcher("Service Usage", ctx, c.BatchingConfig)
 	c.RequestBatcherIam = NewRequestBatcher("IAM", ctx, c.BatchingConfig)
-	c.PollInterval = 10 * time.Second
+	if c.PollInterval == 0 {
+		c.PollInterval = DefaultPollInterval
+	}
 
 	// gRPC Logging setup
 	logger := logrus.StandardLogger()
@@ -1525,6 +1564,13 @@ func ExpandProviderBatchingConfig(v interface{}) (*BatchingConfig, error) {
 	return config, nil
 }
 
+// DefaultPollInterval is the maximum interval between polls of a long-running
+// operation unless operation_poll_interval is set.
+const DefaultPollInterval = 10 * time.Second
+
+// MinPollInterval is the smallest value operation_poll_interval accepts.
+const MinPollInterval = time.Second
+
 func (c *Config) synchronousTimeout() time.Duration {
 	if c.RequestTimeout == 0 {
 		return 120 * time.Second
@@ -2224,6 +2270,7 @@ func ConfigureBasePaths(c *Config) {
 	c.ApphubBasePath = DefaultBasePaths[ApphubBasePathKey]
 	c.ArtifactRegistryBasePath = DefaultBasePaths[ArtifactRegistryBasePathKey]
 	c.BackupDRBasePath = DefaultBasePaths[BackupDRBasePathKey]
+	c.BareMetalSolutionBasePath = DefaultBasePaths[BareMetalSolutionBasePathKey]
 	c.BeyondcorpBasePath = DefaultBasePaths[BeyondcorpBasePathKey]
 	c.BiglakeBasePath = DefaultBasePaths[BiglakeBasePathKey]
 	c.BigQueryBasePath = DefaultBasePaths[BigQueryBasePathKey]
@@ -2405,6 +2452,20 @@ func MultiEnvDefault(ks []string, dv interface{}) interface{} {
 	return dv
 }
 
+// EmulatorBasePath returns the base path of a local emulator if the environment
+// variable envVar is set to its host, such as "localhost:8085". Otherwise, it
+// returns defaultBasePath.
+func EmulatorBasePath(envVar, path, defaultBasePath string) string {
+	host := os.Getenv(envVar)
+	if host == "" {
+		return defaultBasePath
+	}
+	if !strings.HasPrefix(host, "http://") && !strings.HasPrefix(host, "https://") {
+		host = "http://" + host
+	}
+	return strings.TrimSuffix(host, "/") + path
+}
+
 func CustomEndpointValidator() validator.String {
 	return stringvalidator.RegexMatches(regexp.MustCompile(`.*/[^/]+/$`), "")
 }
@@ -703,6 +703,35 @@ func TestRemoveBasePathVersion(t *testing.T) {
 	}
 }
 
+func TestEmulatorBasePath(t *testing.T) {
+	cases := map[string]struct {
+		EmulatorHost string
+		Expected     string
+	}{
+		"unset": {
+			EmulatorHost: "",
+			Expected:     "https://pubsub.googleapis.com/v1/",
+		},
+		"host and port": {
+			EmulatorHost: "localhost:8085",
+			Expected:     "http://localhost:8085/v1/",
+		},
+		"url": {
+			EmulatorHost: "https://emulator.example.com/",
+			Expected:     "https://emulator.example.com/v1/",
+		},
+	}
+
+	for tn, tc := range cases {
+		t.Run(tn, func(t *testing.T) {
+			t.Setenv("PUBSUB_EMULATOR_HOST", tc.EmulatorHost)
+			if got := transport_tpg.EmulatorBasePath("PUBSUB_EMULATOR_HOST", "/v1/", "https://pubsub.googleapis.com/v1/"); got != tc.Expected {
+				t.Errorf("got %s wanted %s", got, tc.Expected)
+			}
+		})
+	}
+}
+
 func TestGetRegionFromRegionSelfLink(t *testing.T) {
 	cases := map[string]struct {
 		Input          string
@@ -0,0 +1,133 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+package transport
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+
+	"google.golang.org/api/googleapi"
+)
+
+// Type URLs of the google.rpc error details that are rendered as text. Other
+// details are kept as compact JSON.
+const (
+	errorInfoType           = "type.googleapis.com/google.rpc.ErrorInfo"
+	quotaFailureType        = "type.googleapis.com/google.rpc.QuotaFailure"
+	preconditionFailureType = "type.googleapis.com/google.rpc.PreconditionFailure"
+	badRequestType          = "type.googleapis.com/google.rpc.BadRequest"
+	resourceInfoType        = "type.googleapis.com/google.rpc.ResourceInfo"
+	helpType                = "type.googleapis.com/google.rpc.Help"
+	localizedMessageType    = "type.googleapis.com/google.rpc.LocalizedMessage"
+	retryInfoType           = "type.googleapis.com/google.rpc.RetryInfo"
+	debugInfoType           = "type.googleapis.com/google.rpc.DebugInfo"
+)
+
+// AddGoogleApiErrorDetails rewrites the details of a *googleapi.Error as
+// readable text, instead of the JSON the googleapi client prints by default,
+// and records the request that failed. The error is updated in place so that
+// callers inspecting its type, code and reasons are unaffected, which means it
+// must be called before err is wrapped into another error's message. Errors
+// that aren't googleapi errors are returned unchanged.
+func AddGoogleApiErrorDetails(err error, method, url string) error {
+	gerr, ok := err.(*googleapi.Error)
+	if !ok || gerr == nil || gerr.Message == "" {
+		return err
+	}
+
+	var lines []string
+	for _, detail := range gerr.Details {
+		lines = append(lines, formatGoogleApiErrorDetail(detail)...)
+	}
+	if method != "" && url != "" {
+		lines = append(lines, fmt.Sprintf("Request: %s %s", method, url))
+	}
+	if len(lines) == 0 {
+		return err
+	}
+
+	message := gerr.Message + "\n" + strings.Join(lines, "\n")
+	// Keep the short ", reason" form of the error when its only entry repeats the message
+	if len(gerr.Errors) == 1 && gerr.Errors[0].Message == gerr.Message {
+		gerr.Errors[0].Message = message
+	}
+	gerr.Message = message
+	gerr.Details = nil
+	return err
+}
+
+func formatGoogleApiErrorDetail(detail interface{}) []string {
+	d, ok := detail.(map[string]interface{})
+	if !ok {
+		return []string{fmt.Sprintf("Detail: %v", detail)}
+	}
+
+	var lines []string
+	switch d["@type"] {
+	case errorInfoType:
+		line := fmt.Sprintf("Reason: %s", d["reason"])
+		if domain, ok := d["domain"].(string); ok && domain != "" {
+			line += fmt.Sprintf(" (domain: %s)", domain)
+		}
+		lines = append(lines, line)
+		if metadata, ok := d["metadata"].(map[string]interface{}); ok && len(metadata) > 0 {
+			keys := make([]string, 0, len(metadata))
+			for k := range metadata {
+				keys = append(keys, k)
+			}
+			sort.Strings(keys)
+			pairs := make([]string, 0, len(keys))
+			for _, k := range keys {
+				pairs = append(pairs, fmt.Sprintf("%s=%v", k, metadata[k]))
+			}
+			lines = append(lines, fmt.Sprintf("Metadata: %s", strings.Join(pairs, ", ")))
+		}
+	case quotaFailureType:
+		for _, v := range detailList(d, "violations") {
+			lines = append(lines, fmt.Sprintf("Quota violation: %s: %s", v["subject"], v["description"]))
+		}
+	case preconditionFailureType:
+		for _, v := range detailList(d, "violations") {
+			lines = append(lines, fmt.Sprintf("Precondition violation: %s %s: %s", v["type"], v["subject"], v["description"]))
+		}
+	case badRequestType:
+		for _, v := range detailList(d, "fieldViolations") {
+			lines = append(lines, fmt.Sprintf("Invalid field %s: %s", v["field"], v["description"]))
+		}
+	case resourceInfoType:
+		line := fmt.Sprintf("Resource: %s %s", d["resourceType"], d["resourceName"])
+		if description, ok := d["description"].(string); ok && description != "" {
+			line += fmt.Sprintf(": %s", description)
+		}
+		lines = append(lines, line)
+	case helpType:
+		for _, v := range detailList(d, "links") {
+			lines = append(lines, fmt.Sprintf("Help: %s (%s)", v["description"], v["url"]))
+		}
+	case localizedMessageType:
+		lines = append(lines, fmt.Sprintf("Message: %s", d["message"]))
+	case retryInfoType, debugInfoType:
+		// Retries are handled by the provider and debug info isn't actionable
+	default:
+		b, err := json.Marshal(d)
+		if err != nil {
+			b = []byte(fmt.Sprintf("%v", d))
+		}
+		lines = append(lines, fmt.Sprintf("Detail: %s", b))
+	}
+	return lines
+}
+
+// detailList returns the objects in the list field of an error detail.
+func detailList(d map[string]interface{}, field string) []map[string]interface{} {
+	raw, _ := d[field].([]interface{})
+	list := make([]map[string]interface{}, 0, len(raw))
+	for _, v := range raw {
+		if m, ok := v.(map[string]interface{}); ok {
+			list = append(list, m)
+		}
+	}
+	return list
+}
@@ -0,0 +1,114 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+package transport
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+
+	"google.golang.org/api/googleapi"
+)
+
+func TestAddGoogleApiErrorDetails(t *testing.T) {
+	gerr := &googleapi.Error{
+		Code:    403,
+		Message: "Compute Engine API has not been used in project 123 before or it is disabled.",
+		Errors: []googleapi.ErrorItem{
+			{
+				Reason:  "accessNotConfigured",
+				Message: "Compute Engine API has not been used in project 123 before or it is disabled.",
+			},
+		},
+		Details: []interface{}{
+			map[string]interface{}{
+				"@type":  "type.googleapis.com/google.rpc.ErrorInfo",
+				"reason": "SERVICE_DISABLED",
+				"domain": "googleapis.com",
+				"metadata": map[string]interface{}{
+					"service":  "compute.googleapis.com",
+					"consumer": "projects/123",
+				},
+			},
+			map[string]interface{}{
+				"@type": "type.googleapis.com/google.rpc.Help",
+				"links": []interface{}{
+					map[string]interface{}{
+						"description": "Google developers console API activation",
+						"url":         "https://console.developers.google.com/apis/api/compute.googleapis.com/overview?project=123",
+					},
+				},
+			},
+			map[string]interface{}{
+				"@type": "type.googleapis.com/google.rpc.QuotaFailure",
+				"violations": []interface{}{
+					map[string]interface{}{
+						"subject":     "project:123",
+						"description": "Quota exceeded",
+					},
+				},
+			},
+			map[string]interface{}{
+				"@type":      "type.googleapis.com/google.rpc.RetryInfo",
+				"retryDelay": "1s",
+			},
+			map[string]interface{}{
+				"@type": "type.googleapis.com/google.example.Unknown",
+				"field": "value",
+			},
+		},
+	}
+	err := AddGoogleApiErrorDetails(gerr, "POST", "https://compute.googleapis.com/compute/v1/projects/123/global/networks")
+
+	if !IsGoogleApiErrorWithCode(err, 403) || !IsApiNotEnabledError(err) {
+		t.Errorf("expected error to still be a 403 googleapi error, got %#v", err)
+	}
+	if gerr.Details != nil {
+		t.Errorf("expected details to be cleared, got %v", gerr.Details)
+	}
+
+	msg := err.Error()
+	for _, expected := range []string{
+		"Reason: SERVICE_DISABLED (domain: googleapis.com)",
+		"Metadata: consumer=projects/123, service=compute.googleapis.com",
+		"Help: Google developers console API activation (https://console.developers.google.com/apis/api/compute.googleapis.com/overview?project=123)",
+		"Quota violation: project:123: Quota exceeded",
+		`Detail: {"@type":"type.googleapis.com/google.example.Unknown","field":"value"}`,
+		"Request: POST https://compute.googleapis.com/compute/v1/projects/123/global/networks, accessNotConfigured",
+	} {
+		if !strings.Contains(msg, expected) {
+			t.Errorf("expected error to contain %q, got:\n%s", expected, msg)
+		}
+	}
+	if strings.Contains(msg, "retryDelay") {
+		t.Errorf("expected RetryInfo to be omitted, got:\n%s", msg)
+	}
+
+	// Adding details is idempotent
+	before := err.Error()
+	if after := AddGoogleApiErrorDetails(err, "", "").Error(); after != before {
+		t.Errorf("expected error to be unchanged, got:\n%s", after)
+	}
+}
+
+func TestAddGoogleApiErrorDetails_otherErrors(t *testing.T) {
+	cases := map[string]error{
+		"not a googleapi error": errors.New("some error"),
+		"googleapi error without a message": &googleapi.Error{
+			Code: 502,
+			Body: "Bad Gateway",
+		},
+	}
+
+	for tn, err := range cases {
+		before := err.Error()
+		if after := AddGoogleApiErrorDetails(err, "GET", "https://example.com").Error(); after != before {
+			t.Errorf("%s: expected %q, got %q", tn, before, after)
+		}
+	}
+
+	if err := AddGoogleApiErrorDetails(nil, "GET", "https://example.com"); err != nil {
+		t.Errorf("expected nil error, got %s", fmt.Sprint(err))
+	}
+}
@@ -313,6 +313,17 @@ func IsPeeringOperationInProgress(err error) (bool, string) {
 	return false, ""
 }
 
+// VPC Access connectors can't be deleted while another operation, such as
+// their creation, is still running on them.
+func IsVpcAccessConnectorOperationInProgress(err error) (bool, string) {
+	if gerr, ok := err.(*googleapi.Error); ok {
+		if (gerr.Code == 400 || gerr.Code == 409) && strings.Contains(strings.ToLower(gerr.Body), "in progress") {
+			return true, "Waiting for the operation in progress on the connector to complete"
+		}
+	}
+	return false, ""
+}
+
 func DatastoreIndex409Contention(err error) (bool, string) {
 	if gerr, ok := err.(*googleapi.Error); ok {
 		if gerr.Code == 409 && strings.Contains(gerr.Body, "too much contention") {
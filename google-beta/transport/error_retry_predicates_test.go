@@ -216,3 +216,47 @@ func TestExternalIpServiceNotActive(t *testing.T) {
 		t.Errorf("Error not detected as retryable")
 	}
 }
+
+func TestIsFingerprintError(t *testing.T) {
+	err := googleapi.Error{
+		Code:    412,
+		Message: "Supplied fingerprint does not match current metadata fingerprint.",
+	}
+	isRetryable, _ := IsFingerprintError(&err)
+	if !isRetryable {
+		t.Errorf("Error not detected as retryable")
+	}
+}
+
+func TestIsFingerprintError_otherPrecondition(t *testing.T) {
+	err := googleapi.Error{
+		Code:    412,
+		Message: "Precondition not met.",
+	}
+	isRetryable, _ := IsFingerprintError(&err)
+	if isRetryable {
+		t.Errorf("Error incorrectly detected as retryable")
+	}
+}
+
+func TestIsVpcAccessConnectorOperationInProgress(t *testing.T) {
+	err := googleapi.Error{
+		Code: 400,
+		Body: "Operation on connector projects/p/locations/us-central1/connectors/c is in progress",
+	}
+	isRetryable, _ := IsVpcAccessConnectorOperationInProgress(&err)
+	if !isRetryable {
+		t.Errorf("Error not detected as retryable")
+	}
+}
+
+func TestIsVpcAccessConnectorOperationInProgress_otherError(t *testing.T) {
+	err := googleapi.Error{
+		Code: 400,
+		Body: "Invalid IP CIDR range",
+	}
+	isRetryable, _ := IsVpcAccessConnectorOperationInProgress(&err)
+	if isRetryable {
+		t.Errorf("Error incorrectly detected as retryable")
+	}
+}
@@ -0,0 +1,194 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+package transport
+
+import (
+	"bytes"
+	"crypto/rand"
+	"encoding/json"
+	"fmt"
+	"log"
+	"net/http"
+	"net/http/httputil"
+	"regexp"
+	"strings"
+	"sync"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/logging"
+)
+
+const redactedValue = "REDACTED"
+
+// Headers whose values are never logged.
+var sensitiveLogHeaders = map[string]bool{
+	"Authorization":       true,
+	"Proxy-Authorization": true,
+	"Cookie":              true,
+	"Set-Cookie":          true,
+	"X-Goog-Api-Key":      true,
+}
+
+// Lowercased names of JSON fields whose values are never logged, in addition
+// to any field whose name contains "password".
+var sensitiveLogFields = map[string]bool{
+	"secret":          true,
+	"clientsecret":    true,
+	"sharedsecret":    true,
+	"privatekey":      true,
+	"privatekeydata":  true,
+	"accesstoken":     true,
+	"refreshtoken":    true,
+	"idtoken":         true,
+	"keystring":       true,
+	"rawkey":          true,
+	"rsaencryptedkey": true,
+}
+
+// IsSensitiveHttpHeader returns whether the value of an HTTP header is a
+// credential that must not be logged or recorded.
+func IsSensitiveHttpHeader(name string) bool {
+	return sensitiveLogHeaders[http.CanonicalHeaderKey(name)]
+}
+
+var logHeaderLineRegex = regexp.MustCompile(`^([A-Za-z0-9-]+): `)
+
+var (
+	correlationId     string
+	correlationIdOnce sync.Once
+)
+
+// CorrelationId returns an identifier that is generated once per provider
+// process. It is logged with every request so that the requests made by a
+// process can be told apart in debug logs and apply manifests.
+func CorrelationId() string {
+	correlationIdOnce.Do(func() {
+		b := make([]byte, 16)
+		if _, err := rand.Read(b); err != nil {
+			log.Printf("[WARN] Error generating correlation ID: %s", err)
+			return
+		}
+		// Format as a version 4 UUID
+		b[6] = (b[6] & 0x0f) | 0x40
+		b[8] = (b[8] & 0x3f) | 0x80
+		correlationId = fmt.Sprintf("terraform-%x-%x-%x-%x-%x", b[0:4], b[4:6], b[6:8], b[8:10], b[10:])
+	})
+	return correlationId
+}
+
+type loggingTransport struct {
+	name      string
+	transport http.RoundTripper
+}
+
+// NewLoggingTransport returns a transport that logs each HTTP request and
+// response at DEBUG level, like logging.NewTransport, but with credentials and
+// secret-bearing fields redacted and the correlation ID of the process.
+func NewLoggingTransport(name string, t http.RoundTripper) http.RoundTripper {
+	return &loggingTransport{name: name, transport: t}
+}
+
+func (t *loggingTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if logging.IsDebugOrHigher() {
+		reqData, err := httputil.DumpRequestOut(req, true)
+		if err == nil {
+			log.Printf("[DEBUG] %s API Request Details (correlation ID %s):\n---[ REQUEST ]---------------------------------------\n%s\n-----------------------------------------------------", t.name, CorrelationId(), RedactHttpLog(reqData))
+		} else {
+			log.Printf("[ERROR] %s API Request error: %#v", t.name, err)
+		}
+	}
+
+	resp, err := t.transport.RoundTrip(req)
+	if err != nil {
+		return resp, err
+	}
+
+	if logging.IsDebugOrHigher() {
+		respData, err := httputil.DumpResponse(resp, true)
+		if err == nil {
+			log.Printf("[DEBUG] %s API Response Details (correlation ID %s):\n---[ RESPONSE ]--------------------------------------\n%s\n-----------------------------------------------------", t.name, CorrelationId(), RedactHttpLog(respData))
+		} else {
+			log.Printf("[ERROR] %s API Response error: %#v", t.name, err)
+		}
+	}
+
+	return resp, nil
+}
+
+// RedactHttpLog replaces the values of sensitive headers and JSON fields in a
+// dumped HTTP request or response, and pretty-prints its JSON body.
+func RedactHttpLog(b []byte) string {
+	dump := string(b)
+	header, body, sep := dump, "", ""
+	for _, s := range []string{"\r\n\r\n", "\n\n"} {
+		if i := strings.Index(dump, s); i >= 0 {
+			header, body, sep = dump[:i], dump[i+len(s):], s
+			break
+		}
+	}
+
+	lines := strings.Split(header, "\n")
+	for i, line := range lines {
+		if m := logHeaderLineRegex.FindStringSubmatch(line); m != nil && IsSensitiveHttpHeader(m[1]) {
+			lines[i] = m[0] + redactedValue
+		}
+	}
+	header = strings.Join(lines, "\n")
+	if sep == "" {
+		return header
+	}
+
+	if trimmed := []byte(strings.TrimSpace(body)); json.Valid(trimmed) {
+		return header + sep + redactJsonBody(trimmed)
+	}
+
+	// Bodies that aren't a single JSON document, such as multipart uploads,
+	// may still contain JSON parts on their own lines.
+	lines = strings.Split(body, "\n")
+	for i, line := range lines {
+		if trimmed := []byte(strings.TrimSuffix(line, "\r")); json.Valid(trimmed) {
+			lines[i] = redactJsonBody(trimmed)
+		}
+	}
+	return header + sep + strings.Join(lines, "\n")
+}
+
+// redactJsonBody redacts sensitive fields in a valid JSON document and
+// pretty-prints it.
+func redactJsonBody(b []byte) string {
+	var v interface{}
+	if err := json.Unmarshal(b, &v); err == nil && redactJsonValue(v) {
+		if out, err := json.MarshalIndent(v, "", " "); err == nil {
+			return string(out)
+		}
+	}
+	var out bytes.Buffer
+	if err := json.Indent(&out, b, "", " "); err != nil {
+		return string(b)
+	}
+	return out.String()
+}
+
+// redactJsonValue redacts sensitive fields in a decoded JSON value in place,
+// and returns whether any field was redacted.
+func redactJsonValue(v interface{}) bool {
+	redacted := false
+	switch v := v.(type) {
+	case map[string]interface{}:
+		for k, field := range v {
+			lower := strings.ToLower(k)
+			if sensitiveLogFields[lower] || strings.Contains(lower, "password") {
+				if _, ok := field.(string); ok {
+					v[k] = redactedValue
+					redacted = true
+					continue
+				}
+			}
+			redacted = redactJsonValue(field) || redacted
+		}
+	case []interface{}:
+		for _, item := range v {
+			redacted = redactJsonValue(item) || redacted
+		}
+	}
+	return redacted
+}
@@ -0,0 +1,76 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+package transport
+
+import (
+	"log"
+	"math"
+	"net/http"
+	"strings"
+	"sync"
+
+	"golang.org/x/time/rate"
+)
+
+// The key of RateLimits that applies to every API without its own limit.
+const DefaultRateLimitKey = "default"
+
+type rateLimitTransport struct {
+	limits    map[string]float64
+	transport http.RoundTripper
+
+	mu       sync.Mutex
+	limiters map[string]*rate.Limiter
+}
+
+// NewRateLimitTransport returns a transport that limits the requests made
+// through t to each API to the number of queries per second in limits, keyed
+// by API host such as compute.googleapis.com. The DefaultRateLimitKey entry
+// applies to every other API, each API getting its own bucket. If limits is
+// empty, t is returned as is.
+func NewRateLimitTransport(t http.RoundTripper, limits map[string]float64) http.RoundTripper {
+	if len(limits) == 0 {
+		return t
+	}
+	return &rateLimitTransport{
+		limits:    limits,
+		transport: t,
+		limiters:  make(map[string]*rate.Limiter),
+	}
+}
+
+func (t *rateLimitTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if limiter := t.limiter(req.URL.Hostname()); limiter != nil {
+		if err := limiter.Wait(req.Context()); err != nil {
+			return nil, err
+		}
+	}
+	return t.transport.RoundTrip(req)
+}
+
+// limiter returns the rate limiter shared by all requests to host, or nil if
+// requests to host are not limited.
+func (t *rateLimitTransport) limiter(host string) *rate.Limiter {
+	host = strings.ToLower(host)
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if l, ok := t.limiters[host]; ok {
+		return l
+	}
+
+	qps, ok := t.limits[host]
+	if !ok {
+		qps, ok = t.limits[DefaultRateLimitKey]
+	}
+	var l *rate.Limiter
+	if ok && qps > 0 {
+		// Allow a burst of one second of requests so that parallel resources
+		// don't all wait on their first request.
+		l = rate.NewLimiter(rate.Limit(qps), int(math.Max(1, math.Ceil(qps))))
+		log.Printf("[DEBUG] Limiting requests to %s to %v per second", host, qps)
+	}
+	t.limiters[host] = l
+	return l
+}
@@ -0,0 +1,67 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+package transport
+
+import (
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+func TestNewRateLimitTransport_noLimits(t *testing.T) {
+	base := roundTripperFunc(func(req *http.Request) (*http.Response, error) {
+		return nil, nil
+	})
+	if _, ok := NewRateLimitTransport(base, nil).(roundTripperFunc); !ok {
+		t.Errorf("expected the transport to be returned as is")
+	}
+}
+
+func TestRateLimitTransport_limiter(t *testing.T) {
+	rt := NewRateLimitTransport(http.DefaultTransport, map[string]float64{
+		"compute.googleapis.com": 2.5,
+		"iam.googleapis.com":     0,
+		DefaultRateLimitKey:      10,
+	}).(*rateLimitTransport)
+
+	compute := rt.limiter("compute.googleapis.com")
+	if compute == nil || compute.Limit() != 2.5 || compute.Burst() != 3 {
+		t.Fatalf("unexpected compute limiter %#v", compute)
+	}
+	if rt.limiter("COMPUTE.googleapis.com") != compute {
+		t.Errorf("expected requests to the same API to share a limiter")
+	}
+	if l := rt.limiter("iam.googleapis.com"); l != nil {
+		t.Errorf("expected no limiter for a limit of 0, got %#v", l)
+	}
+
+	storage := rt.limiter("storage.googleapis.com")
+	pubsub := rt.limiter("pubsub.googleapis.com")
+	if storage == nil || storage.Limit() != 10 || storage.Burst() != 10 {
+		t.Fatalf("unexpected default limiter %#v", storage)
+	}
+	if storage == pubsub {
+		t.Errorf("expected each API to get its own default limiter")
+	}
+}
+
+func TestRateLimitTransport_RoundTrip(t *testing.T) {
+	var requests int
+	base := roundTripperFunc(func(req *http.Request) (*http.Response, error) {
+		requests++
+		return &http.Response{StatusCode: 200, Body: io.NopCloser(strings.NewReader(""))}, nil
+	})
+	client := &http.Client{Transport: NewRateLimitTransport(base, map[string]float64{DefaultRateLimitKey: 1000})}
+
+	for i := 0; i < 5; i++ {
+		res, err := client.Get("https://compute.googleapis.com/compute/v1/projects/p")
+		if err != nil {
+			t.Fatal(err)
+		}
+		res.Body.Close()
+	}
+	if requests != 5 {
+		t.Errorf("expected 5 requests, got %d", requests)
+	}
+}
@@ -40,6 +40,8 @@ import (
 	"log"
 	"net/http"
 	"net/http/httputil"
+	"strconv"
+	"strings"
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
@@ -136,6 +138,13 @@ Retry:
 			break Retry
 		}
 
+		// Quota errors won't succeed before the quota refills, so wait at
+		// least as long as the server asks for.
+		if delay := QuotaRetryDelay(retryErr.Err); delay > backoff {
+			log.Printf("[DEBUG] Retry Transport: Quota exhausted, raising backoff from %s to %s", backoff, delay)
+			backoff = delay
+		}
+
 		log.Printf("[DEBUG] Retry Transport: Waiting %s before trying request again", backoff)
 		select {
 		case <-ctx.Done():
@@ -211,3 +220,39 @@ func (t *retryTransport) checkForRetryableError(resp *http.Response, respErr err
 	}
 	return resource.NonRetryableError(errToCheck)
 }
+
+// QuotaRetryDelay returns how long to wait before retrying a request that
+// failed with err because a quota was exhausted, based on the RetryInfo
+// details or the Retry-After header of the response. It returns 0 if err is
+// not a quota error or the server didn't say when to retry.
+func QuotaRetryDelay(err error) time.Duration {
+	var gerr *googleapi.Error
+	if !errors.As(err, &gerr) {
+		return 0
+	}
+	if gerr.Code != 429 && !strings.Contains(gerr.Body, "RESOURCE_EXHAUSTED") {
+		return 0
+	}
+
+	for _, detail := range gerr.Details {
+		d, ok := detail.(map[string]interface{})
+		if !ok || d["@type"] != "type.googleapis.com/google.rpc.RetryInfo" {
+			continue
+		}
+		if v, ok := d["retryDelay"].(string); ok {
+			if delay, err := time.ParseDuration(v); err == nil {
+				return delay
+			}
+		}
+	}
+
+	if v := gerr.Header.Get("Retry-After"); v != "" {
+		if secs, err := strconv.Atoi(v); err == nil {
+			return time.Duration(secs) * time.Second
+		}
+		if t, err := http.ParseTime(v); err == nil {
+			return time.Until(t)
+		}
+	}
+	return 0
+}
@@ -307,3 +307,51 @@ func testRetryTransportRetryPredicate(err error) (bool, string) {
 	}
 	return false, ""
 }
+
+func TestQuotaRetryDelay(t *testing.T) {
+	retryInfo := []interface{}{
+		map[string]interface{}{
+			"@type":  "type.googleapis.com/google.rpc.ErrorInfo",
+			"reason": "RATE_LIMIT_EXCEEDED",
+		},
+		map[string]interface{}{
+			"@type":      "type.googleapis.com/google.rpc.RetryInfo",
+			"retryDelay": "30s",
+		},
+	}
+	cases := map[string]struct {
+		Err      error
+		Expected time.Duration
+	}{
+		"retry info": {
+			Err:      &googleapi.Error{Code: 429, Details: retryInfo},
+			Expected: 30 * time.Second,
+		},
+		"retry after header": {
+			Err:      &googleapi.Error{Code: 429, Header: http.Header{"Retry-After": []string{"12"}}},
+			Expected: 12 * time.Second,
+		},
+		"resource exhausted status": {
+			Err:      &googleapi.Error{Code: 403, Body: `{"error": {"status": "RESOURCE_EXHAUSTED"}}`, Details: retryInfo},
+			Expected: 30 * time.Second,
+		},
+		"no delay given": {
+			Err:      &googleapi.Error{Code: 429},
+			Expected: 0,
+		},
+		"not a quota error": {
+			Err:      &googleapi.Error{Code: 503, Header: http.Header{"Retry-After": []string{"12"}}},
+			Expected: 0,
+		},
+		"not a googleapi error": {
+			Err:      fmt.Errorf("connection reset"),
+			Expected: 0,
+		},
+	}
+
+	for tn, tc := range cases {
+		if got := QuotaRetryDelay(tc.Err); got != tc.Expected {
+			t.Errorf("%s: expected %s, got %s", tn, tc.Expected, got)
+		}
+	}
+}
@@ -79,7 +79,7 @@ func SendRequest(opt SendRequestOptions) (map[string]interface{}, error) {
 
 			if err := googleapi.CheckResponse(res); err != nil {
 				googleapi.CloseBody(res)
-				return err
+				return AddGoogleApiErrorDetails(err, opt.Method, opt.RawURL)
 			}
 
 			return nil
@@ -268,6 +268,30 @@ func ValidateNonNegativeDuration() schema.SchemaValidateFunc {
 	}
 }
 
+// ValidateDurationAtLeast validates that a duration string is at least min.
+func ValidateDurationAtLeast(min time.Duration) schema.SchemaValidateFunc {
+	return func(i interface{}, k string) (s []string, es []error) {
+		v, ok := i.(string)
+		if !ok {
+			es = append(es, fmt.Errorf("expected type of %s to be string", k))
+			return
+		}
+
+		dur, err := time.ParseDuration(v)
+		if err != nil {
+			es = append(es, fmt.Errorf("expected %s to be a duration, but parsing gave an error: %s", k, err.Error()))
+			return
+		}
+
+		if dur < min {
+			es = append(es, fmt.Errorf("expected %s to be at least %v, got %v", k, min, dur))
+			return
+		}
+
+		return
+	}
+}
+
 func ValidateIpAddress(i interface{}, val string) ([]string, []error) {
 	ip := net.ParseIP(i.(string))
 	if ip == nil {
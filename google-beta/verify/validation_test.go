@@ -7,6 +7,7 @@ import (
 	"regexp"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -161,6 +162,25 @@ func TestValidateRFC3339Time(t *testing.T) {
 	}
 }
 
+func TestValidateDurationAtLeast(t *testing.T) {
+	cases := []StringValidationTestCase{
+		// No errors
+		{TestName: "minimum", Value: "1s"},
+		{TestName: "above minimum", Value: "1m30s"},
+
+		// With errors
+		{TestName: "below minimum", Value: "500ms", ExpectError: true},
+		{TestName: "zero", Value: "0s", ExpectError: true},
+		{TestName: "negative", Value: "-10s", ExpectError: true},
+		{TestName: "not a duration", Value: "10", ExpectError: true},
+	}
+
+	es := TestStringValidationCases(cases, ValidateDurationAtLeast(time.Second))
+	if len(es) > 0 {
+		t.Errorf("Failed to validate durations: %v", es)
+	}
+}
+
 func TestValidateRFC1035Name(t *testing.T) {
 	cases := []struct {
 		TestName    string